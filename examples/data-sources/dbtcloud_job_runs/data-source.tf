@@ -0,0 +1,20 @@
+// we can retrieve the last 10 runs of a job
+data dbtcloud_job_runs last_runs {
+  job_id = 1234
+}
+
+// or the runs in error of an environment since a given date
+data dbtcloud_job_runs errors_since_june {
+  environment_id = 1234
+  status         = "error"
+  created_after  = "2024-06-01T00:00:00Z"
+  limit          = 100
+}
+
+// we can then check the status of the most recent run and the git SHA it used
+output "last_run" {
+  value = {
+    status  = data.dbtcloud_job_runs.last_runs.runs[0].status
+    git_sha = data.dbtcloud_job_runs.last_runs.runs[0].git_sha
+  }
+}
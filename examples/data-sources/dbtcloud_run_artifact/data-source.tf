@@ -0,0 +1,25 @@
+// we can get the manifest of the latest successful run of a job
+data dbtcloud_run_artifact prod_manifest {
+  job_id = 1234
+  path   = "manifest.json"
+}
+
+// or the run results of a given run
+data dbtcloud_run_artifact run_results {
+  run_id = 5678
+  path   = "run_results.json"
+}
+
+// we can then list the models tagged with "finance" and their owner from the meta
+locals {
+  finance_models = {
+    for node in data.dbtcloud_run_artifact.prod_manifest.nodes :
+    node.unique_id => lookup(node.meta, "owner", null)
+    if node.resource_type == "model" && contains(node.tags, "finance")
+  }
+}
+
+// the raw content can also be decoded to access the other fields of the artifact
+output "dbt_version" {
+  value = jsondecode(data.dbtcloud_run_artifact.prod_manifest.content).metadata.dbt_version
+}
@@ -0,0 +1,22 @@
+# a credential for an adapter without a dedicated credential resource
+resource "dbtcloud_credential" "athena_credential" {
+  project_id      = dbtcloud_project.dbt_project.id
+  adapter_version = "athena_v0"
+  fields = {
+    aws_access_key_id     = var.aws_access_key_id
+    aws_secret_access_key = var.aws_secret_access_key
+    schema                = "analytics"
+    threads               = "8"
+  }
+}
+
+resource "dbtcloud_credential" "synapse_credential" {
+  project_id      = dbtcloud_project.dbt_project.id
+  adapter_version = "synapse_v0"
+  fields = {
+    authentication = "ActiveDirectoryPassword"
+    user           = "dbt"
+    password       = var.synapse_password
+    schema         = "analytics"
+  }
+}
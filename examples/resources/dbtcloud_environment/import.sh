@@ -9,6 +9,13 @@ import {
   id = "12345:6789"
 }
 
+# or by names, the import fails if several objects have the same name
+import {
+  to = dbtcloud_environment.prod_environment
+  id = "project=Analytics/environment=Prod"
+}
+
 # using the older import command
 terraform import dbtcloud_environment.prod_environment "project_id:environment_id"
 terraform import dbtcloud_environment.prod_environment 12345:6789
+terraform import dbtcloud_environment.prod_environment "project=Analytics/environment=Prod"
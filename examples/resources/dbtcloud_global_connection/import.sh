@@ -16,6 +16,13 @@ import {
   id = "1234"
 }
 
+# or by name, the import fails if several objects have the same name
+import {
+  to = dbtcloud_global_connection.my_connection
+  id = "name=Snowflake"
+}
+
 # using the older import command
 terraform import dbtcloud_global_connection.my_connection "connection_id"
 terraform import dbtcloud_global_connection.my_connection 1234
+terraform import dbtcloud_global_connection.my_connection "name=Snowflake"
@@ -9,6 +9,13 @@ import {
   id = "12345"
 }
 
+# or by name, the import fails if several objects have the same name
+import {
+  to = dbtcloud_group.my_group
+  id = "name=Analysts"
+}
+
 # using the older import command
 terraform import dbtcloud_group.my_group "group_id"
 terraform import dbtcloud_group.my_group 12345
+terraform import dbtcloud_group.my_group "name=Analysts"
@@ -9,6 +9,18 @@ import {
   id = "12345"
 }
 
+# or by names, the environment only needs to be provided when several jobs of the project have the same name
+import {
+  to = dbtcloud_job.my_job
+  id = "project=Analytics/job=Daily run"
+}
+
+import {
+  to = dbtcloud_job.my_job
+  id = "project=Analytics/environment=Prod/job=Daily run"
+}
+
 # using the older import command
 terraform import dbtcloud_job.my_job "job_id"
 terraform import dbtcloud_job.my_job 12345
+terraform import dbtcloud_job.my_job "project=Analytics/job=Daily run"
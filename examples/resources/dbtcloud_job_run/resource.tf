@@ -0,0 +1,16 @@
+# run a full refresh when the connection of the environment changes
+resource "dbtcloud_job_run" "full_refresh" {
+  job_id         = dbtcloud_job.daily_job.id
+  cause          = "Connection switched by Terraform"
+  steps_override = ["dbt build --full-refresh"]
+  triggers = {
+    connection_id = dbtcloud_environment.prod_environment.connection_id
+  }
+
+  # fail the apply if the run doesn't succeed within 2 hours
+  wait_for_completion = true
+  fail_on_error       = true
+  timeouts {
+    create = "2h"
+  }
+}
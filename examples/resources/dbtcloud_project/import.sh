@@ -9,6 +9,13 @@ import {
   id = "12345"
 }
 
+# or by name, the import fails if several objects have the same name
+import {
+  to = dbtcloud_project.my_project
+  id = "name=Analytics"
+}
+
 # using the older import command
 terraform import dbtcloud_project.my_project "project_id"
 terraform import dbtcloud_project.my_project 12345
+terraform import dbtcloud_project.my_project "name=Analytics"
@@ -3,7 +3,9 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
@@ -11,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/export"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/provider"
 )
 
@@ -20,6 +23,15 @@ import (
 func main() {
 	ctx := context.Background()
 
+	// `terraform-provider-dbtcloud export` generates the config of an existing account instead of serving the provider
+	if len(os.Args) > 1 && os.Args[1] == export.COMMAND_NAME {
+		if err := export.Run(ctx, os.Args[2:], os.Stdout, os.Stderr); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	var debug bool
 
 	flag.BoolVar(
@@ -1,8 +1,8 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strings"
 )
@@ -24,10 +24,11 @@ type AccountFeatureUpdateRequest struct {
 	Value   bool   `json:"value"`
 }
 
-func (c *Client) GetAccountFeatures() (*AccountFeatures, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetAccountFeatures(ctx context.Context) (*AccountFeatures, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf("%s/private/accounts/%d/features/", c.HostURL, c.AccountID),
+		c.APIURL(API_PRIVATE, "accounts/%d/features/", c.AccountID),
 		nil,
 	)
 	if err != nil {
@@ -48,7 +49,7 @@ func (c *Client) GetAccountFeatures() (*AccountFeatures, error) {
 	return &featuresResponse.Data, nil
 }
 
-func (c *Client) UpdateAccountFeature(feature string, value bool) error {
+func (c *Client) UpdateAccountFeature(ctx context.Context, feature string, value bool) error {
 	updateRequest := AccountFeatureUpdateRequest{
 		Feature: feature,
 		Value:   value,
@@ -59,9 +60,10 @@ func (c *Client) UpdateAccountFeature(feature string, value bool) error {
 		return err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf("%s/private/accounts/%d/features/", c.HostURL, c.AccountID),
+		c.APIURL(API_PRIVATE, "accounts/%d/features/", c.AccountID),
 		strings.NewReader(string(updateData)),
 	)
 	if err != nil {
@@ -1,8 +1,8 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -54,6 +54,7 @@ type AdapterCredentialFieldMetadata struct {
 	Encrypt      bool                                     `json:"encrypt"`
 	Overrideable bool                                     `json:"overrideable"`
 	Options      []AdapterCredentialFieldMetadataOptions  `json:"options,omitempty"`
+	DependsOn    map[string][]string                      `json:"depends_on,omitempty"`
 	Validation   AdapterCredentialFieldMetadataValidation `json:"validation"`
 }
 
@@ -62,8 +63,8 @@ type AdapterCredentialFieldMetadataOptions struct {
 	Value string `json:"value"`
 }
 
-func createGenericAdapter(c *Client, newAdapter Adapter, projectID int) (*int, error) {
-	currentUser, err := c.GetConnectedUser()
+func createGenericAdapter(ctx context.Context, c *Client, newAdapter Adapter, projectID int) (*int, error) {
+	currentUser, err := c.GetConnectedUser(ctx)
 	if err != nil {
 
 		// if GetConnectedUser is the following specific error, it means that the user is using a service token
@@ -71,7 +72,7 @@ func createGenericAdapter(c *Client, newAdapter Adapter, projectID int) (*int, e
 		if strings.Contains(err.Error(), "This endpoint cannot be accessed with a service token") {
 
 			// we just get the first service token ID from the list
-			allServiceTokens, err := c.GetAllServiceTokens()
+			allServiceTokens, err := c.GetAllServiceTokens(ctx)
 			if err != nil {
 				return nil, err
 			}
@@ -90,14 +91,10 @@ func createGenericAdapter(c *Client, newAdapter Adapter, projectID int) (*int, e
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/projects/%s/adapters/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			strconv.Itoa(projectID),
-		),
+		c.V3AccountURL("projects/%s/adapters/", strconv.Itoa(projectID)),
 		strings.NewReader(string(newAdapterData)),
 	)
 	if err != nil {
@@ -0,0 +1,365 @@
+package dbt_cloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// AdapterCredential is a credential of a global connection, its details are described by the metadata of the adapter
+type AdapterCredential struct {
+	ID                           *int                     `json:"id"`
+	AccountID                    int                      `json:"account_id"`
+	ProjectID                    int                      `json:"project_id"`
+	Type                         string                   `json:"type"`
+	State                        int                      `json:"state"`
+	Threads                      int                      `json:"threads"`
+	AdapterVersion               string                   `json:"adapter_version"`
+	CredentialDetails            AdapterCredentialDetails `json:"credential_details"`
+	UnencryptedCredentialDetails map[string]any           `json:"unencrypted_credential_details,omitempty"`
+}
+
+func (AdapterCredential) IncludeRelated() []string { return []string{"adapter"} }
+
+const (
+	ADAPTER_FIELD_TYPE_TEXT    = "text"
+	ADAPTER_FIELD_TYPE_NUMBER  = "number"
+	ADAPTER_FIELD_TYPE_SELECT  = "select"
+	ADAPTER_FIELD_TYPE_BOOLEAN = "boolean"
+)
+
+// adapterCredentialField returns the metadata and default value of a field of the credential details
+func adapterCredentialField(
+	label string,
+	description string,
+	fieldType string,
+	required bool,
+	encrypt bool,
+	value any,
+) AdapterCredentialField {
+	return AdapterCredentialField{
+		Metadata: AdapterCredentialFieldMetadata{
+			Label:       label,
+			Description: description,
+			Field_Type:  fieldType,
+			Encrypt:     encrypt,
+			Validation:  AdapterCredentialFieldMetadataValidation{Required: required},
+		},
+		Value: value,
+	}
+}
+
+func threadsCredentialField() AdapterCredentialField {
+	return adapterCredentialField(
+		"Threads",
+		"The number of threads to use for dbt operations.",
+		ADAPTER_FIELD_TYPE_NUMBER,
+		false,
+		false,
+		NUM_THREADS_CREDENTIAL,
+	)
+}
+
+// adapterCredentialSchemas returns the credential details of each adapter, with their default values
+// like for Databricks and Fabric, they are taken from the calls made to the API
+func adapterCredentialSchemas() map[string]AdapterCredentialDetails {
+	schemas := map[string]AdapterCredentialDetails{
+		AthenaConfig{}.AdapterVersion(): {
+			Fields: map[string]AdapterCredentialField{
+				"aws_access_key_id": adapterCredentialField(
+					"AWS Access Key ID",
+					"The AWS access key ID used to connect to Athena.",
+					ADAPTER_FIELD_TYPE_TEXT,
+					true,
+					true,
+					"",
+				),
+				"aws_secret_access_key": adapterCredentialField(
+					"AWS Secret Access Key",
+					"The AWS secret access key used to connect to Athena.",
+					ADAPTER_FIELD_TYPE_TEXT,
+					true,
+					true,
+					"",
+				),
+				"schema":  adapterCredentialField("Schema", "User schema.", ADAPTER_FIELD_TYPE_TEXT, true, false, ""),
+				"threads": threadsCredentialField(),
+			},
+			Field_Order: []string{"aws_access_key_id", "aws_secret_access_key", "schema", "threads"},
+		},
+		StarburstConfig{}.AdapterVersion(): {
+			Fields: map[string]AdapterCredentialField{
+				"user": adapterCredentialField(
+					"User",
+					"The username of the Starburst account.",
+					ADAPTER_FIELD_TYPE_TEXT,
+					true,
+					false,
+					"",
+				),
+				"password": adapterCredentialField(
+					"Password",
+					"The password of the Starburst account.",
+					ADAPTER_FIELD_TYPE_TEXT,
+					true,
+					true,
+					"",
+				),
+				"database": adapterCredentialField(
+					"Catalog",
+					"The catalog where to create the dbt models.",
+					ADAPTER_FIELD_TYPE_TEXT,
+					true,
+					false,
+					"",
+				),
+				"schema":  adapterCredentialField("Schema", "User schema.", ADAPTER_FIELD_TYPE_TEXT, true, false, ""),
+				"threads": threadsCredentialField(),
+			},
+			Field_Order: []string{"user", "password", "database", "schema", "threads"},
+		},
+		ApacheSparkConfig{}.AdapterVersion(): {
+			Fields: map[string]AdapterCredentialField{
+				"token": adapterCredentialField(
+					"Token",
+					"Personalized user token.",
+					ADAPTER_FIELD_TYPE_TEXT,
+					true,
+					true,
+					"",
+				),
+				"schema":  adapterCredentialField("Schema", "User schema.", ADAPTER_FIELD_TYPE_TEXT, true, false, ""),
+				"threads": threadsCredentialField(),
+			},
+			Field_Order: []string{"token", "schema", "threads"},
+		},
+		RedshiftConfig{}.AdapterVersion(): {
+			Fields: map[string]AdapterCredentialField{
+				"username": adapterCredentialField(
+					"Username",
+					"The username of the Redshift user.",
+					ADAPTER_FIELD_TYPE_TEXT,
+					true,
+					false,
+					"",
+				),
+				"password": adapterCredentialField(
+					"Password",
+					"The password of the Redshift user.",
+					ADAPTER_FIELD_TYPE_TEXT,
+					true,
+					true,
+					"",
+				),
+				"default_schema": adapterCredentialField(
+					"Schema",
+					"User schema.",
+					ADAPTER_FIELD_TYPE_TEXT,
+					true,
+					false,
+					"",
+				),
+				"threads": threadsCredentialField(),
+			},
+			Field_Order: []string{"username", "password", "default_schema", "threads"},
+		},
+	}
+
+	// the other adapters reuse the details generated for their dedicated resources
+	// Synapse has the same authentication methods and fields as Fabric
+	databricksDetails, _ := GenerateDatabricksCredentialDetails("", "", "", "")
+	schemas[DatabricksConfig{}.AdapterVersion()] = databricksDetails
+	fabricDetails, _ := GenerateFabricCredentialDetails("", "", "", "", "", "", "")
+	schemas[FabricConfig{}.AdapterVersion()] = fabricDetails
+	synapseDetails, _ := GenerateFabricCredentialDetails("", "", "", "", "", "", "")
+	schemas[SynapseConfig{}.AdapterVersion()] = synapseDetails
+
+	return schemas
+}
+
+// AdapterCredentialVersions returns the adapter versions supported by the generic credential resource
+func AdapterCredentialVersions() []string {
+	versions := lo.Keys(adapterCredentialSchemas())
+	sort.Strings(versions)
+	return versions
+}
+
+// AdapterCredentialSchema returns the fields of the credentials of the adapter, with their default values
+func AdapterCredentialSchema(adapterVersion string) (AdapterCredentialDetails, error) {
+	details, ok := adapterCredentialSchemas()[adapterVersion]
+	if !ok {
+		return AdapterCredentialDetails{}, fmt.Errorf(
+			"the adapter version %q is not supported, expected one of: %s",
+			adapterVersion,
+			strings.Join(AdapterCredentialVersions(), ", "),
+		)
+	}
+	return details, nil
+}
+
+// GenerateAdapterCredentialDetails returns the credential details of the adapter with the values provided
+// the values are converted to the type of their field, and validated against the metadata of the adapter:
+// the fields need to exist, the required ones need to be set and the select ones need to use one of their options
+// the fields not provided keep their default value
+func GenerateAdapterCredentialDetails(
+	adapterVersion string,
+	values map[string]string,
+) (AdapterCredentialDetails, error) {
+	details, err := AdapterCredentialSchema(adapterVersion)
+	if err != nil {
+		return details, err
+	}
+
+	errs := []error{}
+	keys := lo.Keys(values)
+	sort.Strings(keys)
+	for _, key := range keys {
+		if _, ok := details.Fields[key]; !ok {
+			errs = append(errs, fmt.Errorf(
+				"the field %q doesn't exist for the adapter %s, expected one of: %s",
+				key,
+				adapterVersion,
+				strings.Join(sortedFieldNames(details), ", "),
+			))
+		}
+	}
+
+	fields := map[string]AdapterCredentialField{}
+	for _, key := range sortedFieldNames(details) {
+		field := details.Fields[key]
+		value, isSet := values[key]
+
+		if !fieldIsUsed(details, values, field) {
+			if isSet && value != "" {
+				errs = append(errs, fmt.Errorf(
+					"the field %q is only used when %s",
+					key,
+					dependsOnDescription(field),
+				))
+			}
+			fields[key] = field
+			continue
+		}
+
+		if !isSet || value == "" {
+			if field.Metadata.Validation.Required && isEmptyValue(field.Value) {
+				errs = append(errs, fmt.Errorf("the field %q is required for the adapter %s", key, adapterVersion))
+			}
+			fields[key] = field
+			continue
+		}
+
+		field.Value, err = convertFieldValue(field, value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("the field %q %w", key, err))
+		}
+		fields[key] = field
+	}
+
+	if len(errs) > 0 {
+		return details, errors.Join(errs...)
+	}
+
+	return AdapterCredentialDetails{
+		Fields:      fields,
+		Field_Order: details.Field_Order,
+	}, nil
+}
+
+// fieldIsUsed returns false for the fields depending on the value of another field, e.g. the user
+// is only used with the ActiveDirectoryPassword authentication
+func fieldIsUsed(details AdapterCredentialDetails, values map[string]string, field AdapterCredentialField) bool {
+	for dependency, allowedValues := range field.Metadata.DependsOn {
+		dependencyValue, ok := values[dependency]
+		if !ok || dependencyValue == "" {
+			dependencyValue = fmt.Sprint(details.Fields[dependency].Value)
+		}
+		if !lo.Contains(allowedValues, dependencyValue) {
+			return false
+		}
+	}
+	return true
+}
+
+func dependsOnDescription(field AdapterCredentialField) string {
+	conditions := []string{}
+	for _, dependency := range lo.Keys(field.Metadata.DependsOn) {
+		conditions = append(conditions, fmt.Sprintf(
+			"%s is one of: %s",
+			dependency,
+			strings.Join(field.Metadata.DependsOn[dependency], ", "),
+		))
+	}
+	sort.Strings(conditions)
+	return strings.Join(conditions, " and ")
+}
+
+func convertFieldValue(field AdapterCredentialField, value string) (any, error) {
+	switch field.Metadata.Field_Type {
+	case ADAPTER_FIELD_TYPE_NUMBER:
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("needs to be a number, got %q", value)
+		}
+		return number, nil
+	case ADAPTER_FIELD_TYPE_BOOLEAN:
+		boolean, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("needs to be true or false, got %q", value)
+		}
+		return boolean, nil
+	case ADAPTER_FIELD_TYPE_SELECT:
+		options := lo.Map(
+			field.Metadata.Options,
+			func(option AdapterCredentialFieldMetadataOptions, _ int) string { return option.Value },
+		)
+		if len(options) > 0 && !lo.Contains(options, value) {
+			return nil, fmt.Errorf("needs to be one of: %s, got %q", strings.Join(options, ", "), value)
+		}
+		return value, nil
+	default:
+		return value, nil
+	}
+}
+
+func isEmptyValue(value any) bool {
+	return value == nil || value == ""
+}
+
+func sortedFieldNames(details AdapterCredentialDetails) []string {
+	names := lo.Keys(details.Fields)
+	sort.Strings(names)
+	return names
+}
+
+// CreateAdapterCredential creates a credential of a global connection with the details of its adapter
+func (c *Client) CreateAdapterCredential(
+	ctx context.Context,
+	projectID int,
+	adapterVersion string,
+	credentialDetails AdapterCredentialDetails,
+) (*AdapterCredential, error) {
+	newCredential := AdapterCredential{
+		AccountID:         c.AccountID,
+		ProjectID:         projectID,
+		Type:              "adapter",
+		State:             STATE_ACTIVE,
+		Threads:           NUM_THREADS_CREDENTIAL,
+		AdapterVersion:    adapterVersion,
+		CredentialDetails: credentialDetails,
+	}
+
+	client := NewCredentialClient[AdapterCredential](c)
+	return client.Create(ctx, projectID, newCredential)
+}
+
+// AdapterCredentialPatch updates only the fields of the credential details sent
+type AdapterCredentialPatch struct {
+	ID                int                      `json:"id"`
+	CredentialDetails AdapterCredentialDetails `json:"credential_details"`
+}
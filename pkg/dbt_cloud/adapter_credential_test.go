@@ -0,0 +1,121 @@
+package dbt_cloud
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateAdapterCredentialDetails(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		adapterVersion string
+		values         map[string]string
+		expectedValues map[string]any
+		expectedErrors []string
+	}{
+		{
+			name:           "athena",
+			adapterVersion: "athena_v0",
+			values: map[string]string{
+				"aws_access_key_id":     "key",
+				"aws_secret_access_key": "secret",
+				"schema":                "analytics",
+				"threads":               "8",
+			},
+			expectedValues: map[string]any{"schema": "analytics", "threads": 8},
+		},
+		{
+			name:           "default value of the fields not set",
+			adapterVersion: "apache_spark_v0",
+			values:         map[string]string{"token": "token", "schema": "analytics"},
+			expectedValues: map[string]any{"threads": NUM_THREADS_CREDENTIAL},
+		},
+		{
+			name:           "missing required field",
+			adapterVersion: "trino_v0",
+			values:         map[string]string{"user": "dbt", "password": "secret", "schema": "analytics"},
+			expectedErrors: []string{`the field "database" is required for the adapter trino_v0`},
+		},
+		{
+			name:           "unknown field and invalid number",
+			adapterVersion: "redshift_v0",
+			values: map[string]string{
+				"username":       "dbt",
+				"password":       "secret",
+				"default_schema": "analytics",
+				"threads":        "many",
+				"schema":         "analytics",
+			},
+			expectedErrors: []string{
+				`the field "schema" doesn't exist for the adapter redshift_v0`,
+				`the field "threads" needs to be a number, got "many"`,
+			},
+		},
+		{
+			name:           "select option and dependent fields",
+			adapterVersion: "synapse_v0",
+			values: map[string]string{
+				"authentication": "ActiveDirectoryPassword",
+				"user":           "dbt",
+				"password":       "secret",
+				"schema":         "analytics",
+			},
+			expectedValues: map[string]any{"authentication": "ActiveDirectoryPassword", "user": "dbt"},
+		},
+		{
+			name:           "field not used by the authentication",
+			adapterVersion: "fabric_v0",
+			values: map[string]string{
+				"authentication": "ServicePrincipal",
+				"tenant_id":      "tenant",
+				"client_id":      "client",
+				"client_secret":  "secret",
+				"user":           "dbt",
+				"schema":         "analytics",
+			},
+			expectedErrors: []string{
+				`the field "user" is only used when authentication is one of: ActiveDirectoryPassword`,
+			},
+		},
+		{
+			name:           "invalid select option",
+			adapterVersion: "databricks_v0",
+			values:         map[string]string{"auth_type": "password", "token": "token", "schema": "analytics"},
+			expectedErrors: []string{`the field "auth_type" needs to be one of: token, oauth, got "password"`},
+		},
+		{
+			name:           "unsupported adapter",
+			adapterVersion: "oracle_v0",
+			expectedErrors: []string{`the adapter version "oracle_v0" is not supported`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			details, err := GenerateAdapterCredentialDetails(tc.adapterVersion, tc.values)
+
+			if len(tc.expectedErrors) > 0 {
+				if err == nil {
+					t.Fatalf("expected an error, got the details %+v", details)
+				}
+				for _, expectedError := range tc.expectedErrors {
+					if !strings.Contains(err.Error(), expectedError) {
+						t.Errorf("expected the error to contain %q, got %q", expectedError, err.Error())
+					}
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			for key, expectedValue := range tc.expectedValues {
+				if value := details.Fields[key].Value; value != expectedValue {
+					t.Errorf("expected the field %s to be %v, got %v", key, expectedValue, value)
+				}
+			}
+		})
+	}
+}
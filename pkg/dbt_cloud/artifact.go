@@ -0,0 +1,137 @@
+package dbt_cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// ArtifactNode is the subset of a node of an artifact exposed by the provider
+type ArtifactNode struct {
+	UniqueID     string
+	ResourceType string
+	Tags         []string
+	Meta         map[string]any
+}
+
+type artifactNode struct {
+	UniqueID     string         `json:"unique_id"`
+	ResourceType string         `json:"resource_type"`
+	Tags         []string       `json:"tags"`
+	Meta         map[string]any `json:"meta"`
+	Config       struct {
+		Meta map[string]any `json:"meta"`
+	} `json:"config"`
+}
+
+// artifactFile has the lists of nodes of the different artifacts, e.g. nodes and sources for manifest.json
+// or results for run_results.json
+type artifactFile struct {
+	Nodes     map[string]artifactNode `json:"nodes"`
+	Sources   map[string]artifactNode `json:"sources"`
+	Exposures map[string]artifactNode `json:"exposures"`
+	Results   []artifactNode          `json:"results"`
+}
+
+// GetRunArtifact returns the content of an artifact of the run, e.g. manifest.json or run_results.json
+func (c *Client) GetRunArtifact(ctx context.Context, runID int, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		c.V2AccountURL("runs/%d/artifacts/%s", runID, strings.TrimPrefix(path, "/")),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doRequest(req)
+}
+
+// GetLatestSuccessfulRun returns the most recent successful run of the job
+func (c *Client) GetLatestSuccessfulRun(ctx context.Context, jobID int) (*Run, error) {
+	runs, err := c.GetAllRuns(ctx, RunFilters{
+		JobDefinitionID: jobID,
+		Status:          RUN_STATUS_SUCCESS,
+		Limit:           1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(runs) == 0 {
+		return nil, fmt.Errorf("the job %d doesn't have any successful run", jobID)
+	}
+	return &runs[0], nil
+}
+
+// the keys of the JSON artifacts containing nodes
+var artifactNodesKeys = []string{"nodes", "sources", "exposures", "results"}
+
+// ParseArtifactNodes returns the nodes of a JSON artifact, sorted by unique_id
+// the nodes, sources and exposures of manifest.json and the results of run_results.json are returned,
+// when the resource type is not in the artifact it is taken from the unique_id, e.g. model for model.my_project.orders
+// the other JSON artifacts, e.g. a top-level array or an object without any of these keys, don't have any node
+func ParseArtifactNodes(content []byte) ([]ArtifactNode, error) {
+	var parsed any
+	if err := json.Unmarshal(content, &parsed); err != nil {
+		return nil, fmt.Errorf("the artifact is not valid JSON: %w", err)
+	}
+	object, isObject := parsed.(map[string]any)
+	if !isObject || !lo.SomeBy(artifactNodesKeys, func(key string) bool { return object[key] != nil }) {
+		return []ArtifactNode{}, nil
+	}
+
+	file := artifactFile{}
+	err := json.Unmarshal(content, &file)
+	if err != nil {
+		return nil, fmt.Errorf("the nodes of the artifact can't be read: %w", err)
+	}
+
+	rawNodes := []artifactNode{}
+	for _, nodes := range []map[string]artifactNode{file.Nodes, file.Sources, file.Exposures} {
+		for uniqueID, node := range nodes {
+			if node.UniqueID == "" {
+				node.UniqueID = uniqueID
+			}
+			rawNodes = append(rawNodes, node)
+		}
+	}
+	rawNodes = append(rawNodes, file.Results...)
+
+	artifactNodes := []ArtifactNode{}
+	for _, node := range rawNodes {
+		resourceType := node.ResourceType
+		if resourceType == "" {
+			resourceType, _, _ = strings.Cut(node.UniqueID, ".")
+		}
+		tags := node.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+		meta := node.Meta
+		if len(meta) == 0 {
+			meta = node.Config.Meta
+		}
+		if meta == nil {
+			meta = map[string]any{}
+		}
+
+		artifactNodes = append(artifactNodes, ArtifactNode{
+			UniqueID:     node.UniqueID,
+			ResourceType: resourceType,
+			Tags:         tags,
+			Meta:         meta,
+		})
+	}
+
+	sort.Slice(artifactNodes, func(i, j int) bool {
+		return artifactNodes[i].UniqueID < artifactNodes[j].UniqueID
+	})
+
+	return artifactNodes, nil
+}
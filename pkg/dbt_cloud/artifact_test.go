@@ -0,0 +1,141 @@
+package dbt_cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const testManifest = `{
+	"metadata": {"dbt_version": "1.8.0"},
+	"nodes": {
+		"model.analytics.orders": {
+			"unique_id": "model.analytics.orders",
+			"resource_type": "model",
+			"tags": ["finance", "daily"],
+			"meta": {"owner": "finance-team", "tier": 1},
+			"config": {"meta": {"owner": "finance-team", "tier": 1}}
+		},
+		"test.analytics.not_null_orders_id": {
+			"unique_id": "test.analytics.not_null_orders_id",
+			"resource_type": "test",
+			"config": {"meta": {"severity": "warn"}}
+		}
+	},
+	"sources": {
+		"source.analytics.shop.raw_orders": {"resource_type": "source", "tags": []}
+	}
+}`
+
+func TestParseArtifactNodes(t *testing.T) {
+	t.Parallel()
+
+	nodes, err := ParseArtifactNodes([]byte(testManifest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != 3 {
+		t.Fatalf("expected 3 nodes, got %+v", nodes)
+	}
+
+	orders := nodes[0]
+	if orders.UniqueID != "model.analytics.orders" || orders.ResourceType != "model" ||
+		len(orders.Tags) != 2 || orders.Meta["owner"] != "finance-team" {
+		t.Errorf("unexpected model node: %+v", orders)
+	}
+
+	// the unique_id is taken from the key of the map when the node doesn't have it
+	source := nodes[1]
+	if source.UniqueID != "source.analytics.shop.raw_orders" || source.ResourceType != "source" {
+		t.Errorf("unexpected source node: %+v", source)
+	}
+
+	// the meta is taken from the config when the node doesn't have it
+	test := nodes[2]
+	if test.Meta["severity"] != "warn" || test.Tags == nil {
+		t.Errorf("unexpected test node: %+v", test)
+	}
+}
+
+func TestParseArtifactNodesRunResults(t *testing.T) {
+	t.Parallel()
+
+	nodes, err := ParseArtifactNodes([]byte(`{"results": [
+		{"unique_id": "seed.analytics.countries", "status": "success"},
+		{"unique_id": "model.analytics.orders", "status": "success"}
+	]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(nodes) != 2 || nodes[0].UniqueID != "model.analytics.orders" || nodes[0].ResourceType != "model" ||
+		nodes[1].ResourceType != "seed" {
+		t.Errorf("unexpected nodes: %+v", nodes)
+	}
+
+	_, err = ParseArtifactNodes([]byte("select 1"))
+	if err == nil {
+		t.Error("expected an error for an artifact that is not JSON")
+	}
+}
+
+func TestParseArtifactNodesWithoutNodes(t *testing.T) {
+	t.Parallel()
+
+	artifacts := []string{
+		`[{"unique_id": "model.analytics.orders"}]`,
+		`"a string"`,
+		`{"metadata": {"dbt_version": "1.8.0"}, "elapsed_time": 1.5}`,
+		`{"nodes": null}`,
+	}
+	for _, artifact := range artifacts {
+		nodes, err := ParseArtifactNodes([]byte(artifact))
+		if err != nil {
+			t.Errorf("unexpected error for %s: %v", artifact, err)
+			continue
+		}
+		if nodes == nil || len(nodes) != 0 {
+			t.Errorf("expected an empty list of nodes for %s, got %+v", artifact, nodes)
+		}
+	}
+}
+
+func TestGetRunArtifact(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/accounts/1/runs/":
+			query := r.URL.Query()
+			if query.Get("job_definition_id") != "5" || query.Get("status") != "10" || query.Get("limit") != "1" {
+				t.Errorf("unexpected request: %s", r.URL)
+			}
+			w.Write([]byte(`{"data": [{"id": 9, "status": 10}], "extra": {"pagination": {"count": 1, "total_count": 12}}}`))
+		case "/v2/accounts/1/runs/9/artifacts/manifest.json":
+			w.Write([]byte(testManifest))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL)
+	ctx := context.Background()
+
+	run, err := c.GetLatestSuccessfulRun(ctx, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *run.ID != 9 {
+		t.Fatalf("expected the run 9, got %+v", run)
+	}
+
+	content, err := c.GetRunArtifact(ctx, *run.ID, "manifest.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != testManifest {
+		t.Errorf("unexpected content: %s", content)
+	}
+}
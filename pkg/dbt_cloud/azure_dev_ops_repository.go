@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -26,17 +27,14 @@ type AzureDevOpsRepositoriesResponse struct {
 }
 
 func (c *Client) GetAzureDevOpsRepositories(
+	ctx context.Context,
 	azureDevOpsProjectID string,
 ) ([]AzureDevOpsRepository, error) {
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf(
-			"%s/v3/integrations/azure-ad/projects/%s/repositories/?account_id=%d",
-			c.HostURL,
-			azureDevOpsProjectID,
-			c.AccountID,
-		),
+		c.APIURL(API_V3, "integrations/azure-ad/projects/%s/repositories/?account_id=%d", azureDevOpsProjectID, c.AccountID),
 		nil,
 	)
 	if err != nil {
@@ -59,11 +57,12 @@ func (c *Client) GetAzureDevOpsRepositories(
 }
 
 func (c *Client) GetAzureDevOpsRepository(
+	ctx context.Context,
 	repositoryName string,
 	azureDevOpsProjectID string,
 ) (*AzureDevOpsRepository, error) {
 
-	listAzureDevOpsRepositories, err := c.GetAzureDevOpsRepositories(azureDevOpsProjectID)
+	listAzureDevOpsRepositories, err := c.GetAzureDevOpsRepositories(ctx, azureDevOpsProjectID)
 	if err != nil {
 		return nil, err
 	}
@@ -1,8 +1,8 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -44,17 +44,13 @@ type BigQueryConnectionResponse struct {
 }
 
 func (c *Client) GetBigQueryConnection(
+	ctx context.Context,
 	connectionID, projectID string,
 ) (*BigQueryConnection, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/projects/%s/connections/%s/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			projectID,
-			connectionID,
-		),
+		c.V3AccountURL("projects/%s/connections/%s/", projectID, connectionID),
 		nil,
 	)
 	if err != nil {
@@ -76,6 +72,7 @@ func (c *Client) GetBigQueryConnection(
 }
 
 func (c *Client) CreateBigQueryConnection(
+	ctx context.Context,
 	projectID int,
 	name string,
 	connectionType string,
@@ -144,14 +141,10 @@ func (c *Client) CreateBigQueryConnection(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/projects/%s/connections/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			strconv.Itoa(projectID),
-		),
+		c.V3AccountURL("projects/%s/connections/", strconv.Itoa(projectID)),
 		strings.NewReader(string(newConnectionData)),
 	)
 	if err != nil {
@@ -173,6 +166,7 @@ func (c *Client) CreateBigQueryConnection(
 }
 
 func (c *Client) UpdateBigQueryConnection(
+	ctx context.Context,
 	connectionID, projectID string,
 	connection BigQueryConnection,
 ) (*BigQueryConnection, error) {
@@ -181,15 +175,10 @@ func (c *Client) UpdateBigQueryConnection(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/projects/%s/connections/%s/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			projectID,
-			connectionID,
-		),
+		c.V3AccountURL("projects/%s/connections/%s/", projectID, connectionID),
 		strings.NewReader(string(connectionData)),
 	)
 	if err != nil {
@@ -1,17 +1,9 @@
 package dbt_cloud
 
 import (
-	"encoding/json"
-	"fmt"
-	"net/http"
-	"strings"
+	"context"
 )
 
-type BigQueryCredentialResponse struct {
-	Data   BigQueryCredential `json:"data"`
-	Status ResponseStatus     `json:"status"`
-}
-
 type BigQueryCredential struct {
 	ID         *int   `json:"id"`
 	Account_Id int    `json:"account_id"`
@@ -22,40 +14,19 @@ type BigQueryCredential struct {
 	Dataset    string `json:"schema"`
 }
 
+func (BigQueryCredential) IncludeRelated() []string { return nil }
+
 func (c *Client) GetBigQueryCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 ) (*BigQueryCredential, error) {
-	req, err := http.NewRequest(
-		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-			credentialId,
-		),
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	BigQueryCredentialResponse := BigQueryCredentialResponse{}
-	err = json.Unmarshal(body, &BigQueryCredentialResponse)
-	if err != nil {
-		return nil, err
-	}
-
-	return &BigQueryCredentialResponse.Data, nil
+	client := NewCredentialClient[BigQueryCredential](c)
+	return client.Get(ctx, projectId, credentialId)
 }
 
 func (c *Client) CreateBigQueryCredential(
+	ctx context.Context,
 	projectId int,
 	type_ string,
 	isActive bool,
@@ -70,74 +41,7 @@ func (c *Client) CreateBigQueryCredential(
 		Dataset:    dataset,
 		Threads:    numThreads,
 	}
-	newBigQueryCredentialData, err := json.Marshal(newBigQueryCredential)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(
-		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/credentials/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-		),
-		strings.NewReader(string(newBigQueryCredentialData)),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	BigQueryCredentialResponse := BigQueryCredentialResponse{}
-	err = json.Unmarshal(body, &BigQueryCredentialResponse)
-	if err != nil {
-		return nil, err
-	}
-
-	return &BigQueryCredentialResponse.Data, nil
-}
-
-func (c *Client) UpdateBigQueryCredential(
-	projectId int,
-	credentialId int,
-	BigQueryCredential BigQueryCredential,
-) (*BigQueryCredential, error) {
-	BigQueryCredentialData, err := json.Marshal(BigQueryCredential)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(
-		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-			credentialId,
-		),
-		strings.NewReader(string(BigQueryCredentialData)),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	BigQueryCredentialResponse := BigQueryCredentialResponse{}
-	err = json.Unmarshal(body, &BigQueryCredentialResponse)
-	if err != nil {
-		return nil, err
-	}
 
-	return &BigQueryCredentialResponse.Data, nil
+	client := NewCredentialClient[BigQueryCredential](c)
+	return client.Create(ctx, projectId, newBigQueryCredential)
 }
@@ -1,9 +1,8 @@
 package dbt_cloud
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
 	"net/http"
 	"os"
 	"time"
@@ -12,11 +11,44 @@ import (
 var versionString = "dev"
 
 type Client struct {
-	HostURL    string
-	HTTPClient *http.Client
-	Token      string
-	AccountURL string
-	AccountID  int
+	HostURL          string
+	HTTPClient       *http.Client
+	Token            string
+	AccountURL       string
+	AccountID        int
+	RetryMaxAttempts int
+	RetryMaxWait     time.Duration
+	PageConcurrency  int
+	RateLimiter      *RateLimiter
+	ReadCache        *ReadCache
+
+	// set when the credentials validation is skipped at configure time and done on the first call instead
+	credentialsCheck *credentialsCheck
+}
+
+// ClientConfig holds the optional settings of the client, zero values fall back to the defaults
+type ClientConfig struct {
+	RetryMaxAttempts int
+	RetryMaxWait     time.Duration
+	PageConcurrency  int
+	// MaxRequestsPerSecond is shared between all the clients for the same account and host, 0 means no limit
+	MaxRequestsPerSecond float64
+	// EnableReadCache caches the responses of list endpoints until a write is made to the same collection
+	EnableReadCache bool
+
+	HTTPTimeout time.Duration
+	// ProxyURL replaces the proxy read from the HTTP_PROXY/HTTPS_PROXY environment variables
+	ProxyURL string
+	// the CA certificate is added to the system ones, the PEM and file fields are mutually exclusive
+	CACertPEM      string
+	CACertFile     string
+	ClientCertPEM  string
+	ClientCertFile string
+	ClientKeyPEM   string
+	ClientKeyFile  string
+
+	// SkipCredentialsValidation delays the validation of the token to the first API call
+	SkipCredentialsValidation bool
 }
 
 type ResponseStatus struct {
@@ -80,7 +112,7 @@ type AuthResponse struct {
 	Data   []AuthResponseData `json:"data"`
 }
 
-// Parses the error we get to see if it is a 404 for a missing resource
+// Parses the error we get to extract the status and messages from dbt Cloud
 type APIError struct {
 	Data   interface{} `json:"data"`
 	Status struct {
@@ -92,59 +124,89 @@ type APIError struct {
 }
 
 // NewClient -
-func NewClient(account_id *int, token *string, host_url *string) (*Client, error) {
+func NewClient(
+	ctx context.Context,
+	account_id *int,
+	token *string,
+	host_url *string,
+	config *ClientConfig,
+) (*Client, error) {
 
 	if (token == nil) || (*token == "") {
 		return nil, fmt.Errorf("token is set but it is empty")
 	}
 
+	if config == nil {
+		config = &ClientConfig{}
+	}
+
+	httpClient, err := newHTTPClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	hostURL, err := NormalizeHostURL(*host_url)
+	if err != nil {
+		return nil, err
+	}
+
 	c := Client{
-		HTTPClient: &http.Client{Timeout: 30 * time.Second},
-		HostURL:    *host_url,
-		Token:      *token,
-		AccountID:  *account_id,
+		HTTPClient:       httpClient,
+		HostURL:          hostURL,
+		Token:            *token,
+		AccountID:        *account_id,
+		RetryMaxAttempts: DEFAULT_RETRY_MAX_ATTEMPTS,
+		RetryMaxWait:     DEFAULT_RETRY_MAX_WAIT,
+		PageConcurrency:  DEFAULT_PAGE_CONCURRENCY,
+	}
+
+	if config.RetryMaxAttempts > 0 {
+		c.RetryMaxAttempts = config.RetryMaxAttempts
+	}
+	if config.RetryMaxWait > 0 {
+		c.RetryMaxWait = config.RetryMaxWait
+	}
+	if config.PageConcurrency > 0 {
+		c.PageConcurrency = config.PageConcurrency
+	}
+	if config.MaxRequestsPerSecond > 0 {
+		c.RateLimiter = getSharedRateLimiter(c.HostURL, c.AccountID, config.MaxRequestsPerSecond)
+	}
+	if config.EnableReadCache {
+		c.ReadCache = getSharedReadCache(c.HostURL, c.AccountID)
 	}
 
 	_, runningAcceptanceTests := os.LookupEnv("TF_ACC")
 	if account_id != nil && !runningAcceptanceTests {
-		url := fmt.Sprintf("%s/v2/accounts/", *host_url)
-
-		// authenticate
-		req, err := http.NewRequest("GET", url, nil)
-		if err != nil {
-			return nil, err
+		if config.SkipCredentialsValidation {
+			// the credentials are only checked on the first API call, so that commands
+			// not calling the API (e.g. terraform validate) work without network access
+			c.credentialsCheck = &credentialsCheck{}
+			return &c, nil
 		}
 
-		body, err := c.doRequest(req)
+		err := c.validateCredentials(ctx, false)
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		// parse response body
-		ar := AuthResponse{}
-		err = json.Unmarshal(body, &ar)
+	return &c, nil
+}
+
+func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+	if c.credentialsCheck != nil {
+		err := c.checkCredentials(req.Context())
 		if err != nil {
 			return nil, err
 		}
-
-		for _, account := range ar.Data {
-			if account.Id == *account_id {
-				c.AccountURL = url
-				return &c, nil
-			}
-		}
-
-		return nil, fmt.Errorf(
-			"the token is valid but does not have access to the account id %d. This might be due to a lack of permissions or because IP restrictions are in place for the account",
-			*account_id,
-		)
-
 	}
 
-	return &c, nil
+	return c.sendRequest(req)
 }
 
-func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+// sendRequest sends the request to dbt Cloud and returns the body of successful responses
+func (c *Client) sendRequest(req *http.Request) ([]byte, error) {
 
 	userAgentWithVersion := fmt.Sprintf(
 		"terraform-provider-dbtcloud/%s",
@@ -156,51 +218,22 @@ func (c *Client) doRequest(req *http.Request) ([]byte, error) {
 	req.Header.Add("Authorization", fmt.Sprintf("Token %s", c.Token))
 	req.Header.Set("User-Agent", userAgentWithVersion)
 
-	res, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, err
+	res, body, err := c.doRequestWithRetry(req)
+
+	// we invalidate the cache even if the write failed as it might have been partially applied
+	if c.ReadCache != nil && req.Method != http.MethodGet {
+		c.ReadCache.invalidate(req.URL)
 	}
-	defer res.Body.Close()
 
-	body, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	// if this is a 404 on a GET we check the body to see if it is a missing resource or incorrect endpoint
-	if res.StatusCode == 404 && req.Method == "GET" {
-		isResourceNotFound, err := isResourceNotFoundError(body)
-		if err != nil {
-			return nil, err
-		}
-		if isResourceNotFound {
-			return nil, fmt.Errorf("resource-not-found: %s", req.URL)
-		}
-	}
-
 	if (res.StatusCode != http.StatusOK) &&
 		(res.StatusCode != http.StatusCreated) &&
 		(res.StatusCode != http.StatusNoContent) {
-		return nil, fmt.Errorf(
-			"%s url: %s, status: %d, body: %s",
-			req.Method,
-			req.URL,
-			res.StatusCode,
-			body,
-		)
+		return nil, newAPIError(req, res, body)
 	}
 
 	return body, err
 }
-
-func isResourceNotFoundError(body []byte) (bool, error) {
-	var apiErr APIError
-	if unmarshalErr := json.Unmarshal([]byte(body), &apiErr); unmarshalErr != nil {
-		return false, unmarshalErr
-	}
-	// in this case, the body of the error mentions a 404, this is different from a 404 due to a wrong URL
-	if apiErr.Status.Code == 404 {
-		return true, nil
-	}
-	return false, nil
-}
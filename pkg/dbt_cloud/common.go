@@ -1,8 +1,8 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 
 	"github.com/samber/lo"
@@ -52,10 +52,11 @@ type Constants struct {
 	PermissionSets map[string]string `json:"permissions_sets"`
 }
 
-func (c *Client) GetConstants() (*Constants, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetConstants(ctx context.Context) (*Constants, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf("%s/v2/constants/", c.HostURL),
+		c.APIURL(API_V2, "constants/"),
 		nil,
 	)
 	if err != nil {
@@ -76,8 +77,8 @@ func (c *Client) GetConstants() (*Constants, error) {
 	return &constantsResponse.Data, nil
 }
 
-func (c *Client) GetPermissionIDs() ([]string, error) {
-	constants, err := c.GetConstants()
+func (c *Client) GetPermissionIDs(ctx context.Context) ([]string, error) {
+	constants, err := c.GetConstants(ctx)
 	if err != nil {
 		return nil, err
 	}
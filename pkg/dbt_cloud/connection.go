@@ -1,8 +1,8 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -55,16 +55,11 @@ type ConnectionResponse struct {
 	Status ResponseStatus `json:"status"`
 }
 
-func (c *Client) GetConnection(connectionID, projectID string) (*Connection, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetConnection(ctx context.Context, connectionID, projectID string) (*Connection, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/projects/%s/connections/%s/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			projectID,
-			connectionID,
-		),
+		c.V3AccountURL("projects/%s/connections/%s/", projectID, connectionID),
 		nil,
 	)
 	if err != nil {
@@ -86,6 +81,7 @@ func (c *Client) GetConnection(connectionID, projectID string) (*Connection, err
 }
 
 func (c *Client) CreateConnection(
+	ctx context.Context,
 	projectID int,
 	name string,
 	connectionType string,
@@ -112,7 +108,7 @@ func (c *Client) CreateConnection(
 
 	connectionDetails := ConnectionDetails{}
 	if connectionType == "adapter" {
-		adapterId, err := c.createDatabricksAdapter(projectID, state)
+		adapterId, err := c.createDatabricksAdapter(ctx, projectID, state)
 		if err != nil {
 			return nil, err
 		}
@@ -162,14 +158,10 @@ func (c *Client) CreateConnection(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/projects/%s/connections/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			strconv.Itoa(projectID),
-		),
+		c.V3AccountURL("projects/%s/connections/", strconv.Itoa(projectID)),
 		strings.NewReader(string(newConnectionData)),
 	)
 	if err != nil {
@@ -197,6 +189,7 @@ func (c *Client) CreateConnection(
 }
 
 func (c *Client) UpdateConnection(
+	ctx context.Context,
 	connectionID, projectID string,
 	connection Connection,
 ) (*Connection, error) {
@@ -205,15 +198,10 @@ func (c *Client) UpdateConnection(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/projects/%s/connections/%s/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			projectID,
-			connectionID,
-		),
+		c.V3AccountURL("projects/%s/connections/%s/", projectID, connectionID),
 		strings.NewReader(string(connectionData)),
 	)
 	if err != nil {
@@ -234,16 +222,11 @@ func (c *Client) UpdateConnection(
 	return &connectionResponse.Data, nil
 }
 
-func (c *Client) DeleteConnection(connectionID, projectID string) (string, error) {
-	req, err := http.NewRequest(
+func (c *Client) DeleteConnection(ctx context.Context, connectionID, projectID string) (string, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/projects/%s/connections/%s/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			projectID,
-			connectionID,
-		),
+		c.V3AccountURL("projects/%s/connections/%s/", projectID, connectionID),
 		nil,
 	)
 	if err != nil {
@@ -258,7 +241,7 @@ func (c *Client) DeleteConnection(connectionID, projectID string) (string, error
 	return "", err
 }
 
-func (c *Client) createDatabricksAdapter(projectID int, state int) (*int, error) {
+func (c *Client) createDatabricksAdapter(ctx context.Context, projectID int, state int) (*int, error) {
 
 	newAdapter := Adapter{
 		ID:             nil,
@@ -273,7 +256,7 @@ func (c *Client) createDatabricksAdapter(projectID int, state int) (*int, error)
 		},
 	}
 
-	return createGenericAdapter(c, newAdapter, projectID)
+	return createGenericAdapter(ctx, c, newAdapter, projectID)
 }
 
 func GetDatabricksConnectionDetails(
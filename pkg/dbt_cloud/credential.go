@@ -1,30 +1,134 @@
 package dbt_cloud
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
-func (c *Client) DeleteCredential(credentialId, projectId string) (string, error) {
-	req, err := http.NewRequest(
-		"DELETE",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%s/credentials/%s/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-			credentialId,
-		),
-		nil,
-	)
+// Credential holds the fields common to all the credential types
+// it is used to know the type of a credential before reading it with its typed client
+type Credential struct {
+	ID             *int   `json:"id"`
+	AccountID      int    `json:"account_id"`
+	ProjectID      int    `json:"project_id"`
+	Type           string `json:"type"`
+	State          int    `json:"state"`
+	Threads        int    `json:"threads"`
+	AdapterVersion string `json:"adapter_version,omitempty"`
+}
+
+// CredentialConfig is implemented by the typed credentials, e.g. SnowflakeCredential
+type CredentialConfig interface {
+	// IncludeRelated returns the related objects to add to the GET requests, e.g. the adapter of the credential
+	IncludeRelated() []string
+}
+
+func (Credential) IncludeRelated() []string { return nil }
+
+type credentialResponse[T CredentialConfig] struct {
+	Data   T              `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
+// CredentialClient reads and writes the credentials of a project, decoding them as T
+type CredentialClient[T CredentialConfig] struct{ *Client }
+
+func NewCredentialClient[T CredentialConfig](c *Client) CredentialClient[T] {
+	return CredentialClient[T]{
+		c,
+	}
+}
+
+func (c *CredentialClient[T]) credentialURL(projectID int, credentialID int) string {
+	return c.V3AccountURL("projects/%d/credentials/%d/", projectID, credentialID)
+}
+
+func (c *CredentialClient[T]) Get(ctx context.Context, projectID int, credentialID int) (*T, error) {
+	url := c.credentialURL(projectID, credentialID)
+
+	var config T
+	if related := config.IncludeRelated(); len(related) > 0 {
+		url += fmt.Sprintf("?include_related=[%s]", strings.Join(related, ","))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.send(req)
+}
+
+// Create creates the credential in the project of credential, the other fields are sent as they are
+func (c *CredentialClient[T]) Create(ctx context.Context, projectID int, credential T) (*T, error) {
+	return c.write(ctx, "POST", c.V3AccountURL("projects/%d/credentials/", projectID), credential)
+}
+
+// Update replaces the credential, it needs to be sent with all its fields
+func (c *CredentialClient[T]) Update(
+	ctx context.Context,
+	projectID int,
+	credentialID int,
+	credential T,
+) (*T, error) {
+	return c.write(ctx, "POST", c.credentialURL(projectID, credentialID), credential)
+}
+
+// Patch updates only the fields sent in patch, e.g. some of the credential_details of an adapter credential
+func (c *CredentialClient[T]) Patch(
+	ctx context.Context,
+	projectID int,
+	credentialID int,
+	patch any,
+) (*T, error) {
+	return c.write(ctx, "PATCH", c.credentialURL(projectID, credentialID), patch)
+}
+
+func (c *CredentialClient[T]) Delete(ctx context.Context, projectID int, credentialID int) error {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", c.credentialURL(projectID, credentialID), nil)
 	if err != nil {
-		return "", err
+		return err
 	}
 
 	_, err = c.doRequest(req)
+	return err
+}
+
+func (c *CredentialClient[T]) write(ctx context.Context, method string, url string, payload any) (*T, error) {
+	buffer := new(bytes.Buffer)
+	err := json.NewEncoder(buffer).Encode(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, buffer)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return "", err
+	return c.send(req)
+}
+
+func (c *CredentialClient[T]) send(req *http.Request) (*T, error) {
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(credentialResponse[T])
+	err = json.Unmarshal(body, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Data, nil
+}
+
+func (c *Client) GetCredential(ctx context.Context, projectID int, credentialID int) (*Credential, error) {
+	client := NewCredentialClient[Credential](c)
+	return client.Get(ctx, projectID, credentialID)
 }
@@ -0,0 +1,81 @@
+package dbt_cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCredentialClient(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		method string
+		uri    string
+		body   map[string]any
+	}
+	var requests []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		requests = append(requests, request{method: r.Method, uri: r.URL.RequestURI(), body: body})
+		w.Write([]byte(`{"data": {"id": 3, "project_id": 2, "type": "adapter", "adapter_id": 4, "unencrypted_credential_details": {"schema": "analytics"}}}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL)
+	ctx := context.Background()
+	client := NewCredentialClient[FabricCredential](c)
+
+	credential, err := client.Get(ctx, 2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *credential.ID != 3 || credential.Adapter_Id != 4 ||
+		credential.UnencryptedCredentialDetails.Schema != "analytics" {
+		t.Errorf("unexpected credential: %+v", credential)
+	}
+
+	credential.State = STATE_DELETED
+	if _, err := client.Update(ctx, 2, 3, *credential); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Patch(ctx, 2, 3, map[string]any{"id": 3}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.Delete(ctx, 2, 3); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []struct {
+		method string
+		uri    string
+	}{
+		{http.MethodGet, "/v3/accounts/1/projects/2/credentials/3/?include_related=[adapter]"},
+		{http.MethodPost, "/v3/accounts/1/projects/2/credentials/3/"},
+		{http.MethodPatch, "/v3/accounts/1/projects/2/credentials/3/"},
+		{http.MethodDelete, "/v3/accounts/1/projects/2/credentials/3/"},
+	}
+	if len(requests) != len(expected) {
+		t.Fatalf("expected %d requests, got %d: %+v", len(expected), len(requests), requests)
+	}
+	for i, e := range expected {
+		if requests[i].method != e.method || requests[i].uri != e.uri {
+			t.Errorf("expected the request %d to be %s %s, got %s %s", i, e.method, e.uri, requests[i].method, requests[i].uri)
+		}
+	}
+	if requests[1].body["state"] != float64(STATE_DELETED) {
+		t.Errorf("expected the update to send the whole credential, got %v", requests[1].body)
+	}
+
+	// the credentials without related objects don't add include_related
+	requests = nil
+	if _, err := c.GetSnowflakeCredential(ctx, 2, 3); err != nil {
+		t.Fatal(err)
+	}
+	if requests[0].uri != "/v3/accounts/1/projects/2/credentials/3/" {
+		t.Errorf("expected no include_related, got %s", requests[0].uri)
+	}
+}
@@ -0,0 +1,94 @@
+package dbt_cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+// credentialsCheck keeps the result of the lazy credentials validation for a client
+type credentialsCheck struct {
+	mu   sync.Mutex
+	done bool
+	err  error
+}
+
+// validateCredentials checks that the token is valid and has access to the account
+// when `lazy` is true, tokens that are not allowed to list the accounts are accepted as the
+// actual API call will report its own missing permission
+func (c *Client) validateCredentials(ctx context.Context, lazy bool) error {
+	url := c.APIURL(API_V2, "accounts/")
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+
+	body, err := c.sendRequest(req)
+
+	var permissionErr *PermissionDeniedError
+	if errors.As(err, &permissionErr) {
+		if permissionErr.StatusCode == http.StatusUnauthorized {
+			return fmt.Errorf(
+				"the token is not valid for %s, it might have expired or been revoked: %w",
+				c.HostURL,
+				err,
+			)
+		}
+		if lazy {
+			return nil
+		}
+		return fmt.Errorf(
+			"the token is valid but is not allowed to list the accounts (GET /v2/accounts/), which is needed to validate the access to the account %d. "+
+				"The token needs a permission set with read access to the account, or `skip_credentials_validation` can be set to skip this check: %w",
+			c.AccountID,
+			err,
+		)
+	}
+	if err != nil {
+		return err
+	}
+
+	ar := AuthResponse{}
+	err = json.Unmarshal(body, &ar)
+	if err != nil {
+		return err
+	}
+
+	for _, account := range ar.Data {
+		if account.Id == c.AccountID {
+			c.AccountURL = url
+			return nil
+		}
+	}
+
+	return fmt.Errorf(
+		"the token is valid but does not have access to the account id %d. "+
+			"The token needs to be created in this account, or the user owning it needs to be a member of it. "+
+			"This can also happen when IP restrictions are in place for the account and the requests come from an IP not allowed",
+		c.AccountID,
+	)
+}
+
+// checkCredentials runs the credentials validation once per client, on the first API call
+// cancellations are not cached so that the next call validates the credentials again
+func (c *Client) checkCredentials(ctx context.Context) error {
+	c.credentialsCheck.mu.Lock()
+	defer c.credentialsCheck.mu.Unlock()
+
+	if c.credentialsCheck.done {
+		return c.credentialsCheck.err
+	}
+
+	err := c.validateCredentials(ctx, true)
+	if ctx.Err() != nil {
+		return err
+	}
+
+	c.credentialsCheck.done = true
+	c.credentialsCheck.err = err
+	return err
+}
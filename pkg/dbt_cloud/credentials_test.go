@@ -0,0 +1,101 @@
+package dbt_cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+// newAccountsServer returns a server listing the accounts with the given status and IDs
+func newAccountsServer(accountsStatus int, accountIDs string, accountCalls *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/accounts/" {
+			accountCalls.Add(1)
+			w.WriteHeader(accountsStatus)
+			w.Write([]byte(`{"data": [` + accountIDs + `], "status": {"code": 200}}`))
+			return
+		}
+		w.Write([]byte(`{"data": {}}`))
+	}))
+}
+
+func TestNewClientCredentialsValidation(t *testing.T) {
+	// NewClient doesn't validate the credentials when running acceptance tests
+	if tfAcc, ok := os.LookupEnv("TF_ACC"); ok {
+		os.Unsetenv("TF_ACC")
+		t.Cleanup(func() { os.Setenv("TF_ACC", tfAcc) })
+	}
+
+	testCases := []struct {
+		name           string
+		accountsStatus int
+		accountIDs     string
+		skip           bool
+		newClientError string
+		requestError   string
+	}{
+		{name: "valid", accountsStatus: 200, accountIDs: `{"id": 1}`},
+		{name: "valid skipped", accountsStatus: 200, accountIDs: `{"id": 1}`, skip: true},
+		{name: "no access to the account", accountsStatus: 200, accountIDs: `{"id": 2}`, newClientError: "does not have access to the account id 1"},
+		{name: "no access to the account skipped", accountsStatus: 200, accountIDs: `{"id": 2}`, skip: true, requestError: "does not have access to the account id 1"},
+		{name: "invalid token", accountsStatus: 401, newClientError: "the token is not valid"},
+		{name: "invalid token skipped", accountsStatus: 401, skip: true, requestError: "the token is not valid"},
+		{name: "not allowed to list accounts", accountsStatus: 403, newClientError: "not allowed to list the accounts"},
+		{name: "not allowed to list accounts skipped", accountsStatus: 403, skip: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var accountCalls atomic.Int32
+			server := newAccountsServer(tc.accountsStatus, tc.accountIDs, &accountCalls)
+			defer server.Close()
+
+			ctx := context.Background()
+			accountID := 1
+			token := "token"
+			hostURL := server.URL
+
+			c, err := NewClient(ctx, &accountID, &token, &hostURL, &ClientConfig{
+				RetryMaxAttempts:          1,
+				SkipCredentialsValidation: tc.skip,
+			})
+			if tc.newClientError != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.newClientError) {
+					t.Fatalf("expected an error containing %q, got %v", tc.newClientError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expectedCalls := int32(1)
+			if tc.skip {
+				expectedCalls = 0
+			}
+			if calls := accountCalls.Load(); calls != expectedCalls {
+				t.Fatalf("expected %d calls to validate the credentials when creating the client, got %d", expectedCalls, calls)
+			}
+
+			// the lazy validation is only done once per client, whatever the result
+			for i := 0; i < 2; i++ {
+				req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/v3/accounts/1/projects/", nil)
+				_, err = c.doRequest(req)
+				if tc.requestError != "" {
+					if err == nil || !strings.Contains(err.Error(), tc.requestError) {
+						t.Fatalf("expected an error containing %q, got %v", tc.requestError, err)
+					}
+				} else if err != nil {
+					t.Fatal(err)
+				}
+			}
+			if calls := accountCalls.Load(); calls != 1 {
+				t.Errorf("expected the credentials to be validated once, got %d calls", calls)
+			}
+		})
+	}
+}
@@ -1,22 +1,10 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
-	"net/http"
-	"strings"
 )
 
-type DatabricksCredentialListResponse struct {
-	Data   []DatabricksCredential `json:"data"`
-	Status ResponseStatus         `json:"status"`
-}
-
-type DatabricksCredentialResponse struct {
-	Data   DatabricksCredential `json:"data"`
-	Status ResponseStatus       `json:"status"`
-}
-
 type DatabricksUnencryptedCredentialDetails struct {
 	Catalog    string `json:"catalog"`
 	Schema     string `json:"schema"`
@@ -50,45 +38,26 @@ type DatabricksCredentialGlobConn struct {
 	CredentialDetails AdapterCredentialDetails `json:"credential_details"`
 }
 
+func (DatabricksCredential) IncludeRelated() []string { return []string{"adapter"} }
+
+func (DatabricksCredentialGlobConn) IncludeRelated() []string { return []string{"adapter"} }
+
 type DatabricksCredentialGLobConnPatch struct {
 	ID                int                      `json:"id"`
 	CredentialDetails AdapterCredentialDetails `json:"credential_details"`
 }
 
 func (c *Client) GetDatabricksCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 ) (*DatabricksCredential, error) {
-	req, err := http.NewRequest(
-		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/credentials/%d/?include_related=[adapter]",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-			credentialId,
-		),
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	credentialResponse := DatabricksCredentialResponse{}
-	err = json.Unmarshal(body, &credentialResponse)
-	if err != nil {
-		return nil, err
-	}
-
-	return &credentialResponse.Data, nil
+	client := NewCredentialClient[DatabricksCredential](c)
+	return client.Get(ctx, projectId, credentialId)
 }
 
 func (c *Client) CreateDatabricksCredentialLegacy(
+	ctx context.Context,
 	projectId int,
 	type_ string,
 	targetName string,
@@ -98,6 +67,30 @@ func (c *Client) CreateDatabricksCredentialLegacy(
 	schema string,
 	adapterType string,
 ) (*DatabricksCredential, error) {
+	credentialDetails := GenerateDatabricksCredentialDetailsLegacy(adapterType, token, catalog, schema)
+	newDatabricksCredential := DatabricksCredential{
+		Account_Id:         c.AccountID,
+		Project_Id:         projectId,
+		Type:               type_,
+		State:              STATE_ACTIVE,
+		Threads:            NUM_THREADS_CREDENTIAL,
+		Target_Name:        targetName,
+		Adapter_Id:         adapterId,
+		Credential_Details: credentialDetails,
+	}
+
+	client := NewCredentialClient[DatabricksCredential](c)
+	return client.Create(ctx, projectId, newDatabricksCredential)
+}
+
+// GenerateDatabricksCredentialDetailsLegacy returns the credential details of the credentials
+// of the connections created with the legacy connection resource, for the databricks or spark adapter type
+func GenerateDatabricksCredentialDetailsLegacy(
+	adapterType string,
+	token string,
+	catalog string,
+	schema string,
+) AdapterCredentialDetails {
 	validation := AdapterCredentialFieldMetadataValidation{
 		Required: false,
 	}
@@ -151,9 +144,12 @@ func (c *Client) CreateDatabricksCredentialLegacy(
 
 	// the catalog field is only available for databricks adapter type
 	// there is an issue if we provide the number of threads at the creation
+	// the token is only sent when it is set, so that updates can keep the existing one
 	if adapterType == "databricks" {
 		credentialFields["catalog"] = credentialsFieldCatalog
-		credentialFields["token"] = credentialsFieldToken
+		if token != "" {
+			credentialFields["token"] = credentialsFieldToken
+		}
 		credentialFields["schema"] = credentialsFieldSchema
 	}
 
@@ -164,62 +160,21 @@ func (c *Client) CreateDatabricksCredentialLegacy(
 		credentialFields["threads"] = credentialsFieldThreads
 	}
 
-	credentialDetails := AdapterCredentialDetails{
+	return AdapterCredentialDetails{
 		Fields:      credentialFields,
 		Field_Order: []string{},
 	}
-	newDatabricksCredential := DatabricksCredential{
-		Account_Id:         c.AccountID,
-		Project_Id:         projectId,
-		Type:               type_,
-		State:              STATE_ACTIVE,
-		Threads:            NUM_THREADS_CREDENTIAL,
-		Target_Name:        targetName,
-		Adapter_Id:         adapterId,
-		Credential_Details: credentialDetails,
-	}
-
-	newDatabricksCredentialData, err := json.Marshal(newDatabricksCredential)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(
-		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/credentials/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-		),
-		strings.NewReader(string(newDatabricksCredentialData)),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	databricksCredentialResponse := DatabricksCredentialResponse{}
-	err = json.Unmarshal(body, &databricksCredentialResponse)
-	if err != nil {
-		return nil, err
-	}
-
-	return &databricksCredentialResponse.Data, nil
 }
 
 func (c *Client) CreateDatabricksCredential(
+	ctx context.Context,
 	projectId int,
 	token string,
 	schema string,
 	targetName string,
 	catalog string,
 
-) (*DatabricksCredential, error) {
+) (*DatabricksCredentialGlobConn, error) {
 
 	credentialDetails, err := GenerateDatabricksCredentialDetails(
 		token,
@@ -241,115 +196,8 @@ func (c *Client) CreateDatabricksCredential(
 		CredentialDetails: credentialDetails,
 	}
 
-	newDatabricksCredentialData, err := json.Marshal(newDatabricksCredential)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(
-		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/credentials/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-		),
-		strings.NewReader(string(newDatabricksCredentialData)),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	databricksCredentialResponse := DatabricksCredentialResponse{}
-	err = json.Unmarshal(body, &databricksCredentialResponse)
-	if err != nil {
-		return nil, err
-	}
-
-	return &databricksCredentialResponse.Data, nil
-}
-
-func (c *Client) UpdateDatabricksCredentialLegacy(
-	projectId int,
-	credentialId int,
-	databricksCredential DatabricksCredential,
-) (*DatabricksCredential, error) {
-	databricksCredentialData, err := json.Marshal(databricksCredential)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(
-		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-			credentialId,
-		),
-		strings.NewReader(string(databricksCredentialData)),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	databricksCredentialResponse := DatabricksCredentialResponse{}
-	err = json.Unmarshal(body, &databricksCredentialResponse)
-	if err != nil {
-		return nil, err
-	}
-
-	return &databricksCredentialResponse.Data, nil
-}
-
-func (c *Client) UpdateDatabricksCredentialGlobConn(
-	projectId int,
-	credentialId int,
-	databricksCredential DatabricksCredentialGLobConnPatch,
-) (*DatabricksCredential, error) {
-	databricksCredentialData, err := json.Marshal(databricksCredential)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(
-		"PATCH",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-			credentialId,
-		),
-		strings.NewReader(string(databricksCredentialData)),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	databricksCredentialResponse := DatabricksCredentialResponse{}
-	err = json.Unmarshal(body, &databricksCredentialResponse)
-	if err != nil {
-		return nil, err
-	}
-
-	return &databricksCredentialResponse.Data, nil
+	client := NewCredentialClient[DatabricksCredentialGlobConn](c)
+	return client.Create(ctx, projectId, newDatabricksCredential)
 }
 
 func GenerateDatabricksCredentialDetails(
@@ -1,8 +1,8 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strings"
 )
@@ -17,7 +17,7 @@ type Environment struct {
 	State                        int                  `json:"state,omitempty"`
 	Account_Id                   int                  `json:"account_id"`
 	Project_Id                   int                  `json:"project_id"`
-	Credential_Id                *int                 `json:"credentials_id,omitempty"`
+	Credential_Id                *int                 `json:"credentials_id"` // sent as null to remove the credential
 	Name                         string               `json:"name"`
 	Dbt_Version                  string               `json:"dbt_version"`
 	Type                         string               `json:"type"`
@@ -37,16 +37,11 @@ type Environment struct {
 	EnableModelQueryHistory      bool                 `json:"enable_model_query_history,omitempty"`
 }
 
-func (c *Client) GetEnvironment(projectId int, environmentId int) (*Environment, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetEnvironment(ctx context.Context, projectId int, environmentId int) (*Environment, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/environments/%d/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-			environmentId,
-		),
+		c.V3AccountURL("projects/%d/environments/%d/", projectId, environmentId),
 		nil,
 	)
 	if err != nil {
@@ -69,6 +64,7 @@ func (c *Client) GetEnvironment(projectId int, environmentId int) (*Environment,
 }
 
 func (c *Client) CreateEnvironment(
+	ctx context.Context,
 	isActive bool,
 	projectId int,
 	name string,
@@ -117,14 +113,10 @@ func (c *Client) CreateEnvironment(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/environments/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-		),
+		c.V3AccountURL("projects/%d/environments/", projectId),
 		strings.NewReader(string(newEnvironmentData)),
 	)
 	if err != nil {
@@ -147,6 +139,7 @@ func (c *Client) CreateEnvironment(
 }
 
 func (c *Client) UpdateEnvironment(
+	ctx context.Context,
 	projectId int,
 	environmentId int,
 	environment Environment,
@@ -160,15 +153,10 @@ func (c *Client) UpdateEnvironment(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/environments/%d/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-			environmentId,
-		),
+		c.V3AccountURL("projects/%d/environments/%d/", projectId, environmentId),
 		strings.NewReader(string(environmentData)),
 	)
 	if err != nil {
@@ -190,16 +178,11 @@ func (c *Client) UpdateEnvironment(
 	return &environmentResponse.Data, nil
 }
 
-func (c *Client) DeleteEnvironment(projectId, environmentId int) (string, error) {
-	req, err := http.NewRequest(
+func (c *Client) DeleteEnvironment(ctx context.Context, projectId, environmentId int) (string, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/environments/%d/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-			environmentId,
-		),
+		c.V3AccountURL("projects/%d/environments/%d/", projectId, environmentId),
 		nil,
 	)
 	if err != nil {
@@ -0,0 +1,41 @@
+package dbt_cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUpdateEnvironmentRemovesCredential(t *testing.T) {
+	t.Parallel()
+
+	var sent map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+			t.Error(err)
+		}
+		w.Write([]byte(`{"data": {"id": 2, "project_id": 1, "credentials_id": null}}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL)
+	credentialID := 3
+	environment := Environment{Project_Id: 1, Name: "Prod", Credential_Id: &credentialID}
+
+	// the resource sets the credential to nil when credential_id is removed from the config
+	environment.Credential_Id = nil
+	updated, err := c.UpdateEnvironment(context.Background(), 1, 2, environment)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	value, ok := sent["credentials_id"]
+	if !ok || value != nil {
+		t.Errorf("expected credentials_id to be sent as null, got %v (sent: %t)", value, ok)
+	}
+	if updated.Credential_Id != nil {
+		t.Errorf("expected the updated environment to have no credential, got %d", *updated.Credential_Id)
+	}
+}
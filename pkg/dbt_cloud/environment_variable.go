@@ -1,10 +1,13 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
+	"sort"
 	"strings"
+
+	"github.com/samber/lo"
 )
 
 type EnvironmentVariable struct {
@@ -38,18 +41,12 @@ type CreateEnvironmentVariableResponse struct {
 	Status ResponseStatus                           `json:"status"`
 }
 
-func (c *Client) GetEnvironmentVariable(
-	projectID int,
-	environmentVariableName string,
-) (*EnvironmentVariable, error) {
-	req, err := http.NewRequest(
+// getEnvironmentVariables returns all the environment variables of a project with their values per environment
+func (c *Client) getEnvironmentVariables(ctx context.Context, projectID int) (*EnvironmentVariablesGet, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/environment-variables/environment/",
-			c.HostURL,
-			c.AccountID,
-			projectID,
-		),
+		c.V3AccountURL("projects/%d/environment-variables/environment/", projectID),
 		nil,
 	)
 	if err != nil {
@@ -67,29 +64,67 @@ func (c *Client) GetEnvironmentVariable(
 		return nil, err
 	}
 
-	environmentsVariables, _ := environmentVariableResponse.Data.Variables[environmentVariableName]
-	if environmentsVariables == nil {
-		return nil, fmt.Errorf(
-			"resource-not-found: Environment variables %s not found in project ID %d",
-			environmentVariableName,
-			projectID,
-		)
-	}
+	return &environmentVariableResponse.Data, nil
+}
+
+func newEnvironmentVariable(
+	projectID int,
+	name string,
+	environmentsVariables map[string]EnvironmentVariableNameValue,
+) EnvironmentVariable {
 	environmentValues := make(map[string]string)
 	for environmentName, environmentVariableNameValue := range environmentsVariables {
 		environmentValues[environmentName] = environmentVariableNameValue.Value
 	}
 
-	environmentVariable := EnvironmentVariable{
-		Name:                  environmentVariableName,
+	return EnvironmentVariable{
+		Name:                  name,
 		ProjectID:             projectID,
 		EnvironmentNameValues: environmentValues,
 	}
+}
+
+func (c *Client) GetEnvironmentVariable(
+	ctx context.Context,
+	projectID int,
+	environmentVariableName string,
+) (*EnvironmentVariable, error) {
+	environmentVariables, err := c.getEnvironmentVariables(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	environmentsVariables, _ := environmentVariables.Variables[environmentVariableName]
+	if environmentsVariables == nil {
+		return nil, NewNotFoundError(
+			"Environment variables %s not found in project ID %d",
+			environmentVariableName,
+			projectID,
+		)
+	}
+
+	environmentVariable := newEnvironmentVariable(projectID, environmentVariableName, environmentsVariables)
 
 	return &environmentVariable, nil
 }
 
+// GetAllEnvironmentVariables returns the environment variables of a project, ordered by name
+func (c *Client) GetAllEnvironmentVariables(ctx context.Context, projectID int) ([]EnvironmentVariable, error) {
+	environmentVariables, err := c.getEnvironmentVariables(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	names := lo.Keys(environmentVariables.Variables)
+	sort.Strings(names)
+
+	return lo.Map(names, func(name string, _ int) EnvironmentVariable {
+		return newEnvironmentVariable(projectID, name, environmentVariables.Variables[name])
+	}), nil
+}
+
 func (c *Client) CreateEnvironmentVariable(
+	ctx context.Context,
 	projectID int,
 	name string,
 	environmentValues map[string]string,
@@ -104,14 +139,10 @@ func (c *Client) CreateEnvironmentVariable(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/environment-variables/bulk/",
-			c.HostURL,
-			c.AccountID,
-			projectID,
-		),
+		c.V3AccountURL("projects/%d/environment-variables/bulk/", projectID),
 		strings.NewReader(string(newEnvironmentVariableData)),
 	)
 	if err != nil {
@@ -138,6 +169,7 @@ func (c *Client) CreateEnvironmentVariable(
 }
 
 func (c *Client) UpdateEnvironmentVariable(
+	ctx context.Context,
 	projectID int,
 	environmentVariable EnvironmentVariable,
 ) (*EnvironmentVariable, error) {
@@ -152,14 +184,10 @@ func (c *Client) UpdateEnvironmentVariable(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PUT",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/environment-variables/bulk/",
-			c.HostURL,
-			c.AccountID,
-			projectID,
-		),
+		c.V3AccountURL("projects/%d/environment-variables/bulk/", projectID),
 		strings.NewReader(string(environmentVariableData)),
 	)
 	if err != nil {
@@ -192,18 +220,15 @@ func (c *Client) UpdateEnvironmentVariable(
 }
 
 func (c *Client) DeleteEnvironmentVariable(
+	ctx context.Context,
 	environmentVariableName string,
 	projectID int,
 ) (string, error) {
 	environmentVariableData, err := json.Marshal(map[string]string{"name": environmentVariableName})
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/environment-variables/bulk/",
-			c.HostURL,
-			c.AccountID,
-			projectID,
-		),
+		c.V3AccountURL("projects/%d/environment-variables/bulk/", projectID),
 		strings.NewReader(string(environmentVariableData)),
 	)
 	if err != nil {
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -28,19 +29,15 @@ type EnvironmentVariableJobOverrideAllResponse struct {
 }
 
 func (c *Client) GetEnvironmentVariableJobOverride(
+	ctx context.Context,
 	projectID int,
 	jobDefinitionID int,
 	environmentVariableOverrideID int,
 ) (*EnvironmentVariableJobOverride, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/environment-variables/job/?job_definition_id=%d",
-			c.HostURL,
-			c.AccountID,
-			projectID,
-			jobDefinitionID,
-		),
+		c.V3AccountURL("projects/%d/environment-variables/job/?job_definition_id=%d", projectID, jobDefinitionID),
 		nil,
 	)
 	if err != nil {
@@ -90,13 +87,14 @@ func (c *Client) GetEnvironmentVariableJobOverride(
 
 	}
 
-	return nil, fmt.Errorf(
-		"resource-not-found: Did not find the override %d",
+	return nil, NewNotFoundError(
+		"Did not find the override %d",
 		environmentVariableOverrideID,
 	)
 }
 
 func (c *Client) CreateEnvironmentVariableJobOverride(
+	ctx context.Context,
 	projectID int,
 	name string,
 	rawValue string,
@@ -118,14 +116,10 @@ func (c *Client) CreateEnvironmentVariableJobOverride(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/environment-variables/",
-			c.HostURL,
-			c.AccountID,
-			projectID,
-		),
+		c.V3AccountURL("projects/%d/environment-variables/", projectID),
 		strings.NewReader(string(envOverrideData)),
 	)
 	if err != nil {
@@ -147,6 +141,7 @@ func (c *Client) CreateEnvironmentVariableJobOverride(
 }
 
 func (c *Client) UpdateEnvironmentVariableJobOverride(
+	ctx context.Context,
 	projectID int,
 	environmentVariableJobOverrideID int,
 	environmentVariableJobOverride EnvironmentVariableJobOverride,
@@ -157,15 +152,10 @@ func (c *Client) UpdateEnvironmentVariableJobOverride(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/environment-variables/%d/",
-			c.HostURL,
-			c.AccountID,
-			projectID,
-			environmentVariableJobOverrideID,
-		),
+		c.V3AccountURL("projects/%d/environment-variables/%d/", projectID, environmentVariableJobOverrideID),
 		strings.NewReader(string(envOverrideData)),
 	)
 	if err != nil {
@@ -187,18 +177,14 @@ func (c *Client) UpdateEnvironmentVariableJobOverride(
 }
 
 func (c *Client) DeleteEnvironmentVariableJobOverride(
+	ctx context.Context,
 	projectID int,
 	environmentVariableJobOverrideID int,
 ) (string, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/environment-variables/%d/",
-			c.HostURL,
-			c.AccountID,
-			projectID,
-			environmentVariableJobOverrideID,
-		),
+		c.V3AccountURL("projects/%d/environment-variables/%d/", projectID, environmentVariableJobOverrideID),
 		nil,
 	)
 	if err != nil {
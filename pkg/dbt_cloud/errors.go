@@ -0,0 +1,139 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// APIRequestError is the common part of all the errors returned by the dbt Cloud API
+// it is embedded in the specific error types so that callers can use errors.As on them
+type APIRequestError struct {
+	Method           string
+	URL              string
+	StatusCode       int
+	UserMessage      string
+	DeveloperMessage string
+	Body             string
+}
+
+func (e *APIRequestError) Error() string {
+	details := fmt.Sprintf(
+		"%s url: %s, status: %d, body: %s",
+		e.Method,
+		e.URL,
+		e.StatusCode,
+		e.Body,
+	)
+	if message := e.Message(); message != "" {
+		return fmt.Sprintf("%s\n%s", message, details)
+	}
+	return details
+}
+
+// Message returns the message from dbt Cloud, favouring the one intended for users
+func (e *APIRequestError) Message() string {
+	if e.UserMessage != "" {
+		return e.UserMessage
+	}
+	return e.DeveloperMessage
+}
+
+// NotFoundError is returned when the object requested doesn't exist (anymore) in dbt Cloud
+type NotFoundError struct {
+	APIRequestError
+}
+
+func (e *NotFoundError) Error() string {
+	if e.StatusCode == 0 {
+		// the error was raised by the client, not by the API
+		return fmt.Sprintf("resource-not-found: %s", e.UserMessage)
+	}
+	if message := e.Message(); message != "" {
+		return fmt.Sprintf("resource-not-found: %s: %s", e.URL, message)
+	}
+	return fmt.Sprintf("resource-not-found: %s", e.URL)
+}
+
+// PermissionDeniedError is returned for 401 and 403, when the token can't access the object
+type PermissionDeniedError struct {
+	APIRequestError
+}
+
+func (e *PermissionDeniedError) Error() string {
+	return "permission-denied: " + e.APIRequestError.Error()
+}
+
+// ConflictError is returned when the object conflicts with an existing one, e.g. a duplicate name
+type ConflictError struct {
+	APIRequestError
+}
+
+func (e *ConflictError) Error() string {
+	return "conflict: " + e.APIRequestError.Error()
+}
+
+// ValidationError is returned when dbt Cloud rejects the payload sent
+type ValidationError struct {
+	APIRequestError
+}
+
+func (e *ValidationError) Error() string {
+	return "validation-error: " + e.APIRequestError.Error()
+}
+
+// RateLimitedError is returned when we are still throttled by dbt Cloud after all the retries
+type RateLimitedError struct {
+	APIRequestError
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitedError) Error() string {
+	return "rate-limited: " + e.APIRequestError.Error()
+}
+
+// NewNotFoundError creates a not found error for objects that the client itself couldn't find,
+// for example when a list endpoint doesn't contain the ID requested
+func NewNotFoundError(format string, a ...any) error {
+	return &NotFoundError{
+		APIRequestError: APIRequestError{
+			UserMessage: fmt.Sprintf(format, a...),
+		},
+	}
+}
+
+// newAPIError converts a non successful response to one of the typed errors
+func newAPIError(req *http.Request, res *http.Response, body []byte) error {
+	var apiErr APIError
+	// the body is not always JSON, e.g. for errors returned by a proxy, so we ignore unmarshalling issues
+	_ = json.Unmarshal(body, &apiErr)
+
+	requestError := APIRequestError{
+		Method:           req.Method,
+		URL:              req.URL.String(),
+		StatusCode:       res.StatusCode,
+		UserMessage:      apiErr.Status.UserMessage,
+		DeveloperMessage: apiErr.Status.DeveloperMessage,
+		Body:             string(body),
+	}
+
+	switch res.StatusCode {
+	case http.StatusNotFound:
+		// a 404 can also be returned for a wrong URL, in that case the body doesn't mention a 404
+		if req.Method == http.MethodGet && apiErr.Status.Code == http.StatusNotFound {
+			return &NotFoundError{requestError}
+		}
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return &PermissionDeniedError{requestError}
+	case http.StatusConflict:
+		return &ConflictError{requestError}
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return &ValidationError{requestError}
+	case http.StatusTooManyRequests:
+		retryAfter, _ := parseRetryAfter(res, time.Now())
+		return &RateLimitedError{requestError, retryAfter}
+	}
+
+	return &requestError
+}
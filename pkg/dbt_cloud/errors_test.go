@@ -0,0 +1,111 @@
+package dbt_cloud
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestNewAPIError(t *testing.T) {
+	t.Parallel()
+
+	apiBody := `{"status": {"code": %d, "is_success": false, "user_message": "Something is wrong", "developer_message": ""}, "data": null}`
+
+	testCases := []struct {
+		name       string
+		method     string
+		statusCode int
+		body       string
+		check      func(err error) bool
+	}{
+		{
+			name:       "404 from the API is a not found",
+			method:     http.MethodGet,
+			statusCode: 404,
+			body:       strings.Replace(apiBody, "%d", "404", 1),
+			check: func(err error) bool {
+				var e *NotFoundError
+				return errors.As(err, &e) &&
+					err.Error() == "resource-not-found: https://cloud.getdbt.com/api/v3/accounts/1/projects/1/: Something is wrong"
+			},
+		},
+		{
+			name:       "404 without a user message uses the developer message",
+			method:     http.MethodGet,
+			statusCode: 404,
+			body:       `{"status": {"code": 404, "is_success": false, "user_message": "", "developer_message": "Project not found"}, "data": null}`,
+			check: func(err error) bool {
+				var e *NotFoundError
+				return errors.As(err, &e) && strings.HasSuffix(err.Error(), ": Project not found")
+			},
+		},
+		{
+			name:       "404 for a wrong URL is not a not found",
+			method:     http.MethodGet,
+			statusCode: 404,
+			body:       `<html>Not Found</html>`,
+			check: func(err error) bool {
+				var e *NotFoundError
+				return !errors.As(err, &e)
+			},
+		},
+		{
+			name:       "403 is a permission denied",
+			method:     http.MethodGet,
+			statusCode: 403,
+			body:       strings.Replace(apiBody, "%d", "403", 1),
+			check: func(err error) bool {
+				var e *PermissionDeniedError
+				return errors.As(err, &e) && e.Message() == "Something is wrong"
+			},
+		},
+		{
+			name:       "409 is a conflict",
+			method:     http.MethodPost,
+			statusCode: 409,
+			body:       strings.Replace(apiBody, "%d", "409", 1),
+			check: func(err error) bool {
+				var e *ConflictError
+				return errors.As(err, &e)
+			},
+		},
+		{
+			name:       "400 is a validation error",
+			method:     http.MethodPost,
+			statusCode: 400,
+			body:       strings.Replace(apiBody, "%d", "400", 1),
+			check: func(err error) bool {
+				var e *ValidationError
+				return errors.As(err, &e) && e.StatusCode == 400
+			},
+		},
+		{
+			name:       "429 is rate limited",
+			method:     http.MethodGet,
+			statusCode: 429,
+			body:       ``,
+			check: func(err error) bool {
+				var e *RateLimitedError
+				return errors.As(err, &e)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			reqURL, _ := url.Parse("https://cloud.getdbt.com/api/v3/accounts/1/projects/1/")
+			req := &http.Request{Method: tc.method, URL: reqURL}
+			res := &http.Response{StatusCode: tc.statusCode, Header: http.Header{}}
+
+			err := newAPIError(req, res, []byte(tc.body))
+			if !tc.check(err) {
+				t.Errorf("unexpected error type %T: %v", err, err)
+			}
+		})
+	}
+}
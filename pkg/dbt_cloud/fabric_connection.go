@@ -1,8 +1,8 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -28,16 +28,11 @@ type FabricConnectionResponse struct {
 	Status ResponseStatus   `json:"status"`
 }
 
-func (c *Client) GetFabricConnection(connectionID, projectID string) (*FabricConnection, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetFabricConnection(ctx context.Context, connectionID, projectID string) (*FabricConnection, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/projects/%s/connections/%s/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			projectID,
-			connectionID,
-		),
+		c.V3AccountURL("projects/%s/connections/%s/", projectID, connectionID),
 		nil,
 	)
 	if err != nil {
@@ -59,6 +54,7 @@ func (c *Client) GetFabricConnection(connectionID, projectID string) (*FabricCon
 }
 
 func (c *Client) CreateFabricConnection(
+	ctx context.Context,
 	projectID int,
 	name string,
 	server string,
@@ -70,7 +66,7 @@ func (c *Client) CreateFabricConnection(
 ) (*FabricConnection, error) {
 
 	connectionDetails := FabricConnectionDetails{}
-	adapterId, err := c.createFabricAdapter(projectID)
+	adapterId, err := c.createFabricAdapter(ctx, projectID)
 	if err != nil {
 		return nil, err
 	}
@@ -102,14 +98,10 @@ func (c *Client) CreateFabricConnection(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/projects/%s/connections/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			strconv.Itoa(projectID),
-		),
+		c.V3AccountURL("projects/%s/connections/", strconv.Itoa(projectID)),
 		strings.NewReader(string(newConnectionData)),
 	)
 	if err != nil {
@@ -131,6 +123,7 @@ func (c *Client) CreateFabricConnection(
 }
 
 func (c *Client) UpdateFabricConnection(
+	ctx context.Context,
 	connectionID, projectID string,
 	connection FabricConnection,
 ) (*FabricConnection, error) {
@@ -139,15 +132,10 @@ func (c *Client) UpdateFabricConnection(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/projects/%s/connections/%s/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			projectID,
-			connectionID,
-		),
+		c.V3AccountURL("projects/%s/connections/%s/", projectID, connectionID),
 		strings.NewReader(string(connectionData)),
 	)
 	if err != nil {
@@ -168,7 +156,7 @@ func (c *Client) UpdateFabricConnection(
 	return &connectionResponse.Data, nil
 }
 
-func (c *Client) createFabricAdapter(projectID int) (*int, error) {
+func (c *Client) createFabricAdapter(ctx context.Context, projectID int) (*int, error) {
 
 	newAdapter := Adapter{
 		ID:             nil,
@@ -183,7 +171,7 @@ func (c *Client) createFabricAdapter(projectID int) (*int, error) {
 		},
 	}
 
-	return createGenericAdapter(c, newAdapter, projectID)
+	return createGenericAdapter(ctx, c, newAdapter, projectID)
 }
 
 func GetFabricConnectionDetails(
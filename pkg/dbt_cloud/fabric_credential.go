@@ -1,22 +1,10 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
-	"net/http"
-	"strings"
 )
 
-type FabricCredentialListResponse struct {
-	Data   []FabricCredential `json:"data"`
-	Status ResponseStatus     `json:"status"`
-}
-
-type FabricCredentialResponse struct {
-	Data   FabricCredential `json:"data"`
-	Status ResponseStatus   `json:"status"`
-}
-
 type FabricUnencryptedCredentialDetails struct {
 	Authentication      string `json:"authentication"`
 	User                string `json:"user"`
@@ -38,40 +26,19 @@ type FabricCredential struct {
 	UnencryptedCredentialDetails FabricUnencryptedCredentialDetails `json:"unencrypted_credential_details"`
 }
 
+func (FabricCredential) IncludeRelated() []string { return []string{"adapter"} }
+
 func (c *Client) GetFabricCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 ) (*FabricCredential, error) {
-	req, err := http.NewRequest(
-		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/credentials/%d/?include_related=[adapter]",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-			credentialId,
-		),
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	credentialResponse := FabricCredentialResponse{}
-	err = json.Unmarshal(body, &credentialResponse)
-	if err != nil {
-		return nil, err
-	}
-
-	return &credentialResponse.Data, nil
+	client := NewCredentialClient[FabricCredential](c)
+	return client.Get(ctx, projectId, credentialId)
 }
 
 func (c *Client) CreateFabricCredential(
+	ctx context.Context,
 	projectId int,
 	adapterId int,
 	user string,
@@ -106,37 +73,8 @@ func (c *Client) CreateFabricCredential(
 		CredentialDetails: credentialDetails,
 	}
 
-	newFabricCredentialData, err := json.Marshal(newFabricCredential)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(
-		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/credentials/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-		),
-		strings.NewReader(string(newFabricCredentialData)),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	fabricCredentialResponse := FabricCredentialResponse{}
-	err = json.Unmarshal(body, &fabricCredentialResponse)
-	if err != nil {
-		return nil, err
-	}
-
-	return &fabricCredentialResponse.Data, nil
+	client := NewCredentialClient[FabricCredential](c)
+	return client.Create(ctx, projectId, newFabricCredential)
 }
 
 func GenerateFabricCredentialDetails(
@@ -359,42 +297,3 @@ func GenerateFabricCredentialDetails(
 	}
 	return credentialDetails, nil
 }
-
-func (c *Client) UpdateFabricCredential(
-	projectId int,
-	credentialId int,
-	fabricCredential FabricCredential,
-) (*FabricCredential, error) {
-	fabricCredentialData, err := json.Marshal(fabricCredential)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(
-		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-			credentialId,
-		),
-		strings.NewReader(string(fabricCredentialData)),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	fabricCredentialResponse := FabricCredentialResponse{}
-	err = json.Unmarshal(body, &fabricCredentialResponse)
-	if err != nil {
-		return nil, err
-	}
-
-	return &fabricCredentialResponse.Data, nil
-}
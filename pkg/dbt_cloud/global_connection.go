@@ -2,6 +2,7 @@ package dbt_cloud
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -23,15 +24,11 @@ type GlobalConnectionAdapter struct {
 	} `json:"data"`
 }
 
-func (c *Client) GetGlobalConnectionAdapter(connectionID int64) (*GlobalConnectionAdapter, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetGlobalConnectionAdapter(ctx context.Context, connectionID int64) (*GlobalConnectionAdapter, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/connections/%d/",
-			c.HostURL,
-			c.AccountID,
-			connectionID,
-		),
+		c.V3AccountURL("connections/%d/", connectionID),
 		nil,
 	)
 	if err != nil {
@@ -82,15 +79,11 @@ func NewGlobalConnectionClient[T GlobalConnectionConfig](c *Client) GlobalConnec
 	}
 }
 
-func (c *GlobalConnectionClient[T]) Get(connectionID int64) (*GlobalConnectionCommon, *T, error) {
-	req, err := http.NewRequest(
+func (c *GlobalConnectionClient[T]) Get(ctx context.Context, connectionID int64) (*GlobalConnectionCommon, *T, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/connections/%d/",
-			c.HostURL,
-			c.AccountID,
-			connectionID,
-		),
+		c.V3AccountURL("connections/%d/", connectionID),
 		nil,
 	)
 
@@ -114,6 +107,7 @@ func (c *GlobalConnectionClient[T]) Get(connectionID int64) (*GlobalConnectionCo
 }
 
 func (c *GlobalConnectionClient[T]) Create(
+	ctx context.Context,
 	common GlobalConnectionCommon,
 	config T,
 ) (*GlobalConnectionCommon, *T, error) {
@@ -135,13 +129,10 @@ func (c *GlobalConnectionClient[T]) Create(
 		return nil, nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/connections/",
-			c.HostURL,
-			c.AccountID,
-		),
+		c.V3AccountURL("connections/"),
 		buffer,
 	)
 	if err != nil {
@@ -164,6 +155,7 @@ func (c *GlobalConnectionClient[T]) Create(
 }
 
 func (c *GlobalConnectionClient[T]) Update(
+	ctx context.Context,
 	connectionID int64,
 	common GlobalConnectionCommon,
 	config T,
@@ -183,14 +175,10 @@ func (c *GlobalConnectionClient[T]) Update(
 		return nil, nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PATCH",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/connections/%d/",
-			c.HostURL,
-			c.AccountID,
-			connectionID,
-		),
+		c.V3AccountURL("connections/%d/", connectionID),
 		buffer,
 	)
 	if err != nil {
@@ -211,15 +199,11 @@ func (c *GlobalConnectionClient[T]) Update(
 	return &resp.Data.GlobalConnectionCommon, &resp.Data.Config, nil
 }
 
-func (c *Client) DeleteGlobalConnection(connectionID int64) (string, error) {
-	req, err := http.NewRequest(
+func (c *Client) DeleteGlobalConnection(ctx context.Context, connectionID int64) (string, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/connections/%d/",
-			c.HostURL,
-			c.AccountID,
-			connectionID,
-		),
+		c.V3AccountURL("connections/%d/", connectionID),
 		nil,
 	)
 	if err != nil {
@@ -258,16 +242,13 @@ type GlobalConnectionEncryptionPayload struct {
 }
 
 func (c *GlobalConnectionClient[T]) GetEncryptionsForConnection(
+	ctx context.Context,
 	connectionID int64,
 ) (*[]GlobalConnectionEncryptionPayload, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf(
-			"%s/v2/accounts/%d/encryptions/?connection_id=%d&state=1",
-			c.HostURL,
-			c.AccountID,
-			connectionID,
-		),
+		c.V2AccountURL("encryptions/?connection_id=%d&state=1", connectionID),
 		nil,
 	)
 
@@ -295,6 +276,7 @@ func (c *GlobalConnectionClient[T]) GetEncryptionsForConnection(
 }
 
 func (c *GlobalConnectionClient[T]) CreateUpdateEncryption(
+	ctx context.Context,
 	encryptionPayload GlobalConnectionEncryptionPayload,
 ) (*GlobalConnectionEncryptionPayload, error) {
 
@@ -311,22 +293,13 @@ func (c *GlobalConnectionClient[T]) CreateUpdateEncryption(
 
 	if encryptionID == nil {
 		// create
-		postURL = fmt.Sprintf(
-			"%s/v2/accounts/%d/encryptions/",
-			c.HostURL,
-			c.AccountID,
-		)
+		postURL = c.V2AccountURL("encryptions/")
 	} else {
 		// update
-		postURL = fmt.Sprintf(
-			"%s/v2/accounts/%d/encryptions/%d/",
-			c.HostURL,
-			c.AccountID,
-			*encryptionID,
-		)
+		postURL = c.V2AccountURL("encryptions/%d/", *encryptionID)
 	}
 
-	req, err := http.NewRequest("POST", postURL, buffer)
+	req, err := http.NewRequestWithContext(ctx, "POST", postURL, buffer)
 	if err != nil {
 		return nil, err
 	}
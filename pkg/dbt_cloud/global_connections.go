@@ -1,8 +1,7 @@
 package dbt_cloud
 
 import (
-	"encoding/json"
-	"fmt"
+	"context"
 )
 
 type GlobalConnectionSummary struct {
@@ -18,26 +17,9 @@ type GlobalConnectionSummary struct {
 	EnvironmentCount      int64   `json:"environment__count"`
 }
 
-func (c *Client) GetAllConnections() ([]GlobalConnectionSummary, error) {
+func (c *Client) GetAllConnections(ctx context.Context) ([]GlobalConnectionSummary, error) {
 
-	url := fmt.Sprintf(
-		`%s/v3/accounts/%d/connections/`,
-		c.HostURL,
-		c.AccountID,
-	)
+	url := c.V3AccountURL("connections/")
 
-	allConnectionsRaw := c.GetData(url)
-
-	allConnections := []GlobalConnectionSummary{}
-	for _, connection := range allConnectionsRaw {
-
-		data, _ := json.Marshal(connection)
-		currentConnection := GlobalConnectionSummary{}
-		err := json.Unmarshal(data, &currentConnection)
-		if err != nil {
-			return nil, err
-		}
-		allConnections = append(allConnections, currentConnection)
-	}
-	return allConnections, nil
+	return GetAll[GlobalConnectionSummary](ctx, c, url, nil)
 }
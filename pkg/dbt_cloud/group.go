@@ -1,8 +1,8 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -40,15 +40,11 @@ type GroupPermissionListResponse struct {
 	Status ResponseStatus    `json:"status"`
 }
 
-func (c *Client) GetGroup(groupID int) (*Group, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetGroup(ctx context.Context, groupID int) (*Group, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/groups/%s/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			strconv.Itoa(groupID),
-		),
+		c.V3AccountURL("groups/%s/", strconv.Itoa(groupID)),
 		nil,
 	)
 	if err != nil {
@@ -70,6 +66,7 @@ func (c *Client) GetGroup(groupID int) (*Group, error) {
 }
 
 func (c *Client) CreateGroup(
+	ctx context.Context,
 	name string,
 	assignByDefault bool,
 	ssoMappingGroups []string,
@@ -86,9 +83,10 @@ func (c *Client) CreateGroup(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf("%s/v3/accounts/%d/groups/", c.HostURL, c.AccountID),
+		c.V3AccountURL("groups/"),
 		strings.NewReader(string(newGroupData)),
 	)
 	if err != nil {
@@ -109,15 +107,16 @@ func (c *Client) CreateGroup(
 	return &groupResponse.Data, nil
 }
 
-func (c *Client) UpdateGroup(groupID int, group Group) (*Group, error) {
+func (c *Client) UpdateGroup(ctx context.Context, groupID int, group Group) (*Group, error) {
 	groupData, err := json.Marshal(group)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf("%s/v3/accounts/%s/groups/%d/", c.HostURL, strconv.Itoa(c.AccountID), groupID),
+		c.V3AccountURL("groups/%d/", groupID),
 		strings.NewReader(string(groupData)),
 	)
 	if err != nil {
@@ -139,6 +138,7 @@ func (c *Client) UpdateGroup(groupID int, group Group) (*Group, error) {
 }
 
 func (c *Client) UpdateGroupPermissions(
+	ctx context.Context,
 	groupID int,
 	groupPermissions []GroupPermission,
 ) (*[]GroupPermission, error) {
@@ -147,14 +147,10 @@ func (c *Client) UpdateGroupPermissions(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/group-permissions/%d/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			groupID,
-		),
+		c.V3AccountURL("group-permissions/%d/", groupID),
 		strings.NewReader(string(groupPermissionData)),
 	)
 	if err != nil {
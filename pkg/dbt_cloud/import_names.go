@@ -0,0 +1,318 @@
+package dbt_cloud
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+const (
+	IMPORT_NAME_DELIMITER = "/"
+	IMPORT_NAME_SEPARATOR = "="
+)
+
+// IsImportByName returns true when an import ID uses names instead of IDs
+// e.g. name=Analytics or project=Analytics/environment=Prod
+func IsImportByName(importID string) bool {
+	return strings.Contains(importID, IMPORT_NAME_SEPARATOR)
+}
+
+// ParseImportNames parses an import ID made of key=value segments separated by /
+// the keys need to be in the order of allowedKeys, and all the requiredKeys need to be provided
+// a / followed by an unknown key is kept in the value, so that names can contain /
+func ParseImportNames(
+	importID string,
+	resourceType string,
+	allowedKeys []string,
+	requiredKeys []string,
+) (map[string]string, error) {
+	formatErr := fmt.Errorf(
+		"expected an ID or names in the format %s to import a %s, got: %s",
+		importNamesFormat(allowedKeys, requiredKeys),
+		resourceType,
+		importID,
+	)
+
+	names := map[string]string{}
+	keys := []string{}
+	for _, segment := range strings.Split(importID, IMPORT_NAME_DELIMITER) {
+		key, value, found := strings.Cut(segment, IMPORT_NAME_SEPARATOR)
+		if found && lo.Contains(allowedKeys, key) {
+			if _, ok := names[key]; ok {
+				return nil, formatErr
+			}
+			names[key] = value
+			keys = append(keys, key)
+			continue
+		}
+		if len(keys) == 0 {
+			return nil, formatErr
+		}
+		lastKey := keys[len(keys)-1]
+		names[lastKey] += IMPORT_NAME_DELIMITER + segment
+	}
+
+	// the keys need to follow the order of allowedKeys, e.g. project before environment
+	lastIndex := -1
+	for _, key := range keys {
+		index := lo.IndexOf(allowedKeys, key)
+		if index < lastIndex {
+			return nil, formatErr
+		}
+		lastIndex = index
+	}
+
+	for _, key := range requiredKeys {
+		if names[key] == "" {
+			return nil, formatErr
+		}
+	}
+
+	return names, nil
+}
+
+func importNamesFormat(allowedKeys []string, requiredKeys []string) string {
+	segments := []string{}
+	for _, key := range allowedKeys {
+		segment := fmt.Sprintf("%s%s<%s name>", key, IMPORT_NAME_SEPARATOR, key)
+		if key == "name" {
+			segment = fmt.Sprintf("%s%s<name>", key, IMPORT_NAME_SEPARATOR)
+		}
+		if !lo.Contains(requiredKeys, key) {
+			segment = "[" + segment + "]"
+		}
+		segments = append(segments, segment)
+	}
+	return "'" + strings.Join(segments, IMPORT_NAME_DELIMITER) + "'"
+}
+
+// matchName returns the ID of the only object with the given name
+// and an error listing the IDs when several objects have the same name
+func matchName[T any](
+	objectType string,
+	name string,
+	objects []T,
+	getName func(T) string,
+	getID func(T) int,
+) (int, error) {
+	matchingIDs := []int{}
+	for _, object := range objects {
+		if getName(object) == name {
+			matchingIDs = append(matchingIDs, getID(object))
+		}
+	}
+
+	switch len(matchingIDs) {
+	case 0:
+		return 0, fmt.Errorf("did not find any %s with the name %q", objectType, name)
+	case 1:
+		return matchingIDs[0], nil
+	default:
+		ids := lo.Map(matchingIDs, func(id int, _ int) string { return strconv.Itoa(id) })
+		return 0, fmt.Errorf(
+			"found %d %ss with the name %q (IDs %s), use the ID to import it",
+			len(matchingIDs),
+			objectType,
+			name,
+			strings.Join(ids, ", "),
+		)
+	}
+}
+
+func (c *Client) getProjectIDByName(ctx context.Context, name string) (int, error) {
+	projects, err := c.GetAllProjects(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	projects = lo.Filter(projects, func(project ProjectConnectionRepository, _ int) bool {
+		return project.State != STATE_DELETED
+	})
+
+	return matchName(
+		"project",
+		name,
+		projects,
+		func(project ProjectConnectionRepository) string { return project.Name },
+		func(project ProjectConnectionRepository) int { return int(project.ID) },
+	)
+}
+
+func (c *Client) getEnvironmentIDByName(ctx context.Context, projectID int, name string) (int, error) {
+	environments, err := c.GetAllEnvironments(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+	environments = lo.Filter(environments, func(environment Environment, _ int) bool {
+		return environment.State != STATE_DELETED && environment.ID != nil
+	})
+
+	return matchName(
+		"environment",
+		name,
+		environments,
+		func(environment Environment) string { return environment.Name },
+		func(environment Environment) int { return *environment.ID },
+	)
+}
+
+// ResolveProjectImportID returns the ID of the project to import from an ID or from name=<name>
+func (c *Client) ResolveProjectImportID(ctx context.Context, importID string) (string, error) {
+	if !IsImportByName(importID) {
+		return importID, nil
+	}
+
+	names, err := ParseImportNames(importID, "dbtcloud_project", []string{"name"}, []string{"name"})
+	if err != nil {
+		return "", err
+	}
+
+	projectID, err := c.getProjectIDByName(ctx, names["name"])
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(projectID), nil
+}
+
+// ResolveEnvironmentImportID returns the ID of the environment to import in the format project_id:environment_id
+// from this format or from project=<project name>/environment=<environment name>
+func (c *Client) ResolveEnvironmentImportID(ctx context.Context, importID string) (string, error) {
+	if !IsImportByName(importID) {
+		return importID, nil
+	}
+
+	keys := []string{"project", "environment"}
+	names, err := ParseImportNames(importID, "dbtcloud_environment", keys, keys)
+	if err != nil {
+		return "", err
+	}
+
+	projectID, err := c.getProjectIDByName(ctx, names["project"])
+	if err != nil {
+		return "", err
+	}
+	environmentID, err := c.getEnvironmentIDByName(ctx, projectID, names["environment"])
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d%s%d", projectID, ID_DELIMITER, environmentID), nil
+}
+
+// ResolveJobImportID returns the ID of the job to import from an ID or from
+// project=<project name>/[environment=<environment name>/]job=<job name>
+// the environment only needs to be provided when the job name is not unique in the project
+func (c *Client) ResolveJobImportID(ctx context.Context, importID string) (string, error) {
+	if !IsImportByName(importID) {
+		return importID, nil
+	}
+
+	names, err := ParseImportNames(
+		importID,
+		"dbtcloud_job",
+		[]string{"project", "environment", "job"},
+		[]string{"project", "job"},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	projectID, err := c.getProjectIDByName(ctx, names["project"])
+	if err != nil {
+		return "", err
+	}
+
+	var jobs []JobWithEnvironment
+	if environmentName, ok := names["environment"]; ok {
+		environmentID, err := c.getEnvironmentIDByName(ctx, projectID, environmentName)
+		if err != nil {
+			return "", err
+		}
+		jobs, err = c.GetAllJobs(ctx, 0, environmentID)
+		if err != nil {
+			return "", err
+		}
+	} else {
+		jobs, err = c.GetAllJobs(ctx, projectID, 0)
+		if err != nil {
+			return "", err
+		}
+	}
+	jobs = lo.Filter(jobs, func(job JobWithEnvironment, _ int) bool {
+		return job.State != STATE_DELETED && job.ID != nil
+	})
+
+	jobID, err := matchName(
+		"job",
+		names["job"],
+		jobs,
+		func(job JobWithEnvironment) string { return job.Name },
+		func(job JobWithEnvironment) int { return *job.ID },
+	)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(jobID), nil
+}
+
+// ResolveGlobalConnectionImportID returns the ID of the global connection to import from an ID or from name=<name>
+func (c *Client) ResolveGlobalConnectionImportID(ctx context.Context, importID string) (string, error) {
+	if !IsImportByName(importID) {
+		return importID, nil
+	}
+
+	names, err := ParseImportNames(importID, "dbtcloud_global_connection", []string{"name"}, []string{"name"})
+	if err != nil {
+		return "", err
+	}
+
+	connections, err := c.GetAllConnections(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	connectionID, err := matchName(
+		"global connection",
+		names["name"],
+		connections,
+		func(connection GlobalConnectionSummary) string { return connection.Name },
+		func(connection GlobalConnectionSummary) int { return int(connection.ID) },
+	)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(connectionID), nil
+}
+
+// ResolveGroupImportID returns the ID of the group to import from an ID or from name=<name>
+func (c *Client) ResolveGroupImportID(ctx context.Context, importID string) (string, error) {
+	if !IsImportByName(importID) {
+		return importID, nil
+	}
+
+	names, err := ParseImportNames(importID, "dbtcloud_group", []string{"name"}, []string{"name"})
+	if err != nil {
+		return "", err
+	}
+
+	groups, err := c.GetAllGroups(ctx)
+	if err != nil {
+		return "", err
+	}
+	groups = lo.Filter(groups, func(group Group, _ int) bool {
+		return group.State == STATE_ACTIVE && group.ID != nil
+	})
+
+	groupID, err := matchName(
+		"group",
+		names["name"],
+		groups,
+		func(group Group) string { return group.Name },
+		func(group Group) int { return *group.ID },
+	)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(groupID), nil
+}
@@ -0,0 +1,190 @@
+package dbt_cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseImportNames(t *testing.T) {
+	t.Parallel()
+
+	jobKeys := []string{"project", "environment", "job"}
+	jobRequiredKeys := []string{"project", "job"}
+
+	testCases := []struct {
+		importID string
+		expected map[string]string
+		err      bool
+	}{
+		{
+			importID: "project=Analytics/job=Daily run",
+			expected: map[string]string{"project": "Analytics", "job": "Daily run"},
+		},
+		{
+			importID: "project=Analytics/environment=Prod/job=Daily run",
+			expected: map[string]string{"project": "Analytics", "environment": "Prod", "job": "Daily run"},
+		},
+		{
+			importID: "project=Sales/EMEA/job=Run a=b",
+			expected: map[string]string{"project": "Sales/EMEA", "job": "Run a=b"},
+		},
+		{importID: "project=Analytics", err: true},
+		{importID: "job=Daily run/project=Analytics", err: true},
+		{importID: "project=Analytics/project=Sales/job=Daily run", err: true},
+		{importID: "name=Analytics", err: true},
+		{importID: "project=/job=Daily run", err: true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.importID, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := ParseImportNames(tc.importID, "dbtcloud_job", jobKeys, jobRequiredKeys)
+			if tc.err {
+				if err == nil {
+					t.Errorf("expected an error, got %v", actual)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestResolveImportIDs(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch r.URL.Path {
+		case "/v3/accounts/1/projects/":
+			w.Write([]byte(`{"data": [
+				{"id": 1, "name": "Analytics", "state": 1},
+				{"id": 2, "name": "Analytics v2", "state": 1},
+				{"id": 3, "name": "Duplicate", "state": 1},
+				{"id": 4, "name": "Duplicate", "state": 1},
+				{"id": 5, "name": "Marketing", "state": 2}
+			], "extra": {"pagination": {"count": 5, "total_count": 5}}}`))
+		case "/v3/accounts/1/environments/":
+			if query.Get("project_id") != "1" {
+				t.Errorf("unexpected request: %s", r.URL)
+			}
+			w.Write([]byte(`{"data": [
+				{"id": 10, "project_id": 1, "name": "Prod", "state": 1},
+				{"id": 11, "project_id": 1, "name": "Staging", "state": 1}
+			], "extra": {"pagination": {"count": 2, "total_count": 2}}}`))
+		case "/v2/accounts/1/jobs/":
+			switch {
+			case query.Get("project_id") == "1":
+				w.Write([]byte(`{"data": [
+					{"id": 100, "environment_id": 10, "name": "Daily run", "state": 1},
+					{"id": 101, "environment_id": 11, "name": "Daily run", "state": 1},
+					{"id": 102, "environment_id": 10, "name": "Hourly run", "state": 1}
+				], "extra": {"pagination": {"count": 3, "total_count": 3}}}`))
+			case query.Get("environment_id") == "10":
+				w.Write([]byte(`{"data": [
+					{"id": 100, "environment_id": 10, "name": "Daily run", "state": 1},
+					{"id": 102, "environment_id": 10, "name": "Hourly run", "state": 1}
+				], "extra": {"pagination": {"count": 2, "total_count": 2}}}`))
+			default:
+				t.Errorf("unexpected request: %s", r.URL)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	c := newTestClient(server.URL)
+
+	testCases := []struct {
+		name     string
+		resolve  func(context.Context, string) (string, error)
+		importID string
+		expected string
+		err      string
+	}{
+		{name: "project ID", resolve: c.ResolveProjectImportID, importID: "123", expected: "123"},
+		{name: "project name", resolve: c.ResolveProjectImportID, importID: "name=Analytics", expected: "1"},
+		{
+			name:     "ambiguous project name",
+			resolve:  c.ResolveProjectImportID,
+			importID: "name=Duplicate",
+			err:      "found 2 projects with the name \"Duplicate\" (IDs 3, 4)",
+		},
+		{
+			name:     "deleted project name",
+			resolve:  c.ResolveProjectImportID,
+			importID: "name=Marketing",
+			err:      "did not find any project",
+		},
+		{
+			name:     "environment ID",
+			resolve:  c.ResolveEnvironmentImportID,
+			importID: "1:10",
+			expected: "1:10",
+		},
+		{
+			name:     "environment names",
+			resolve:  c.ResolveEnvironmentImportID,
+			importID: "project=Analytics/environment=Prod",
+			expected: "1:10",
+		},
+		{
+			name:     "unknown environment name",
+			resolve:  c.ResolveEnvironmentImportID,
+			importID: "project=Analytics/environment=Dev",
+			err:      "did not find any environment",
+		},
+		{
+			name:     "job names",
+			resolve:  c.ResolveJobImportID,
+			importID: "project=Analytics/job=Hourly run",
+			expected: "102",
+		},
+		{
+			name:     "ambiguous job names",
+			resolve:  c.ResolveJobImportID,
+			importID: "project=Analytics/job=Daily run",
+			err:      "found 2 jobs with the name \"Daily run\" (IDs 100, 101)",
+		},
+		{
+			name:     "job names with the environment",
+			resolve:  c.ResolveJobImportID,
+			importID: "project=Analytics/environment=Prod/job=Daily run",
+			expected: "100",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := tc.resolve(context.Background(), tc.importID)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Errorf("expected an error containing %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, actual)
+			}
+		})
+	}
+}
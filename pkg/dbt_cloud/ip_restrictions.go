@@ -1,10 +1,9 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
-	"strconv"
 	"strings"
 
 	"github.com/samber/lo"
@@ -49,21 +48,18 @@ type IPRestrictionsRuleResponse struct {
 	Status ResponseStatus     `json:"status"`
 }
 
-func (c *Client) GetIPRestrictions() (*IPRestrictions, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetIPRestrictions(ctx context.Context) (*IPRestrictions, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/ip-restrictions/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-		),
+		c.V3AccountURL("ip-restrictions/"),
 		nil,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
+	body, err := c.doCachedRequest(req)
 	if err != nil {
 		return nil, err
 	}
@@ -77,8 +73,8 @@ func (c *Client) GetIPRestrictions() (*IPRestrictions, error) {
 	return &ipRestrictionsResponse.Data, nil
 }
 
-func (c *Client) GetIPRestrictionsRule(ruleID int64) (*IPRestrictionsRule, error) {
-	allIPRestrictions, err := c.GetIPRestrictions()
+func (c *Client) GetIPRestrictionsRule(ctx context.Context, ruleID int64) (*IPRestrictionsRule, error) {
+	allIPRestrictions, err := c.GetIPRestrictions(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +94,7 @@ func (c *Client) GetIPRestrictionsRule(ruleID int64) (*IPRestrictionsRule, error
 }
 
 func (c *Client) CreateIPRestrictionsRule(
+	ctx context.Context,
 	ipRestrictionsRule IPRestrictionsRule,
 ) (*IPRestrictionsRule, error) {
 
@@ -106,9 +103,10 @@ func (c *Client) CreateIPRestrictionsRule(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf("%s/v3/accounts/%s/ip-restrictions/", c.HostURL, strconv.Itoa(c.AccountID)),
+		c.V3AccountURL("ip-restrictions/"),
 		strings.NewReader(string(newIPRestrictionsData)),
 	)
 	if err != nil {
@@ -130,6 +128,7 @@ func (c *Client) CreateIPRestrictionsRule(
 }
 
 func (c *Client) UpdateIPRestrictionsRule(
+	ctx context.Context,
 	ipRestrictionsId string,
 	ipRestrictions IPRestrictionsRule,
 ) (*IPRestrictionsRule, error) {
@@ -138,14 +137,10 @@ func (c *Client) UpdateIPRestrictionsRule(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PUT",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/ip-restrictions/%s",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			ipRestrictionsId,
-		),
+		c.V3AccountURL("ip-restrictions/%s", ipRestrictionsId),
 		strings.NewReader(string(ipRestrictionsData)),
 	)
 	if err != nil {
@@ -166,9 +161,9 @@ func (c *Client) UpdateIPRestrictionsRule(
 	return &ipRestrictionsRuleResponse.Data, nil
 }
 
-func (c *Client) DeleteIPRestrictions(ipRestrictions IPRestrictions) error {
+func (c *Client) DeleteIPRestrictions(ctx context.Context, ipRestrictions IPRestrictions) error {
 	for _, ipRestrictionsRule := range ipRestrictions {
-		err := c.DeleteIPRestrictionsRule(ipRestrictionsRule.ID)
+		err := c.DeleteIPRestrictionsRule(ctx, ipRestrictionsRule.ID)
 		if err != nil {
 			return err
 		}
@@ -176,15 +171,11 @@ func (c *Client) DeleteIPRestrictions(ipRestrictions IPRestrictions) error {
 	return nil
 }
 
-func (c *Client) DeleteIPRestrictionsRule(ipRestrictionsRuleID int64) error {
-	req, err := http.NewRequest(
+func (c *Client) DeleteIPRestrictionsRule(ctx context.Context, ipRestrictionsRuleID int64) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/ip-restrictions/%d",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			ipRestrictionsRuleID,
-		),
+		c.V3AccountURL("ip-restrictions/%d", ipRestrictionsRuleID),
 		nil,
 	)
 	if err != nil {
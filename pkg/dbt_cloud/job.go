@@ -1,8 +1,8 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -86,10 +86,11 @@ type JobWithEnvironment struct {
 	Environment Environment `json:"environment"`
 }
 
-func (c *Client) GetJob(jobID string) (*Job, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetJob(ctx context.Context, jobID string) (*Job, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf("%s/v2/accounts/%s/jobs/%s/", c.HostURL, strconv.Itoa(c.AccountID), jobID),
+		c.V2AccountURL("jobs/%s/", jobID),
 		nil,
 	)
 	if err != nil {
@@ -111,6 +112,7 @@ func (c *Client) GetJob(jobID string) (*Job, error) {
 }
 
 func (c *Client) CreateJob(
+	ctx context.Context,
 	projectId int,
 	environmentId int,
 	name string,
@@ -254,9 +256,10 @@ func (c *Client) CreateJob(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf("%s/v2/accounts/%s/jobs/", c.HostURL, strconv.Itoa(c.AccountID)),
+		c.V2AccountURL("jobs/"),
 		strings.NewReader(string(newJobData)),
 	)
 	if err != nil {
@@ -280,22 +283,23 @@ func (c *Client) CreateJob(
 		selfID := *jobResponse.Data.ID
 		updatedJob.Deferring_Job_Id = &deferringJobID
 		updatedJob.ID = &selfID
-		return c.UpdateJob(strconv.Itoa(*jobResponse.Data.ID), updatedJob)
+		return c.UpdateJob(ctx, strconv.Itoa(*jobResponse.Data.ID), updatedJob)
 	}
 
 	return &jobResponse.Data, nil
 }
 
-func (c *Client) UpdateJob(jobId string, job Job) (*Job, error) {
+func (c *Client) UpdateJob(ctx context.Context, jobId string, job Job) (*Job, error) {
 
 	jobData, err := json.Marshal(job)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf("%s/v2/accounts/%s/jobs/%s/", c.HostURL, strconv.Itoa(c.AccountID), jobId),
+		c.V2AccountURL("jobs/%s/", jobId),
 		strings.NewReader(string(jobData)),
 	)
 	if err != nil {
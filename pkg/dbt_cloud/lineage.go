@@ -1,8 +1,8 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strings"
 )
@@ -28,18 +28,14 @@ type LineageIntegrationResponse struct {
 }
 
 func (c *Client) GetLineageIntegration(
+	ctx context.Context,
 	projectID int64,
 	lineageIntegrationID int64,
 ) (*LineageIntegration, error) {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/integrations/lineage/%d/",
-			c.HostURL,
-			c.AccountID,
-			projectID,
-			lineageIntegrationID,
-		),
+		c.V3AccountURL("projects/%d/integrations/lineage/%d/", projectID, lineageIntegrationID),
 		nil,
 	)
 	if err != nil {
@@ -61,6 +57,7 @@ func (c *Client) GetLineageIntegration(
 }
 
 func (c *Client) CreateLineageIntegration(
+	ctx context.Context,
 	projectID int64,
 	name string,
 	host string,
@@ -84,14 +81,10 @@ func (c *Client) CreateLineageIntegration(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/integrations/lineage/",
-			c.HostURL,
-			c.AccountID,
-			projectID,
-		),
+		c.V3AccountURL("projects/%d/integrations/lineage/", projectID),
 		strings.NewReader(string(newLineageIntegrationData)),
 	)
 	if err != nil {
@@ -113,6 +106,7 @@ func (c *Client) CreateLineageIntegration(
 }
 
 func (c *Client) UpdateLineageIntegration(
+	ctx context.Context,
 	projectID int64,
 	lineageIntegrationID int64,
 	lineageIntegration LineageIntegration,
@@ -122,15 +116,10 @@ func (c *Client) UpdateLineageIntegration(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PATCH",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/integrations/lineage/%d/",
-			c.HostURL,
-			c.AccountID,
-			projectID,
-			lineageIntegrationID,
-		),
+		c.V3AccountURL("projects/%d/integrations/lineage/%d/", projectID, lineageIntegrationID),
 		strings.NewReader(string(lineageIntegrationData)),
 	)
 	if err != nil {
@@ -151,16 +140,11 @@ func (c *Client) UpdateLineageIntegration(
 	return &lineageIntegrationResponse.Data, nil
 }
 
-func (c *Client) DeleteLineageIntegration(projectID int64, lineageIntegrationID int64) error {
-	req, err := http.NewRequest(
+func (c *Client) DeleteLineageIntegration(ctx context.Context, projectID int64, lineageIntegrationID int64) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/integrations/lineage/%d/",
-			c.HostURL,
-			c.AccountID,
-			projectID,
-			lineageIntegrationID,
-		),
+		c.V3AccountURL("projects/%d/integrations/lineage/%d/", projectID, lineageIntegrationID),
 		nil,
 	)
 	if err != nil {
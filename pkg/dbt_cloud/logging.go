@@ -0,0 +1,215 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	REDACTED_VALUE = "***REDACTED***"
+	// SECRET_ENV_VAR_PREFIX is the prefix of the dbt environment variables holding secrets
+	SECRET_ENV_VAR_PREFIX = "DBT_ENV_SECRET_"
+	// MAX_LOGGED_BODY_SIZE is the number of bytes of a body written to the logs, the rest is truncated
+	MAX_LOGGED_BODY_SIZE = 64 * 1024
+)
+
+// fields of the request and response bodies that are never written to the logs
+var secretFields = map[string]bool{
+	"token":                  true,
+	"password":               true,
+	"private_key":            true,
+	"private_key_passphrase": true,
+	"client_secret":          true,
+	"oauth_client_secret":    true,
+	"application_secret":     true,
+	"aws_secret_access_key":  true,
+	"hmac_secret":            true,
+	"token_string":           true,
+}
+
+// fields kept in the objects describing a secret environment variable, all the others hold its values
+var secretEnvVarIdentifierFields = map[string]bool{
+	"name": true,
+	"type": true,
+}
+
+// the environment variables setting the log level of the provider, from the most to the least specific
+var logLevelEnvVars = []string{"TF_LOG_PROVIDER_DBTCLOUD", "TF_LOG_PROVIDER", "TF_LOG"}
+
+// the header set by dbt Cloud to identify a request, useful when opening a support ticket
+var requestIDHeaders = []string{"X-Request-Id", "X-Dbt-Request-Id"}
+
+// redactBody returns the body as a string with the values of the secret fields replaced
+// bodies that are not JSON are returned as is
+func redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var parsed any
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return string(body)
+	}
+
+	redacted, err := json.Marshal(redactValue(parsed))
+	if err != nil {
+		return REDACTED_VALUE
+	}
+	return string(redacted)
+}
+
+func redactValue(value any) any {
+	switch typedValue := value.(type) {
+	case map[string]any:
+		// the environment variables are sent as {"name": "DBT_ENV_SECRET_...", "raw_value": "..."}
+		// or as maps keyed by their name, e.g. {"DBT_ENV_SECRET_...": {"prod": {"value": "..."}}}
+		secretEnvVar := isSecretEnvVar(typedValue["name"]) || isSecretEnvVar(typedValue["Name"])
+		for key, fieldValue := range typedValue {
+			switch {
+			case secretFields[strings.ToLower(key)]:
+				if fieldValue != nil && fieldValue != "" {
+					typedValue[key] = REDACTED_VALUE
+				}
+			case isSecretEnvVar(key), secretEnvVar && !secretEnvVarIdentifierFields[strings.ToLower(key)]:
+				typedValue[key] = redactStrings(fieldValue)
+			default:
+				typedValue[key] = redactValue(fieldValue)
+			}
+		}
+		return typedValue
+	case []any:
+		for i, item := range typedValue {
+			typedValue[i] = redactValue(item)
+		}
+		return typedValue
+	}
+	return value
+}
+
+// redactStrings replaces all the non empty strings of the value, however deep they are
+func redactStrings(value any) any {
+	switch typedValue := value.(type) {
+	case string:
+		if typedValue != "" {
+			return REDACTED_VALUE
+		}
+	case map[string]any:
+		for key, fieldValue := range typedValue {
+			typedValue[key] = redactStrings(fieldValue)
+		}
+	case []any:
+		for i, item := range typedValue {
+			typedValue[i] = redactStrings(item)
+		}
+	}
+	return value
+}
+
+func isSecretEnvVar(name any) bool {
+	nameString, ok := name.(string)
+	return ok && strings.HasPrefix(strings.ToUpper(nameString), SECRET_ENV_VAR_PREFIX)
+}
+
+// loggedBody returns the body redacted and truncated to MAX_LOGGED_BODY_SIZE
+func loggedBody(body []byte) string {
+	redacted := redactBody(body)
+	if len(redacted) <= MAX_LOGGED_BODY_SIZE {
+		return redacted
+	}
+
+	// we don't want to cut a multi-byte character in half
+	cut := MAX_LOGGED_BODY_SIZE
+	for cut > 0 && !utf8.RuneStart(redacted[cut]) {
+		cut--
+	}
+	return fmt.Sprintf("%s... (truncated, %d bytes in total)", redacted[:cut], len(redacted))
+}
+
+// traceLoggingEnabled returns true when the provider logs are at the TRACE level
+// tflog doesn't expose the level, so we read the same environment variables as Terraform
+// this avoids redacting the bodies of all the requests when they are not logged anyway
+func traceLoggingEnabled() bool {
+	for _, envVar := range logLevelEnvVars {
+		level := strings.TrimSpace(os.Getenv(envVar))
+		if level == "" {
+			continue
+		}
+		// TF_LOG=JSON logs everything at the TRACE level
+		return strings.EqualFold(level, "TRACE") || (envVar == "TF_LOG" && strings.EqualFold(level, "JSON"))
+	}
+	return false
+}
+
+func requestID(res *http.Response) string {
+	if res == nil {
+		return ""
+	}
+	for _, header := range requestIDHeaders {
+		if id := res.Header.Get(header); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// logRequest logs the request sent to dbt Cloud, the body is only logged at the TRACE level
+func logRequest(req *http.Request, attempt int) {
+	ctx := req.Context()
+	fields := map[string]any{
+		"http_method":  req.Method,
+		"http_url":     req.URL.String(),
+		"http_attempt": attempt,
+	}
+	tflog.Debug(ctx, "Sending request to dbt Cloud", fields)
+
+	if req.GetBody == nil || !traceLoggingEnabled() {
+		return
+	}
+	bodyReader, err := req.GetBody()
+	if err != nil {
+		return
+	}
+	defer bodyReader.Close()
+	body, err := io.ReadAll(bodyReader)
+	if err != nil || len(body) == 0 {
+		return
+	}
+	fields["http_request_body"] = loggedBody(body)
+	tflog.Trace(ctx, "Request body sent to dbt Cloud", fields)
+}
+
+// logResponse logs the response received from dbt Cloud, the body is only logged at the TRACE level
+func logResponse(req *http.Request, attempt int, start time.Time, res *http.Response, body []byte, err error) {
+	ctx := req.Context()
+	fields := map[string]any{
+		"http_method":     req.Method,
+		"http_url":        req.URL.String(),
+		"http_attempt":    attempt,
+		"http_latency_ms": time.Since(start).Milliseconds(),
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Request to dbt Cloud failed", fields)
+		return
+	}
+
+	fields["http_status"] = res.StatusCode
+	if id := requestID(res); id != "" {
+		fields["http_request_id"] = id
+	}
+	tflog.Debug(ctx, "Received response from dbt Cloud", fields)
+
+	if len(body) > 0 && traceLoggingEnabled() {
+		fields["http_response_body"] = loggedBody(body)
+		tflog.Trace(ctx, "Response body received from dbt Cloud", fields)
+	}
+}
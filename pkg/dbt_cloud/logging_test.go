@@ -0,0 +1,161 @@
+package dbt_cloud
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestRedactBody(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		body     string
+		expected string
+	}{
+		{
+			name:     "secret fields",
+			body:     `{"name": "my_creds", "password": "hunter2", "token": "abc"}`,
+			expected: `{"name": "my_creds", "password": "***REDACTED***", "token": "***REDACTED***"}`,
+		},
+		{
+			name:     "nested fields",
+			body:     `{"data": [{"id": 1, "token_string": "dbtc_123", "details": {"private_key": "key", "client_secret": "s"}}]}`,
+			expected: `{"data": [{"id": 1, "token_string": "***REDACTED***", "details": {"private_key": "***REDACTED***", "client_secret": "***REDACTED***"}}]}`,
+		},
+		{
+			name:     "oauth client secret",
+			body:     `{"oauth_client_id": "id", "oauth_client_secret": "s"}`,
+			expected: `{"oauth_client_id": "id", "oauth_client_secret": "***REDACTED***"}`,
+		},
+		{
+			name:     "private key passphrase",
+			body:     `{"private_key_passphrase": "p", "user": "dbt"}`,
+			expected: `{"private_key_passphrase": "***REDACTED***", "user": "dbt"}`,
+		},
+		{
+			name:     "application secret",
+			body:     `{"application_id": "id", "application_secret": "s"}`,
+			expected: `{"application_id": "id", "application_secret": "***REDACTED***"}`,
+		},
+		{
+			name:     "aws secret access key",
+			body:     `{"aws_access_key_id": "AKIA", "aws_secret_access_key": "s"}`,
+			expected: `{"aws_access_key_id": "AKIA", "aws_secret_access_key": "***REDACTED***"}`,
+		},
+		{
+			name:     "secret environment variable job override",
+			body:     `{"id": 1, "name": "DBT_ENV_SECRET_TOKEN", "raw_value": "s", "type": "job"}`,
+			expected: `{"id": 1, "name": "DBT_ENV_SECRET_TOKEN", "raw_value": "***REDACTED***", "type": "job"}`,
+		},
+		{
+			name:     "secret environment variable update",
+			body:     `{"env_vars": {"name": "DBT_ENV_SECRET_TOKEN", "project": "s1", "prod": "s2"}}`,
+			expected: `{"env_vars": {"name": "DBT_ENV_SECRET_TOKEN", "project": "***REDACTED***", "prod": "***REDACTED***"}}`,
+		},
+		{
+			name:     "secret environment variable create",
+			body:     `{"Name": "DBT_ENV_SECRET_TOKEN", "ProjectID": 1, "EnvironmentNameValues": {"project": "s"}}`,
+			expected: `{"Name": "DBT_ENV_SECRET_TOKEN", "ProjectID": 1, "EnvironmentNameValues": {"project": "***REDACTED***"}}`,
+		},
+		{
+			name:     "secret environment variables list",
+			body:     `{"data": {"variables": {"DBT_ENV_SECRET_TOKEN": {"prod": {"id": 1, "value": "s"}}, "DBT_TARGET": {"prod": {"id": 2, "value": "prod"}}}}}`,
+			expected: `{"data": {"variables": {"DBT_ENV_SECRET_TOKEN": {"prod": {"id": 1, "value": "***REDACTED***"}}, "DBT_TARGET": {"prod": {"id": 2, "value": "prod"}}}}}`,
+		},
+		{
+			name:     "empty and null secrets are kept",
+			body:     `{"hmac_secret": null, "password": ""}`,
+			expected: `{"hmac_secret": null, "password": ""}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var actual, expected any
+			if err := json.Unmarshal([]byte(redactBody([]byte(tc.body))), &actual); err != nil {
+				t.Fatal(err)
+			}
+			json.Unmarshal([]byte(tc.expected), &expected)
+			if !reflect.DeepEqual(actual, expected) {
+				t.Errorf("expected %v, got %v", expected, actual)
+			}
+		})
+	}
+
+	if redacted := redactBody([]byte("<html>Bad Gateway</html>")); redacted != "<html>Bad Gateway</html>" {
+		t.Errorf("expected non JSON bodies to be kept, got %s", redacted)
+	}
+}
+
+func TestLoggedBody(t *testing.T) {
+	t.Parallel()
+
+	short := `{"name": "a", "password": "hunter2"}`
+	if logged := loggedBody([]byte(short)); strings.Contains(logged, "hunter2") || strings.Contains(logged, "truncated") {
+		t.Errorf("expected a short body to be redacted and kept whole, got %s", logged)
+	}
+
+	long := `{"name": "` + strings.Repeat("é", MAX_LOGGED_BODY_SIZE) + `"}`
+	logged := loggedBody([]byte(long))
+	if !strings.Contains(logged, "(truncated,") {
+		t.Errorf("expected a long body to be truncated, got %d bytes", len(logged))
+	}
+	if len(logged) > MAX_LOGGED_BODY_SIZE+100 {
+		t.Errorf("expected the body to be capped at %d bytes, got %d", MAX_LOGGED_BODY_SIZE, len(logged))
+	}
+	if !utf8.ValidString(logged) {
+		t.Error("expected the truncated body to be valid UTF-8")
+	}
+}
+
+func TestTraceLoggingEnabled(t *testing.T) {
+	testCases := []struct {
+		name     string
+		env      map[string]string
+		expected bool
+	}{
+		{
+			name:     "no log level",
+			env:      map[string]string{},
+			expected: false,
+		},
+		{
+			name:     "debug",
+			env:      map[string]string{"TF_LOG": "DEBUG"},
+			expected: false,
+		},
+		{
+			name:     "trace",
+			env:      map[string]string{"TF_LOG": "trace"},
+			expected: true,
+		},
+		{
+			name:     "json",
+			env:      map[string]string{"TF_LOG": "JSON"},
+			expected: true,
+		},
+		{
+			name:     "the provider level takes precedence",
+			env:      map[string]string{"TF_LOG": "TRACE", "TF_LOG_PROVIDER_DBTCLOUD": "INFO"},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, envVar := range logLevelEnvVars {
+				t.Setenv(envVar, tc.env[envVar])
+			}
+			if enabled := traceLoggingEnabled(); enabled != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, enabled)
+			}
+		})
+	}
+}
@@ -1,10 +1,9 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
-	"strconv"
 	"strings"
 )
 
@@ -28,15 +27,11 @@ type Notification struct {
 	SlackChannelName *string `json:"slack_channel_name"`
 }
 
-func (c *Client) GetNotification(notificationID string) (*Notification, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetNotification(ctx context.Context, notificationID string) (*Notification, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf(
-			"%s/v2/accounts/%s/notifications/%s/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			notificationID,
-		),
+		c.V2AccountURL("notifications/%s/", notificationID),
 		nil,
 	)
 	if err != nil {
@@ -58,6 +53,7 @@ func (c *Client) GetNotification(notificationID string) (*Notification, error) {
 }
 
 func (c *Client) CreateNotification(
+	ctx context.Context,
 	userId int,
 	onCancel []int,
 	onFailure []int,
@@ -88,9 +84,10 @@ func (c *Client) CreateNotification(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf("%s/v2/accounts/%s/notifications/", c.HostURL, strconv.Itoa(c.AccountID)),
+		c.V2AccountURL("notifications/"),
 		strings.NewReader(string(newNotificationData)),
 	)
 	if err != nil {
@@ -112,6 +109,7 @@ func (c *Client) CreateNotification(
 }
 
 func (c *Client) UpdateNotification(
+	ctx context.Context,
 	notificationId string,
 	notification Notification,
 ) (*Notification, error) {
@@ -120,14 +118,10 @@ func (c *Client) UpdateNotification(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v2/accounts/%s/notifications/%s/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			notificationId,
-		),
+		c.V2AccountURL("notifications/%s/", notificationId),
 		strings.NewReader(string(notificationData)),
 	)
 	if err != nil {
@@ -1,10 +1,9 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
-	"strconv"
 	"strings"
 )
 
@@ -36,15 +35,11 @@ type OAuthConfigurationResponse struct {
 	Status ResponseStatus     `json:"status"`
 }
 
-func (c *Client) GetOAuthConfiguration(oAuthConfigurationID int64) (*OAuthConfiguration, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetOAuthConfiguration(ctx context.Context, oAuthConfigurationID int64) (*OAuthConfiguration, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/oauth-configurations/%d/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			oAuthConfigurationID,
-		),
+		c.V3AccountURL("oauth-configurations/%d/", oAuthConfigurationID),
 		nil,
 	)
 	if err != nil {
@@ -66,6 +61,7 @@ func (c *Client) GetOAuthConfiguration(oAuthConfigurationID int64) (*OAuthConfig
 }
 
 func (c *Client) CreateOAuthConfiguration(
+	ctx context.Context,
 	oAuthType string,
 	name string,
 	clientId string,
@@ -98,13 +94,10 @@ func (c *Client) CreateOAuthConfiguration(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/oauth-configurations/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-		),
+		c.V3AccountURL("oauth-configurations/"),
 		strings.NewReader(string(newOAuthConfigurationData)),
 	)
 	if err != nil {
@@ -126,6 +119,7 @@ func (c *Client) CreateOAuthConfiguration(
 }
 
 func (c *Client) UpdateOAuthConfiguration(
+	ctx context.Context,
 	oAuthConfigurationID int64,
 	oAuthConfiguration OAuthConfiguration,
 ) (*OAuthConfiguration, error) {
@@ -134,14 +128,10 @@ func (c *Client) UpdateOAuthConfiguration(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/oauth-configurations/%d/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			oAuthConfigurationID,
-		),
+		c.V3AccountURL("oauth-configurations/%d/", oAuthConfigurationID),
 		strings.NewReader(string(oAuthConfigurationData)),
 	)
 	if err != nil {
@@ -163,16 +153,13 @@ func (c *Client) UpdateOAuthConfiguration(
 }
 
 func (c *Client) DeleteOAuthConfiguration(
+	ctx context.Context,
 	oAuthConfigurationID int64,
 ) error {
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/oauth-configurations/%d/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			oAuthConfigurationID,
-		),
+		c.V3AccountURL("oauth-configurations/%d/", oAuthConfigurationID),
 		nil,
 	)
 	if err != nil {
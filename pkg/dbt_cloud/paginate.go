@@ -1,194 +1,266 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/samber/lo"
-	"github.com/sirupsen/logrus"
 )
 
-type Response struct {
-	Data  []any `json:"data"`
-	Extra Extra `json:"extra"`
-}
+const (
+	PAGINATION_LIMIT         = 100
+	DEFAULT_PAGE_CONCURRENCY = 4
+)
 
-type Extra struct {
-	Pagination Pagination `json:"pagination"`
+type paginatedResponse[T any] struct {
+	Data   []T            `json:"data"`
+	Status ResponseStatus `json:"status"`
+	Extra  ResponseExtra  `json:"extra"`
 }
 
-type Pagination struct {
-	Count      int `json:"count"`
-	TotalCount int `json:"total_count"`
-}
+// getPage returns one page of results for the URL, using the params provided with the limit and offset
+func getPage[T any](
+	ctx context.Context,
+	c *Client,
+	baseURL string,
+	params url.Values,
+	offset int,
+) (*paginatedResponse[T], error) {
+	pageParams := url.Values{}
+	for key, values := range params {
+		pageParams[key] = values
+	}
+	if pageParams.Get("limit") == "" {
+		pageParams.Set("limit", strconv.Itoa(PAGINATION_LIMIT))
+	}
+	pageParams.Set("offset", strconv.Itoa(offset))
 
-var log = logrus.New()
+	pageURL := baseURL
+	if strings.Contains(pageURL, "?") {
+		pageURL = fmt.Sprintf("%s&%s", pageURL, pageParams.Encode())
+	} else {
+		pageURL = fmt.Sprintf("%s?%s", pageURL, pageParams.Encode())
+	}
 
-func (c *Client) GetEndpoint(url string) ([]byte, error) {
-	req, err := http.NewRequest("GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", pageURL, nil)
 	if err != nil {
-		log.Fatalf("Error creating a new request: %v", err)
+		return nil, err
 	}
 
-	resp, err := c.doRequest(req)
+	body, err := c.doCachedRequest(req)
 	if err != nil {
-		log.Fatalf("Error fetching URL %v: %v", url, err)
+		return nil, err
 	}
 
-	return resp, err
-}
-
-func (c *Client) GetData(url string) []any {
-
-	// get the first page
-	jsonPayload, err := c.GetEndpoint(url)
+	response := paginatedResponse[T]{}
+	err = json.Unmarshal(body, &response)
 	if err != nil {
-		log.Fatal(err)
+		return nil, fmt.Errorf("error decoding the page at offset %d of %s: %w", offset, baseURL, err)
 	}
 
-	var response Response
+	return &response, nil
+}
+
+// GetAll returns all the objects of a list endpoint, going through all the pages
+// once the first page tells us the total count, the other pages are fetched in parallel
+func GetAll[T any](
+	ctx context.Context,
+	c *Client,
+	baseURL string,
+	params url.Values,
+) ([]T, error) {
 
-	err = json.Unmarshal(jsonPayload, &response)
+	// get the first page
+	response, err := getPage[T](ctx, c, baseURL, params, 0)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
 
 	allResponses := response.Data
+	totalCount := response.Extra.Pagination.TotalCount
+	pageSize := len(response.Data)
 
-	count := response.Extra.Pagination.Count
-	for count < response.Extra.Pagination.TotalCount {
-		// get the next page
-
-		var newURL string
-		lastPartURL, _ := lo.Last(strings.Split(url, "/"))
-		if strings.Contains(lastPartURL, "?") {
-			newURL = fmt.Sprintf("%s&offset=%d", url, count)
-		} else {
-			newURL = fmt.Sprintf("%s?offset=%d", url, count)
-		}
+	if pageSize == 0 || len(allResponses) >= totalCount {
+		return allResponses, nil
+	}
 
-		jsonPayload, err := c.GetEndpoint(newURL)
-		if err != nil {
-			log.Fatal(err)
-		}
-		var response Response
+	offsets := []int{}
+	for offset := pageSize; offset < totalCount; offset += pageSize {
+		offsets = append(offsets, offset)
+	}
 
-		err = json.Unmarshal(jsonPayload, &response)
-		if err != nil {
-			log.Fatal(err)
-		}
+	pages, err := getPages[T](ctx, c, baseURL, params, offsets)
+	if err != nil {
+		return nil, err
+	}
 
-		if response.Extra.Pagination.Count == 0 {
+	for _, page := range pages {
+		if len(page) == 0 {
 			// Unlucky! one object might have been deleted since the first call
-			// if we don't stop here we will loop forever!
+			// the next pages are empty as well so we can stop here
 			break
-		} else {
-			count += response.Extra.Pagination.Count
 		}
-		allResponses = append(allResponses, response.Data...)
+		allResponses = append(allResponses, page...)
 	}
 
-	return allResponses
+	return allResponses, nil
 }
 
-func (c *Client) GetAllGroupIDsByName(groupName string) []int {
-	url := fmt.Sprintf("%s/v3/accounts/%d/groups/", c.HostURL, c.AccountID)
+// GetUntil returns the objects of a list endpoint, going through the pages one after the other until done returns true
+// it is used when only the first objects are needed, e.g. the most recent runs, so the pages are not fetched in parallel
+func GetUntil[T any](
+	ctx context.Context,
+	c *Client,
+	baseURL string,
+	params url.Values,
+	done func(objects []T) bool,
+) ([]T, error) {
+	allResponses := []T{}
+	offset := 0
+	for {
+		response, err := getPage[T](ctx, c, baseURL, params, offset)
+		if err != nil {
+			return nil, err
+		}
 
-	allGroupsRaw := c.GetData(url)
+		allResponses = append(allResponses, response.Data...)
+		offset += len(response.Data)
 
-	return lo.FilterMap(allGroupsRaw, func(group any, _ int) (int, bool) {
-		if group.(map[string]any)["name"].(string) == groupName {
-			return int(group.(map[string]any)["id"].(float64)), true
+		if len(response.Data) == 0 ||
+			offset >= response.Extra.Pagination.TotalCount ||
+			done(allResponses) {
+			return allResponses, nil
 		}
-		return 0, false
-	})
+	}
 }
 
-func (c *Client) GetAllEnvironments(projectID int) ([]Environment, error) {
-	url := fmt.Sprintf("%s/v3/accounts/%d/environments/", c.HostURL, c.AccountID)
-
-	if projectID != 0 {
-		url = fmt.Sprintf("%s?project_id=%d", url, projectID)
+// getPages fetches the pages at the given offsets with at most c.PageConcurrency requests at a time
+// the pages are returned in the same order as the offsets
+func getPages[T any](
+	ctx context.Context,
+	c *Client,
+	baseURL string,
+	params url.Values,
+	offsets []int,
+) ([][]T, error) {
+	concurrency := c.PageConcurrency
+	if concurrency < 1 {
+		concurrency = 1
 	}
 
-	allEnvironmentsRaw := c.GetData(url)
+	pageCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-	allEnvs := []Environment{}
-	for _, env := range allEnvironmentsRaw {
+	pages := make([][]T, len(offsets))
+	semaphore := make(chan struct{}, concurrency)
 
-		data, _ := json.Marshal(env)
-		currentEnv := Environment{}
-		err := json.Unmarshal(data, &currentEnv)
-		if err != nil {
-			return nil, err
+	var firstErr error
+	var errOnce sync.Once
+
+	var wg sync.WaitGroup
+	for i, offset := range offsets {
+		select {
+		case semaphore <- struct{}{}:
+		case <-pageCtx.Done():
+		}
+		if pageCtx.Err() != nil {
+			break
 		}
-		allEnvs = append(allEnvs, currentEnv)
+
+		wg.Add(1)
+		go func(i int, offset int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			response, err := getPage[T](pageCtx, c, baseURL, params, offset)
+			if err != nil {
+				// we keep the first error and stop getting the other pages
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			pages[i] = response.Data
+		}(i, offset)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
 	}
-	return allEnvs, nil
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return pages, nil
 }
 
-func (c *Client) GetAllNotifications() ([]Notification, error) {
-	url := fmt.Sprintf("%s/v2/accounts/%d/notifications/", c.HostURL, c.AccountID)
+func (c *Client) GetAllGroups(ctx context.Context) ([]Group, error) {
+	url := c.V3AccountURL("groups/")
 
-	allNotificationsRaw := c.GetData(url)
+	return GetAll[Group](ctx, c, url, nil)
+}
 
-	allNotifications := []Notification{}
-	for _, notification := range allNotificationsRaw {
+func (c *Client) GetAllGroupIDsByName(ctx context.Context, groupName string) ([]int, error) {
+	allGroups, err := c.GetAllGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-		data, _ := json.Marshal(notification)
-		currentNotification := Notification{}
-		err := json.Unmarshal(data, &currentNotification)
-		if err != nil {
-			return nil, err
+	return lo.FilterMap(allGroups, func(group Group, _ int) (int, bool) {
+		if group.Name == groupName && group.ID != nil {
+			return *group.ID, true
 		}
-		allNotifications = append(allNotifications, currentNotification)
-	}
-	return allNotifications, nil
+		return 0, false
+	}), nil
 }
 
-func (c *Client) GetAllServiceTokens() ([]ServiceToken, error) {
-	url := fmt.Sprintf("%s/v3/accounts/%d/service-tokens/?state=1", c.HostURL, c.AccountID)
+func (c *Client) GetAllEnvironments(ctx context.Context, projectID int) ([]Environment, error) {
+	params := url.Values{}
+	if projectID != 0 {
+		params.Set("project_id", strconv.Itoa(projectID))
+	}
 
-	allServiceTokensRaw := c.GetData(url)
+	url := c.V3AccountURL("environments/")
 
-	allServiceTokens := []ServiceToken{}
-	for _, notification := range allServiceTokensRaw {
+	return GetAll[Environment](ctx, c, url, params)
+}
 
-		data, _ := json.Marshal(notification)
-		currentServiceToken := ServiceToken{}
-		err := json.Unmarshal(data, &currentServiceToken)
-		if err != nil {
-			return nil, err
-		}
-		allServiceTokens = append(allServiceTokens, currentServiceToken)
-	}
-	return allServiceTokens, nil
+func (c *Client) GetAllNotifications(ctx context.Context) ([]Notification, error) {
+	url := c.V2AccountURL("notifications/")
+
+	return GetAll[Notification](ctx, c, url, nil)
 }
 
-func (c *Client) GetAllLicenseMaps() ([]LicenseMap, error) {
-	url := fmt.Sprintf("%s/v3/accounts/%d/license-maps/", c.HostURL, c.AccountID)
+func (c *Client) GetAllWebhooks(ctx context.Context) ([]WebhookRead, error) {
+	url := c.V3AccountURL("webhooks/subscriptions")
 
-	allLicenseMapsRaw := c.GetData(url)
+	return GetAll[WebhookRead](ctx, c, url, nil)
+}
 
-	allLicenseMaps := []LicenseMap{}
-	for _, notification := range allLicenseMapsRaw {
+func (c *Client) GetAllServiceTokens(ctx context.Context) ([]ServiceToken, error) {
+	params := url.Values{}
+	params.Set("state", strconv.Itoa(STATE_ACTIVE))
 
-		data, _ := json.Marshal(notification)
-		currentLicenseMap := LicenseMap{}
-		err := json.Unmarshal(data, &currentLicenseMap)
-		if err != nil {
-			return nil, err
-		}
-		allLicenseMaps = append(allLicenseMaps, currentLicenseMap)
-	}
-	return allLicenseMaps, nil
+	url := c.V3AccountURL("service-tokens/")
+
+	return GetAll[ServiceToken](ctx, c, url, params)
 }
 
-func (c *Client) GetAllJobs(projectID int, environmentID int) ([]JobWithEnvironment, error) {
-	var url string
+func (c *Client) GetAllLicenseMaps(ctx context.Context) ([]LicenseMap, error) {
+	url := c.V3AccountURL("license-maps/")
 
+	return GetAll[LicenseMap](ctx, c, url, nil)
+}
+
+func (c *Client) GetAllJobs(ctx context.Context, projectID int, environmentID int) ([]JobWithEnvironment, error) {
 	if projectID != 0 && environmentID != 0 {
 		return nil, fmt.Errorf("you can't filter by both project and environment")
 	}
@@ -197,36 +269,75 @@ func (c *Client) GetAllJobs(projectID int, environmentID int) ([]JobWithEnvironm
 		return nil, fmt.Errorf("you must filter by either project or environment")
 	}
 
+	params := url.Values{}
+	params.Set("include_related", "[environment]")
 	if projectID != 0 {
-		url = fmt.Sprintf(
-			"%s/v2/accounts/%d/jobs?project_id=%d&include_related=[environment]",
-			c.HostURL,
-			c.AccountID,
-			projectID,
-		)
+		params.Set("project_id", strconv.Itoa(projectID))
 	}
-
 	if environmentID != 0 {
-		url = fmt.Sprintf(
-			"%s/v2/accounts/%d/jobs?environment_id=%d&include_related=[environment]",
-			c.HostURL,
-			c.AccountID,
-			environmentID,
-		)
+		params.Set("environment_id", strconv.Itoa(environmentID))
 	}
 
-	allJobsRaw := c.GetData(url)
+	url := c.V2AccountURL("jobs/")
 
-	allJobs := []JobWithEnvironment{}
-	for _, job := range allJobsRaw {
+	return GetAll[JobWithEnvironment](ctx, c, url, params)
+}
 
-		data, _ := json.Marshal(job)
-		currentJob := JobWithEnvironment{}
-		err := json.Unmarshal(data, &currentJob)
+// GetAllRuns returns the runs matching the filters, the most recent first
+// the pages are only fetched until the limit is reached or until the runs are older than CreatedAfter
+func (c *Client) GetAllRuns(ctx context.Context, filters RunFilters) ([]Run, error) {
+	params := url.Values{}
+	params.Set("include_related", "[trigger,run_steps]")
+	params.Set("order_by", "-id")
+	if filters.JobDefinitionID != 0 {
+		params.Set("job_definition_id", strconv.Itoa(filters.JobDefinitionID))
+	}
+	if filters.EnvironmentID != 0 {
+		params.Set("environment_id", strconv.Itoa(filters.EnvironmentID))
+	}
+	if filters.ProjectID != 0 {
+		params.Set("project_id", strconv.Itoa(filters.ProjectID))
+	}
+	if filters.Status != 0 {
+		params.Set("status", strconv.Itoa(filters.Status))
+	}
+	if filters.Limit > 0 {
+		params.Set("limit", strconv.Itoa(min(filters.Limit, PAGINATION_LIMIT)))
+	}
+
+	var parseErr error
+	isRecent := func(run Run) bool {
+		if filters.CreatedAfter.IsZero() {
+			return true
+		}
+		createdAt, err := run.CreatedTime()
 		if err != nil {
-			return nil, err
+			parseErr = err
+			return false
 		}
-		allJobs = append(allJobs, currentJob)
+		return createdAt.After(filters.CreatedAfter)
 	}
-	return allJobs, nil
+
+	url := c.V2AccountURL("runs/")
+
+	runs, err := GetUntil[Run](ctx, c, url, params, func(runs []Run) bool {
+		// the runs are sorted from the most recent, so the next pages only have older runs
+		if !isRecent(runs[len(runs)-1]) {
+			return true
+		}
+		return filters.Limit > 0 && len(runs) >= filters.Limit
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	runs = lo.Filter(runs, func(run Run, _ int) bool { return isRecent(run) })
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	if filters.Limit > 0 && len(runs) > filters.Limit {
+		runs = runs[:filters.Limit]
+	}
+
+	return runs, nil
 }
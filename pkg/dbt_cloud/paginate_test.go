@@ -0,0 +1,80 @@
+package dbt_cloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+type testObject struct {
+	ID int `json:"id"`
+}
+
+// newPaginatedServer returns a server listing `total` objects, `pageSize` at a time
+// when `deleted` is set, the total count announced is higher than the number of objects returned
+func newPaginatedServer(t *testing.T, total int, pageSize int, deleted int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		if r.URL.Query().Get("limit") == "" {
+			t.Errorf("expected a limit to be set in %s", r.URL)
+		}
+
+		data := []testObject{}
+		for i := offset; i < min(offset+pageSize, total-deleted); i++ {
+			data = append(data, testObject{ID: i})
+		}
+
+		response := map[string]any{
+			"data": data,
+			"extra": map[string]any{
+				"pagination": map[string]int{"count": len(data), "total_count": total},
+			},
+		}
+		json.NewEncoder(w).Encode(response)
+	}))
+}
+
+func TestGetAll(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		total    int
+		deleted  int
+		expected int
+	}{
+		{name: "single page", total: 5, expected: 5},
+		{name: "multiple pages", total: 23, expected: 23},
+		{name: "more pages than workers", total: 95, expected: 95},
+		{name: "objects deleted between pages", total: 23, deleted: 4, expected: 19},
+		{name: "no objects", total: 0, expected: 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newPaginatedServer(t, tc.total, 10, tc.deleted)
+			defer server.Close()
+
+			c := newTestClient(server.URL)
+			results, err := GetAll[testObject](context.Background(), c, server.URL+"/objects/", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(results) != tc.expected {
+				t.Fatalf("expected %d results, got %d", tc.expected, len(results))
+			}
+			for i, result := range results {
+				if result.ID != i {
+					t.Errorf("expected object %d at position %d, got %d", i, i, result.ID)
+				}
+			}
+		})
+	}
+}
@@ -1,10 +1,7 @@
 package dbt_cloud
 
 import (
-	"encoding/json"
-	"fmt"
-	"net/http"
-	"strings"
+	"context"
 )
 
 type PostgresCredential struct {
@@ -20,47 +17,21 @@ type PostgresCredential struct {
 	Password       string `json:"password,omitempty"`
 }
 
-type PostgresCredentialResponse struct {
-	Data   PostgresCredential `json:"data"`
-	Status ResponseStatus     `json:"status"`
-}
+func (PostgresCredential) IncludeRelated() []string { return nil }
 
 // GetPostgresCredential retrieves a specific Postgres credential by its ID
 func (c *Client) GetPostgresCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 ) (*PostgresCredential, error) {
-	req, err := http.NewRequest(
-		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-			credentialId,
-		),
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	PostgresCredentialResponse := PostgresCredentialResponse{}
-	err = json.Unmarshal(body, &PostgresCredentialResponse)
-	if err != nil {
-		return nil, err
-	}
-
-	return &PostgresCredentialResponse.Data, nil
+	client := NewCredentialClient[PostgresCredential](c)
+	return client.Get(ctx, projectId, credentialId)
 }
 
 // CreatePostgresCredential creates a new Postgres credential
 func (c *Client) CreatePostgresCredential(
+	ctx context.Context,
 	projectId int,
 	isActive bool,
 	type_ string,
@@ -81,100 +52,7 @@ func (c *Client) CreatePostgresCredential(
 		Target_Name:    targetName,
 		Password:       password,
 	}
-	newPostgresCredentialData, err := json.Marshal(newPostgresCredential)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(
-		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/credentials/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-		),
-		strings.NewReader(string(newPostgresCredentialData)),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	postgresCredentialResponse := PostgresCredentialResponse{}
-	err = json.Unmarshal(body, &postgresCredentialResponse)
-	if err != nil {
-		return nil, err
-	}
-
-	return &postgresCredentialResponse.Data, nil
-}
-
-// UpdatePostgresCredential updates an existing Postgres credential
-func (c *Client) UpdatePostgresCredential(
-	projectId int,
-	credentialId int,
-	postgresCredential PostgresCredential,
-) (*PostgresCredential, error) {
-	postgresCredentialData, err := json.Marshal(postgresCredential)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(
-		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-			credentialId,
-		),
-		strings.NewReader(string(postgresCredentialData)),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	postgresCredentialResponse := PostgresCredentialResponse{}
-	err = json.Unmarshal(body, &postgresCredentialResponse)
-	if err != nil {
-		return nil, err
-	}
-
-	return &postgresCredentialResponse.Data, nil
-}
-
-// DeletePostgresCredential deletes a Postgres credential by its ID
-func (c *Client) DeletePostgresCredential(credentialId, projectId string) (string, error) {
-	req, err := http.NewRequest(
-		"DELETE",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%s/credentials/%s/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-			credentialId,
-		),
-		nil,
-	)
-	if err != nil {
-		return "", err
-	}
-
-	_, err = c.doRequest(req)
-	if err != nil {
-		return "", err
-	}
 
-	return "", err
+	client := NewCredentialClient[PostgresCredential](c)
+	return client.Create(ctx, projectId, newPostgresCredential)
 }
@@ -1,6 +1,7 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -26,13 +27,13 @@ type PrivatelinkEndpointResponse struct {
 	Status ResponseStatus      `json:"status"`
 }
 
-func (c *Client) GetPrivatelinkEndpoint(endpointName string, privatelinkEndpointURL string) (*PrivatelinkEndpoint, error) {
+func (c *Client) GetPrivatelinkEndpoint(ctx context.Context, endpointName string, privatelinkEndpointURL string) (*PrivatelinkEndpoint, error) {
 
 	if endpointName == "" && privatelinkEndpointURL == "" {
 		return nil, fmt.Errorf("The endpoint name or url needs to be provided")
 	}
 
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%d/private-link-endpoints/", c.HostURL, c.AccountID), nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", c.V3AccountURL("private-link-endpoints/"), nil)
 	if err != nil {
 		return nil, err
 	}
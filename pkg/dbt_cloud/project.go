@@ -1,10 +1,11 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"strconv"
+	"net/url"
 	"strings"
 )
 
@@ -32,76 +33,17 @@ type ProjectResponse struct {
 	Status ResponseStatus `json:"status"`
 }
 
-func (c *Client) GetProjectByName(projectName string) (*Project, error) {
-	req, err := http.NewRequest(
-		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/projects/?include_related=[freshness_job_id,docs_job_id]",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-		),
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
+func (c *Client) GetProjectByName(ctx context.Context, projectName string) (*Project, error) {
+	params := url.Values{}
+	params.Set("include_related", "[freshness_job_id,docs_job_id]")
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
+	url := c.V3AccountURL("projects/")
 
-	projectListResponse := ProjectListResponse{}
-	err = json.Unmarshal(body, &projectListResponse)
+	listAllProjects, err := GetAll[Project](ctx, c, url, params)
 	if err != nil {
 		return nil, err
 	}
 
-	listAllProjects := projectListResponse.Data
-
-	// if there are more than the limit, we need to paginate
-	if projectListResponse.Extra.Pagination.TotalCount > projectListResponse.Extra.Filters.Limit {
-		numProjects := projectListResponse.Extra.Pagination.Count
-		for numProjects < projectListResponse.Extra.Pagination.TotalCount {
-
-			req, err := http.NewRequest(
-				"GET",
-				fmt.Sprintf(
-					"%s/v3/accounts/%s/projects/?include_related=[freshness_job_id,docs_job_id]&offset=%d",
-					c.HostURL,
-					strconv.Itoa(c.AccountID),
-					numProjects,
-				),
-				nil,
-			)
-			if err != nil {
-				return nil, err
-			}
-
-			body, err := c.doRequest(req)
-			if err != nil {
-				return nil, err
-			}
-
-			projectListResponse := ProjectListResponse{}
-			err = json.Unmarshal(body, &projectListResponse)
-			if err != nil {
-				return nil, err
-			}
-
-			numProjectsLastCall := projectListResponse.Extra.Pagination.Count
-			if numProjectsLastCall > 0 {
-				listAllProjects = append(listAllProjects, projectListResponse.Data...)
-				numProjects += projectListResponse.Extra.Pagination.Count
-			} else {
-				// this means that most likely one item was deleted since the first call
-				// so the number of items is less than the initial total, we can break the loop
-				break
-			}
-
-		}
-	}
-
 	// we now loop though the projects to find the ones with the name we are looking for
 	matchingProjects := []Project{}
 	for _, project := range listAllProjects {
@@ -119,15 +61,11 @@ func (c *Client) GetProjectByName(projectName string) (*Project, error) {
 	return &matchingProjects[0], nil
 }
 
-func (c *Client) GetProject(projectID string) (*Project, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetProject(ctx context.Context, projectID string) (*Project, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/projects/%s/?include_related=[freshness_job_id,docs_job_id]",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			projectID,
-		),
+		c.V3AccountURL("projects/%s/?include_related=[freshness_job_id,docs_job_id]", projectID),
 		nil,
 	)
 	if err != nil {
@@ -149,6 +87,7 @@ func (c *Client) GetProject(projectID string) (*Project, error) {
 }
 
 func (c *Client) CreateProject(
+	ctx context.Context,
 	name string,
 	description string,
 	dbtProjectSubdirectory string,
@@ -168,9 +107,10 @@ func (c *Client) CreateProject(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf("%s/v3/accounts/%s/projects/", c.HostURL, strconv.Itoa(c.AccountID)),
+		c.V3AccountURL("projects/"),
 		strings.NewReader(string(newProjectData)),
 	)
 	if err != nil {
@@ -191,20 +131,16 @@ func (c *Client) CreateProject(
 	return &projectResponse.Data, nil
 }
 
-func (c *Client) UpdateProject(projectID string, project Project) (*Project, error) {
+func (c *Client) UpdateProject(ctx context.Context, projectID string, project Project) (*Project, error) {
 	projectData, err := json.Marshal(project)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/projects/%s/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			projectID,
-		),
+		c.V3AccountURL("projects/%s/", projectID),
 		strings.NewReader(string(projectData)),
 	)
 	if err != nil {
@@ -1,8 +1,8 @@
 package dbt_cloud
 
 import (
-	"encoding/json"
-	"fmt"
+	"context"
+	"net/url"
 )
 
 type ProjectConnectionRepository struct {
@@ -28,36 +28,15 @@ type ProjectConnectionRepository struct {
 	FreshnessJob           any                                   `json:"freshness_job,omitempty"`
 }
 
-func (c *Client) GetAllProjects(nameContains string) ([]ProjectConnectionRepository, error) {
-	var url string
-
-	if nameContains == "" {
-		url = fmt.Sprintf(
-			`%s/v3/accounts/%d/projects/?limit=100&order_by=name&include_related=["repository","connection"]`,
-			c.HostURL,
-			c.AccountID,
-		)
-	} else {
-		url = fmt.Sprintf(
-			`%s/v3/accounts/%d/projects/?name__icontains=%s&limit=100&order_by=name&include_related=["repository","connection"]`,
-			c.HostURL,
-			c.AccountID,
-			nameContains,
-		)
+func (c *Client) GetAllProjects(ctx context.Context, nameContains string) ([]ProjectConnectionRepository, error) {
+	params := url.Values{}
+	params.Set("order_by", "name")
+	params.Set("include_related", `["repository","connection"]`)
+	if nameContains != "" {
+		params.Set("name__icontains", nameContains)
 	}
 
-	allProjectsRaw := c.GetData(url)
-
-	allProjects := []ProjectConnectionRepository{}
-	for _, job := range allProjectsRaw {
+	url := c.V3AccountURL("projects/")
 
-		data, _ := json.Marshal(job)
-		currentProject := ProjectConnectionRepository{}
-		err := json.Unmarshal(data, &currentProject)
-		if err != nil {
-			return nil, err
-		}
-		allProjects = append(allProjects, currentProject)
-	}
-	return allProjects, nil
+	return GetAll[ProjectConnectionRepository](ctx, c, url, params)
 }
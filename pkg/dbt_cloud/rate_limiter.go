@@ -0,0 +1,102 @@
+package dbt_cloud
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// The provider is made of 2 halves (SDKv2 and Framework) that each create their own client
+// the rate limiters are stored at the package level so that they are shared for the same account and host
+var (
+	rateLimiters   = map[string]*RateLimiter{}
+	rateLimitersMu sync.Mutex
+)
+
+// RateLimiter is a token bucket allowing `rate` requests per second with bursts of up to `burst` requests
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func NewRateLimiter(requestsPerSecond float64) *RateLimiter {
+	burst := math.Max(1, math.Ceil(requestsPerSecond))
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+}
+
+// getSharedRateLimiter returns the rate limiter for the account and host, creating it if needed
+func getSharedRateLimiter(hostURL string, accountID int, requestsPerSecond float64) *RateLimiter {
+	key := fmt.Sprintf("%s|%d", hostURL, accountID)
+
+	rateLimitersMu.Lock()
+	defer rateLimitersMu.Unlock()
+
+	limiter, ok := rateLimiters[key]
+	if !ok {
+		limiter = NewRateLimiter(requestsPerSecond)
+		rateLimiters[key] = limiter
+		return limiter
+	}
+
+	limiter.setRate(requestsPerSecond)
+	return limiter
+}
+
+func (l *RateLimiter) setRate(requestsPerSecond float64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(time.Now())
+	l.rate = requestsPerSecond
+	l.burst = math.Max(1, math.Ceil(requestsPerSecond))
+	l.tokens = math.Min(l.tokens, l.burst)
+}
+
+// refill adds the tokens accumulated since the last call, it needs to be called with the lock held
+func (l *RateLimiter) refill(now time.Time) {
+	elapsed := now.Sub(l.last).Seconds()
+	if elapsed > 0 {
+		l.tokens = math.Min(l.burst, l.tokens+elapsed*l.rate)
+		l.last = now
+	}
+}
+
+// Wait blocks until a request can be sent or the context is cancelled
+// the token is reserved immediately so that concurrent callers are served in order
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	l.refill(time.Now())
+	l.tokens--
+	tokens := l.tokens
+	rate := l.rate
+	l.mu.Unlock()
+
+	if tokens >= 0 {
+		return nil
+	}
+
+	wait := time.Duration(-tokens / rate * float64(time.Second))
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		// we didn't use our token, we give it back
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,57 @@
+package dbt_cloud
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewRateLimiter(20)
+	ctx := context.Background()
+
+	start := time.Now()
+	// the first 20 requests use the burst, the next 10 need to wait for 0.5s in total
+	for i := 0; i < 30; i++ {
+		if err := limiter.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	elapsed := time.Since(start)
+
+	if elapsed < 400*time.Millisecond {
+		t.Errorf("expected the limiter to slow down the requests, took %v", elapsed)
+	}
+}
+
+func TestRateLimiterCancel(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewRateLimiter(0.1)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if err := limiter.Wait(ctx); err != nil {
+		t.Fatalf("the first request should use the burst, got %v", err)
+	}
+	if err := limiter.Wait(ctx); err == nil {
+		t.Fatal("expected the second request to be cancelled")
+	}
+}
+
+func TestSharedRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	limiter1 := getSharedRateLimiter("https://test-shared.getdbt.com/api", 1, 5)
+	limiter2 := getSharedRateLimiter("https://test-shared.getdbt.com/api", 1, 5)
+	limiter3 := getSharedRateLimiter("https://test-shared.getdbt.com/api", 2, 5)
+
+	if limiter1 != limiter2 {
+		t.Error("expected the same limiter for the same account and host")
+	}
+	if limiter1 == limiter3 {
+		t.Error("expected different limiters for different accounts")
+	}
+}
@@ -0,0 +1,169 @@
+package dbt_cloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/samber/lo"
+)
+
+// Like the rate limiters, the read caches are shared between the SDKv2 and Framework clients
+// so that a write from one half of the provider invalidates the entries read by the other half
+var (
+	readCaches   = map[string]*ReadCache{}
+	readCachesMu sync.Mutex
+)
+
+// segments of the URL path that don't identify a collection
+var nonCollectionSegments = map[string]bool{
+	"api":      true,
+	"v2":       true,
+	"v3":       true,
+	"accounts": true,
+}
+
+// cachedListEndpoints are the account level list endpoints served from the cache
+// each of them is invalidated by the writes to the collections listed, e.g. deleting a project deletes its environments
+// other endpoints, and in particular runs which change without the provider writing anything, are never cached
+var cachedListEndpoints = map[string][]string{
+	"projects":        {"projects", "connections", "repositories"},
+	"environments":    {"environments", "projects", "credentials", "connections"},
+	"connections":     {"connections"},
+	"users":           {"users", "groups", "assign-groups", "group-permissions"},
+	"ip-restrictions": {"ip-restrictions"},
+}
+
+// ReadCache keeps the responses of the list endpoints in cachedListEndpoints for the lifetime of the provider process
+// the entries are keyed by URL and invalidated when a write is sent to a collection they depend on
+// the generation is incremented by each invalidation so that a read started before a write can't store a stale response
+type ReadCache struct {
+	mu         sync.RWMutex
+	entries    map[string][]byte
+	generation uint64
+}
+
+func NewReadCache() *ReadCache {
+	return &ReadCache{
+		entries: map[string][]byte{},
+	}
+}
+
+// getSharedReadCache returns the read cache for the account and host, creating it if needed
+func getSharedReadCache(hostURL string, accountID int) *ReadCache {
+	key := fmt.Sprintf("%s|%d", hostURL, accountID)
+
+	readCachesMu.Lock()
+	defer readCachesMu.Unlock()
+
+	cache, ok := readCaches[key]
+	if !ok {
+		cache = NewReadCache()
+		readCaches[key] = cache
+	}
+	return cache
+}
+
+func (rc *ReadCache) get(key string) ([]byte, bool) {
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+
+	body, ok := rc.entries[key]
+	return body, ok
+}
+
+func (rc *ReadCache) currentGeneration() uint64 {
+	rc.mu.RLock()
+	defer rc.mu.RUnlock()
+
+	return rc.generation
+}
+
+// set stores the response unless the cache was invalidated since the generation read before sending the request
+func (rc *ReadCache) set(key string, body []byte, generation uint64) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if rc.generation != generation {
+		return
+	}
+	rc.entries[key] = body
+}
+
+// collection returns the name of the last collection in a URL path
+// e.g. /api/v3/accounts/1/projects/2/environments/3/ returns environments
+func collection(path string) string {
+	segments := strings.Split(path, "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		segment := segments[i]
+		if segment == "" || nonCollectionSegments[segment] {
+			continue
+		}
+		if _, err := strconv.Atoi(segment); err == nil {
+			continue
+		}
+		return segment
+	}
+	return ""
+}
+
+// listEndpoint returns the name of the endpoint if the URL path is one of cachedListEndpoints
+// e.g. /api/v3/accounts/1/environments/ returns environments but /api/v2/accounts/1/runs/ returns ""
+func listEndpoint(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		if segment != "accounts" {
+			continue
+		}
+		if len(segments) != i+3 {
+			return ""
+		}
+		if _, ok := cachedListEndpoints[segments[i+2]]; ok {
+			return segments[i+2]
+		}
+		return ""
+	}
+	return ""
+}
+
+// invalidate removes all the entries depending on the collection of the URL written to
+// the entries are removed for all the parents (e.g. all the projects) as we don't try to be smarter than that
+func (rc *ReadCache) invalidate(writeURL *url.URL) {
+	writeCollection := collection(writeURL.Path)
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	rc.generation++
+	for key := range rc.entries {
+		cachedURL, err := url.Parse(key)
+		if err != nil || lo.Contains(cachedListEndpoints[listEndpoint(cachedURL.Path)], writeCollection) {
+			delete(rc.entries, key)
+		}
+	}
+}
+
+// doCachedRequest is used for GET requests on list endpoints
+// the response is served from the cache when it is enabled, the endpoint is cacheable and the URL has already been read
+func (c *Client) doCachedRequest(req *http.Request) ([]byte, error) {
+	if c.ReadCache == nil || req.Method != http.MethodGet || listEndpoint(req.URL.Path) == "" {
+		return c.doRequest(req)
+	}
+
+	key := req.URL.String()
+	if body, ok := c.ReadCache.get(key); ok {
+		return body, nil
+	}
+
+	generation := c.ReadCache.currentGeneration()
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	c.ReadCache.set(key, body, generation)
+	return body, nil
+}
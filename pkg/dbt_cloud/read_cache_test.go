@@ -0,0 +1,176 @@
+package dbt_cloud
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestReadCache(t *testing.T) {
+	t.Parallel()
+
+	var listCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			listCalls.Add(1)
+		}
+		w.Write([]byte(`{"data": [{"id": 1}], "extra": {"pagination": {"count": 1, "total_count": 1}}}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL)
+	c.ReadCache = NewReadCache()
+	ctx := context.Background()
+
+	listURL := server.URL + "/v3/accounts/1/environments/"
+	list := func() {
+		if _, err := GetAll[testObject](ctx, c, listURL, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	list()
+	list()
+	if calls := listCalls.Load(); calls != 1 {
+		t.Fatalf("expected the second list to be served from the cache, got %d calls", calls)
+	}
+
+	// a write to another collection keeps the entries
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/v3/accounts/1/projects/2/jobs/", strings.NewReader("{}"))
+	if _, err := c.doRequest(req); err != nil {
+		t.Fatal(err)
+	}
+	list()
+	if calls := listCalls.Load(); calls != 1 {
+		t.Fatalf("expected a write to another collection to keep the cache, got %d calls", calls)
+	}
+
+	// a write to the same collection invalidates them
+	req, _ = http.NewRequestWithContext(ctx, http.MethodDelete, server.URL+"/v3/accounts/1/projects/2/environments/3/", nil)
+	if _, err := c.doRequest(req); err != nil {
+		t.Fatal(err)
+	}
+	list()
+	if calls := listCalls.Load(); calls != 2 {
+		t.Fatalf("expected a write to the same collection to invalidate the cache, got %d calls", calls)
+	}
+
+	// a write to a collection the list depends on invalidates them too
+	req, _ = http.NewRequestWithContext(ctx, http.MethodDelete, server.URL+"/v3/accounts/1/projects/2/", nil)
+	if _, err := c.doRequest(req); err != nil {
+		t.Fatal(err)
+	}
+	list()
+	if calls := listCalls.Load(); calls != 3 {
+		t.Fatalf("expected deleting a project to invalidate the environments, got %d calls", calls)
+	}
+}
+
+func TestReadCacheWriteDuringRead(t *testing.T) {
+	t.Parallel()
+
+	var listCalls atomic.Int32
+	readStarted := make(chan struct{})
+	writeDone := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the first read is delayed until the write is done so that its response is stale
+		if r.Method == http.MethodGet && listCalls.Add(1) == 1 {
+			close(readStarted)
+			<-writeDone
+		}
+		w.Write([]byte(`{"data": [{"id": 1}], "extra": {"pagination": {"count": 1, "total_count": 1}}}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL)
+	c.ReadCache = NewReadCache()
+	ctx := context.Background()
+
+	listURL := server.URL + "/v3/accounts/1/environments/"
+	readErr := make(chan error)
+	go func() {
+		_, err := GetAll[testObject](ctx, c, listURL, nil)
+		readErr <- err
+	}()
+
+	<-readStarted
+	req, _ := http.NewRequestWithContext(ctx, http.MethodDelete, server.URL+"/v3/accounts/1/projects/2/environments/3/", nil)
+	_, err := c.doRequest(req)
+	close(writeDone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := <-readErr; err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := GetAll[testObject](ctx, c, listURL, nil); err != nil {
+		t.Fatal(err)
+	}
+	if calls := listCalls.Load(); calls != 2 {
+		t.Fatalf("expected the read started before the write not to be cached, got %d calls", calls)
+	}
+}
+
+func TestReadCacheOnlyListEndpoints(t *testing.T) {
+	t.Parallel()
+
+	var listCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		listCalls.Add(1)
+		w.Write([]byte(`{"data": [{"id": 1}], "extra": {"pagination": {"count": 1, "total_count": 1}}}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL)
+	c.ReadCache = NewReadCache()
+	ctx := context.Background()
+
+	listURLs := []string{
+		server.URL + "/v2/accounts/1/runs/",
+		server.URL + "/v3/accounts/1/projects/2/environments/",
+		server.URL + "/v3/accounts/1/jobs/",
+	}
+	for _, listURL := range listURLs {
+		for i := 0; i < 2; i++ {
+			if _, err := GetAll[testObject](ctx, c, listURL, nil); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if calls := listCalls.Load(); calls != 6 {
+		t.Fatalf("expected the endpoints not listed in cachedListEndpoints to bypass the cache, got %d calls", calls)
+	}
+
+	// the runs are never cached, even through the client methods
+	for i := 0; i < 2; i++ {
+		if _, err := c.GetAllRuns(ctx, RunFilters{Limit: 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if calls := listCalls.Load(); calls != 8 {
+		t.Fatalf("expected the runs to bypass the cache, got %d calls", calls)
+	}
+}
+
+func TestListEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"/api/v3/accounts/1/environments/":            "environments",
+		"/api/v3/accounts/1/ip-restrictions/":         "ip-restrictions",
+		"/api/v3/accounts/1/users/":                   "users",
+		"/api/v3/accounts/1/projects/2/":              "",
+		"/api/v3/accounts/1/projects/2/environments/": "",
+		"/api/v2/accounts/1/runs/":                    "",
+	}
+
+	for path, want := range testCases {
+		if got := listEndpoint(path); got != want {
+			t.Errorf("listEndpoint(%q): got %q, want %q", path, got, want)
+		}
+	}
+}
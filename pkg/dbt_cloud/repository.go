@@ -1,8 +1,8 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -44,18 +44,13 @@ type RepositoryResponse struct {
 }
 
 func (c *Client) GetRepository(
+	ctx context.Context,
 	repositoryID, projectID string,
 ) (*Repository, error) {
 
-	repositoryUrl := fmt.Sprintf(
-		"%s/v3/accounts/%s/projects/%s/repositories/%s/",
-		c.HostURL,
-		strconv.Itoa(c.AccountID),
-		projectID,
-		repositoryID,
-	)
+	repositoryUrl := c.V3AccountURL("projects/%s/repositories/%s/", projectID, repositoryID)
 
-	req, err := http.NewRequest("GET", repositoryUrl, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", repositoryUrl, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -75,6 +70,7 @@ func (c *Client) GetRepository(
 }
 
 func (c *Client) CreateRepository(
+	ctx context.Context,
 	projectID int,
 	remoteUrl string,
 	isActive bool,
@@ -115,14 +111,10 @@ func (c *Client) CreateRepository(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/projects/%s/repositories/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			strconv.Itoa(projectID),
-		),
+		c.V3AccountURL("projects/%s/repositories/", strconv.Itoa(projectID)),
 		strings.NewReader(string(newRepositoryData)),
 	)
 	if err != nil {
@@ -154,6 +146,7 @@ func (c *Client) CreateRepository(
 		}
 
 		updatedRepo, err := c.UpdateRepository(
+			ctx,
 			strconv.Itoa(*repositoryResponse.Data.ID),
 			strconv.Itoa(projectID),
 			newRepository,
@@ -168,6 +161,7 @@ func (c *Client) CreateRepository(
 }
 
 func (c *Client) UpdateRepository(
+	ctx context.Context,
 	repositoryID, projectID string,
 	repository Repository,
 ) (*Repository, error) {
@@ -180,15 +174,10 @@ func (c *Client) UpdateRepository(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/projects/%s/repositories/%s/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			projectID,
-			repositoryID,
-		),
+		c.V3AccountURL("projects/%s/repositories/%s/", projectID, repositoryID),
 		strings.NewReader(string(repositoryData)),
 	)
 	if err != nil {
@@ -209,16 +198,11 @@ func (c *Client) UpdateRepository(
 	return &repositoryResponse.Data, nil
 }
 
-func (c *Client) DeleteRepository(repositoryID, projectID string) (string, error) {
-	req, err := http.NewRequest(
+func (c *Client) DeleteRepository(ctx context.Context, repositoryID, projectID string) (string, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/projects/%s/repositories/%s/",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			projectID,
-			repositoryID,
-		),
+		c.V3AccountURL("projects/%s/repositories/%s/", projectID, repositoryID),
 		nil,
 	)
 	if err != nil {
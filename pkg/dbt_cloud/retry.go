@@ -0,0 +1,154 @@
+package dbt_cloud
+
+import (
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DEFAULT_RETRY_MAX_ATTEMPTS = 5
+	DEFAULT_RETRY_MAX_WAIT     = 30 * time.Second
+	retryBaseWait              = 1 * time.Second
+)
+
+// isIdempotentMethod returns true for the HTTP methods that can be safely replayed
+// POST is not part of it as replaying it could create duplicated objects
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// shouldRetry decides if a request should be sent again based on the response or transport error
+// - 429 are retried for all methods as the request has not been processed by dbt Cloud
+// - 5xx and transport errors are only retried for idempotent methods
+func shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+
+	if err != nil {
+		return isIdempotentMethod(req.Method)
+	}
+
+	if res.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	switch res.StatusCode {
+	case http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return isIdempotentMethod(req.Method)
+	}
+
+	return false
+}
+
+// parseRetryAfter reads the Retry-After header, which can either be a number of seconds or an HTTP date
+func parseRetryAfter(res *http.Response, now time.Time) (time.Duration, bool) {
+	if res == nil {
+		return 0, false
+	}
+
+	retryAfter := res.Header.Get("Retry-After")
+	if retryAfter == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(retryAfter); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(retryAfter); err == nil {
+		wait := date.Sub(now)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// retryWait returns how long to wait before the next attempt
+// the Retry-After header takes precedence, otherwise we use an exponential backoff with jitter
+func retryWait(attempt int, res *http.Response, maxWait time.Duration) time.Duration {
+	if wait, ok := parseRetryAfter(res, time.Now()); ok {
+		return min(wait, maxWait)
+	}
+
+	backoff := maxWait
+	if attempt < 32 {
+		backoff = min(retryBaseWait*time.Duration(1<<(attempt-1)), maxWait)
+	}
+
+	// we keep at least half of the backoff and randomize the rest
+	half := backoff / 2
+	if half <= 0 {
+		return backoff
+	}
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+// doRequestWithRetry sends the request and retries it on throttling, server and transport errors
+// it returns the last response received with its body already read
+func (c *Client) doRequestWithRetry(req *http.Request) (*http.Response, []byte, error) {
+	maxAttempts := c.RetryMaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	maxWait := c.RetryMaxWait
+	if maxWait <= 0 {
+		maxWait = DEFAULT_RETRY_MAX_WAIT
+	}
+
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.GetBody != nil {
+			newBody, err := req.GetBody()
+			if err != nil {
+				return nil, nil, err
+			}
+			req.Body = newBody
+		}
+
+		if c.RateLimiter != nil {
+			if err := c.RateLimiter.Wait(req.Context()); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		logRequest(req, attempt)
+		start := time.Now()
+		res, err := c.HTTPClient.Do(req)
+
+		var body []byte
+		if err == nil {
+			body, err = io.ReadAll(res.Body)
+			res.Body.Close()
+		}
+		logResponse(req, attempt, start, res, body, err)
+
+		canReplay := req.Body == nil || req.GetBody != nil
+		if attempt >= maxAttempts || !canReplay || !shouldRetry(req, res, err) {
+			return res, body, err
+		}
+
+		timer := time.NewTimer(retryWait(attempt, res, maxWait))
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package dbt_cloud
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestClient(serverURL string) *Client {
+	return &Client{
+		HTTPClient:       &http.Client{Timeout: 5 * time.Second},
+		HostURL:          serverURL,
+		Token:            "test",
+		AccountID:        1,
+		RetryMaxAttempts: 3,
+		RetryMaxWait:     10 * time.Millisecond,
+		PageConcurrency:  3,
+	}
+}
+
+func TestDoRequestRetry(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		method           string
+		statuses         []int
+		expectedAttempts int
+		expectError      bool
+	}{
+		{
+			name:             "GET retried on 502 until success",
+			method:           http.MethodGet,
+			statuses:         []int{502, 503, 200},
+			expectedAttempts: 3,
+		},
+		{
+			name:             "GET stops after max attempts",
+			method:           http.MethodGet,
+			statuses:         []int{500, 500, 500, 500},
+			expectedAttempts: 3,
+			expectError:      true,
+		},
+		{
+			name:             "POST retried on 429",
+			method:           http.MethodPost,
+			statuses:         []int{429, 201},
+			expectedAttempts: 2,
+		},
+		{
+			name:             "POST not retried on 502",
+			method:           http.MethodPost,
+			statuses:         []int{502, 201},
+			expectedAttempts: 1,
+			expectError:      true,
+		},
+		{
+			name:             "400 is not retried",
+			method:           http.MethodGet,
+			statuses:         []int{400, 200},
+			expectedAttempts: 1,
+			expectError:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			attempts := 0
+			server := httptest.NewServer(
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					status := tc.statuses[attempts]
+					attempts++
+					if status == http.StatusTooManyRequests {
+						w.Header().Set("Retry-After", "0")
+					}
+					w.WriteHeader(status)
+					w.Write([]byte(`{"status": {"code": 0}}`))
+				}),
+			)
+			defer server.Close()
+
+			c := newTestClient(server.URL)
+			req, err := http.NewRequest(tc.method, server.URL, strings.NewReader(`{}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = c.doRequest(req)
+			if tc.expectError != (err != nil) {
+				t.Errorf("expected error: %v, got: %v", tc.expectError, err)
+			}
+			if attempts != tc.expectedAttempts {
+				t.Errorf("expected %d attempts, got %d", tc.expectedAttempts, attempts)
+			}
+		})
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	t.Parallel()
+
+	res := &http.Response{Header: http.Header{}}
+	res.Header.Set("Retry-After", "120")
+	if wait := retryWait(1, res, 30*time.Second); wait != 30*time.Second {
+		t.Errorf("expected Retry-After to be capped to 30s, got %v", wait)
+	}
+
+	res.Header.Set("Retry-After", "2")
+	if wait := retryWait(1, res, 30*time.Second); wait != 2*time.Second {
+		t.Errorf("expected Retry-After of 2s, got %v", wait)
+	}
+
+	for attempt := 1; attempt < 10; attempt++ {
+		wait := retryWait(attempt, nil, 8*time.Second)
+		if wait > 8*time.Second {
+			t.Errorf("attempt %d: wait %v is above the max wait", attempt, wait)
+		}
+	}
+
+	wait := retryWait(3, nil, 30*time.Second)
+	if wait < 2*time.Second || wait > 4*time.Second {
+		t.Errorf("expected the third attempt to wait between 2s and 4s, got %v", wait)
+	}
+}
@@ -0,0 +1,203 @@
+package dbt_cloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	RUN_STATUS_QUEUED    = 1
+	RUN_STATUS_STARTING  = 2
+	RUN_STATUS_RUNNING   = 3
+	RUN_STATUS_SUCCESS   = 10
+	RUN_STATUS_ERROR     = 20
+	RUN_STATUS_CANCELLED = 30
+
+	// RUN_POLL_INTERVAL is the time between two calls to the API when waiting for a run to complete
+	RUN_POLL_INTERVAL = 10 * time.Second
+)
+
+// RunStatuses maps the statuses of the runs, as returned in status_humanized but in lowercase, to their code
+var RunStatuses = map[string]int{
+	"queued":    RUN_STATUS_QUEUED,
+	"starting":  RUN_STATUS_STARTING,
+	"running":   RUN_STATUS_RUNNING,
+	"success":   RUN_STATUS_SUCCESS,
+	"error":     RUN_STATUS_ERROR,
+	"cancelled": RUN_STATUS_CANCELLED,
+}
+
+type RunTrigger struct {
+	Cause         string   `json:"cause"`
+	GitBranch     *string  `json:"git_branch,omitempty"`
+	StepsOverride []string `json:"steps_override,omitempty"`
+}
+
+type RunStep struct {
+	ID              int    `json:"id"`
+	Index           int    `json:"index"`
+	Name            string `json:"name"`
+	Status          int    `json:"status"`
+	StatusHumanized string `json:"status_humanized"`
+	Duration        string `json:"duration"`
+}
+
+type Run struct {
+	ID              *int        `json:"id"`
+	AccountID       int         `json:"account_id"`
+	ProjectID       int         `json:"project_id"`
+	EnvironmentID   int         `json:"environment_id"`
+	JobDefinitionID int         `json:"job_definition_id"`
+	Status          int         `json:"status"`
+	StatusHumanized string      `json:"status_humanized"`
+	StatusMessage   *string     `json:"status_message"`
+	GitBranch       *string     `json:"git_branch"`
+	GitSHA          *string     `json:"git_sha"`
+	Href            string      `json:"href"`
+	IsComplete      bool        `json:"is_complete"`
+	IsSuccess       bool        `json:"is_success"`
+	IsError         bool        `json:"is_error"`
+	IsCancelled     bool        `json:"is_cancelled"`
+	CreatedAt       string      `json:"created_at"`
+	StartedAt       *string     `json:"started_at"`
+	FinishedAt      *string     `json:"finished_at"`
+	Duration        string      `json:"duration"`
+	Trigger         *RunTrigger `json:"trigger,omitempty"`
+	RunSteps        []RunStep   `json:"run_steps,omitempty"`
+}
+
+// runTimeLayouts are the formats of the dates returned for the runs, e.g. 2024-06-12 09:32:11.384236+00:00
+var runTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999-07:00",
+	"2006-01-02 15:04:05.999999Z07:00",
+	time.RFC3339Nano,
+}
+
+// CreatedTime returns the creation date of the run
+func (r Run) CreatedTime() (time.Time, error) {
+	for _, layout := range runTimeLayouts {
+		createdAt, err := time.Parse(layout, r.CreatedAt)
+		if err == nil {
+			return createdAt, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("the creation date %q of the run is not in a known format", r.CreatedAt)
+}
+
+// RunFilters are the filters of the runs listed, the zero values are ignored
+type RunFilters struct {
+	JobDefinitionID int
+	EnvironmentID   int
+	ProjectID       int
+	Status          int
+	CreatedAfter    time.Time
+	Limit           int
+}
+
+type RunResponse struct {
+	Data   Run            `json:"data"`
+	Status ResponseStatus `json:"status"`
+}
+
+// TriggerRunRequest holds the parameters of a run, the steps and the branch of the job are used when they are empty
+type TriggerRunRequest struct {
+	Cause         string   `json:"cause"`
+	GitBranch     string   `json:"git_branch,omitempty"`
+	StepsOverride []string `json:"steps_override,omitempty"`
+}
+
+// TriggerRun starts a run of the job and returns it without waiting for it to complete
+func (c *Client) TriggerRun(ctx context.Context, jobID int, request TriggerRunRequest) (*Run, error) {
+	runData, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		c.V2AccountURL("jobs/%d/run/", jobID),
+		strings.NewReader(string(runData)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doRunRequest(req)
+}
+
+func (c *Client) GetRun(ctx context.Context, runID int) (*Run, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"GET",
+		c.V2AccountURL("runs/%d/", runID),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doRunRequest(req)
+}
+
+// CancelRun cancels a run that didn't complete yet, the run is returned with its new status
+func (c *Client) CancelRun(ctx context.Context, runID int) (*Run, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		"POST",
+		c.V2AccountURL("runs/%d/cancel/", runID),
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doRunRequest(req)
+}
+
+// WaitForRun polls the run every pollInterval until it completes, or until the context is done
+// the last state of the run is returned with the error when the context is done
+func (c *Client) WaitForRun(ctx context.Context, runID int, pollInterval time.Duration) (*Run, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var lastRun *Run
+	for {
+		run, err := c.GetRun(ctx, runID)
+		if err != nil {
+			if ctx.Err() != nil && lastRun != nil {
+				return lastRun, fmt.Errorf("run %d did not complete: %w", runID, ctx.Err())
+			}
+			return lastRun, err
+		}
+		lastRun = run
+		if run.IsComplete {
+			return run, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return lastRun, fmt.Errorf("run %d did not complete: %w", runID, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Client) doRunRequest(req *http.Request) (*Run, error) {
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	runResponse := RunResponse{}
+	err = json.Unmarshal(body, &runResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &runResponse.Data, nil
+}
@@ -0,0 +1,202 @@
+package dbt_cloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTriggerAndWaitForRun(t *testing.T) {
+	t.Parallel()
+
+	var triggerRequest TriggerRunRequest
+	var getCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/accounts/1/jobs/5/run/":
+			json.NewDecoder(r.Body).Decode(&triggerRequest)
+			w.Write([]byte(`{"data": {"id": 7, "job_definition_id": 5, "status": 1, "is_complete": false}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v2/accounts/1/runs/7/":
+			// the run completes on the third call
+			if getCalls.Add(1) < 3 {
+				w.Write([]byte(`{"data": {"id": 7, "status": 3, "status_humanized": "Running", "is_complete": false}}`))
+				return
+			}
+			w.Write([]byte(`{"data": {"id": 7, "status": 20, "status_humanized": "Error", "is_complete": true, "is_error": true}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL)
+	ctx := context.Background()
+
+	run, err := c.TriggerRun(ctx, 5, TriggerRunRequest{
+		Cause:         "Triggered by Terraform",
+		StepsOverride: []string{"dbt build --full-refresh"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *run.ID != 7 || run.IsComplete {
+		t.Fatalf("unexpected run: %+v", run)
+	}
+	if triggerRequest.Cause != "Triggered by Terraform" || len(triggerRequest.StepsOverride) != 1 ||
+		triggerRequest.GitBranch != "" {
+		t.Errorf("unexpected trigger request: %+v", triggerRequest)
+	}
+
+	run, err = c.WaitForRun(ctx, 7, time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if run.Status != RUN_STATUS_ERROR || run.StatusHumanized != "Error" || getCalls.Load() != 3 {
+		t.Errorf("expected the run to complete with an error after 3 calls, got %+v after %d calls", run, getCalls.Load())
+	}
+}
+
+func TestWaitForRunContextDone(t *testing.T) {
+	t.Parallel()
+
+	var cancelCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost && r.URL.Path == "/v2/accounts/1/runs/7/cancel/" {
+			cancelCalls.Add(1)
+			w.Write([]byte(`{"data": {"id": 7, "status": 30, "status_humanized": "Cancelled", "is_complete": true, "is_cancelled": true}}`))
+			return
+		}
+		w.Write([]byte(`{"data": {"id": 7, "status": 3, "status_humanized": "Running", "is_complete": false}}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	run, err := c.WaitForRun(ctx, 7, 5*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the deadline to be exceeded, got %v", err)
+	}
+	if run == nil || run.StatusHumanized != "Running" {
+		t.Errorf("expected the last state of the run to be returned, got %+v", run)
+	}
+
+	run, err = c.CancelRun(context.Background(), 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !run.IsCancelled || cancelCalls.Load() != 1 {
+		t.Errorf("expected the run to be cancelled, got %+v", run)
+	}
+}
+
+// newRunsServer returns a server listing 25 runs from the most recent, one per day until 2024-01-25
+// the pages have at most 10 runs
+func newRunsServer(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		query := r.URL.Query()
+		if r.URL.Path != "/v2/accounts/1/runs/" || query.Get("order_by") != "-id" ||
+			query.Get("job_definition_id") != "5" || query.Get("status") != "10" {
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+
+		limit, _ := strconv.Atoi(query.Get("limit"))
+		offset, _ := strconv.Atoi(query.Get("offset"))
+		data := []map[string]any{}
+		for i := offset; i < min(offset+min(limit, 10), 25); i++ {
+			data = append(data, map[string]any{
+				"id":         25 - i,
+				"status":     RUN_STATUS_SUCCESS,
+				"created_at": fmt.Sprintf("2024-01-%02d 10:00:00.123456+00:00", 25-i),
+			})
+		}
+
+		json.NewEncoder(w).Encode(map[string]any{
+			"data":  data,
+			"extra": map[string]any{"pagination": map[string]int{"count": len(data), "total_count": 25}},
+		})
+	}))
+}
+
+func TestGetAllRuns(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		createdAfter     time.Time
+		limit            int
+		expectedRuns     int
+		expectedRequests int32
+	}{
+		{name: "limit in the first page", limit: 5, expectedRuns: 5, expectedRequests: 1},
+		{name: "limit over several pages", limit: 12, expectedRuns: 12, expectedRequests: 2},
+		{name: "no limit", expectedRuns: 25, expectedRequests: 3},
+		{
+			name:             "created after",
+			createdAfter:     time.Date(2024, 1, 18, 0, 0, 0, 0, time.UTC),
+			expectedRuns:     8,
+			expectedRequests: 1,
+		},
+		{
+			name:             "created after and limit",
+			createdAfter:     time.Date(2024, 1, 18, 0, 0, 0, 0, time.UTC),
+			limit:            4,
+			expectedRuns:     4,
+			expectedRequests: 1,
+		},
+		{
+			name:             "created after reached before the limit",
+			createdAfter:     time.Date(2024, 1, 23, 0, 0, 0, 0, time.UTC),
+			limit:            5,
+			expectedRuns:     3,
+			expectedRequests: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests atomic.Int32
+			server := newRunsServer(t, &requests)
+			defer server.Close()
+
+			c := newTestClient(server.URL)
+
+			runs, err := c.GetAllRuns(context.Background(), RunFilters{
+				JobDefinitionID: 5,
+				Status:          RUN_STATUS_SUCCESS,
+				CreatedAfter:    tc.createdAfter,
+				Limit:           tc.limit,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(runs) != tc.expectedRuns || requests.Load() != tc.expectedRequests {
+				t.Fatalf(
+					"expected %d runs in %d requests, got %d runs in %d requests",
+					tc.expectedRuns,
+					tc.expectedRequests,
+					len(runs),
+					requests.Load(),
+				)
+			}
+			for i, run := range runs {
+				if *run.ID != 25-i {
+					t.Errorf("expected the run %d at position %d, got %d", 25-i, i, *run.ID)
+				}
+			}
+		})
+	}
+}
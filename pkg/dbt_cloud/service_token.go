@@ -1,8 +1,8 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -44,8 +44,8 @@ type ServiceTokenPermissionListResponse struct {
 	Status ResponseStatus           `json:"status"`
 }
 
-func (c *Client) GetServiceTokenPermissions(serviceTokenID int) (*[]ServiceTokenPermission, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%s/service-tokens/%s/permissions/", c.HostURL, strconv.Itoa(c.AccountID), strconv.Itoa(serviceTokenID)), nil)
+func (c *Client) GetServiceTokenPermissions(ctx context.Context, serviceTokenID int) (*[]ServiceTokenPermission, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.V3AccountURL("service-tokens/%s/permissions/", strconv.Itoa(serviceTokenID)), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -64,8 +64,8 @@ func (c *Client) GetServiceTokenPermissions(serviceTokenID int) (*[]ServiceToken
 	return &serviceTokenPermissionListResponse.Data, nil
 }
 
-func (c *Client) GetServiceToken(serviceTokenID int) (*ServiceToken, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v3/accounts/%s/service-tokens/%s/", c.HostURL, strconv.Itoa(c.AccountID), strconv.Itoa(serviceTokenID)), nil)
+func (c *Client) GetServiceToken(ctx context.Context, serviceTokenID int) (*ServiceToken, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.V3AccountURL("service-tokens/%s/", strconv.Itoa(serviceTokenID)), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -83,10 +83,10 @@ func (c *Client) GetServiceToken(serviceTokenID int) (*ServiceToken, error) {
 
 	// the endpoint returns service tokens when their state is inactive, so we need to check for the state
 	if serviceTokenResponse.Data.State != STATE_ACTIVE {
-		return nil, fmt.Errorf("resource-not-found: service token %d is not active", serviceTokenID)
+		return nil, NewNotFoundError("service token %d is not active", serviceTokenID)
 	}
 
-	permissions, err := c.GetServiceTokenPermissions(serviceTokenID)
+	permissions, err := c.GetServiceTokenPermissions(ctx, serviceTokenID)
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +96,7 @@ func (c *Client) GetServiceToken(serviceTokenID int) (*ServiceToken, error) {
 }
 
 func (c *Client) CreateServiceToken(
+	ctx context.Context,
 	name string,
 	state int,
 ) (*ServiceToken, error) {
@@ -109,7 +110,7 @@ func (c *Client) CreateServiceToken(
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v3/accounts/%d/service-tokens/", c.HostURL, c.AccountID), strings.NewReader(string(newServiceTokenData)))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.V3AccountURL("service-tokens/"), strings.NewReader(string(newServiceTokenData)))
 	if err != nil {
 		return nil, err
 	}
@@ -128,13 +129,13 @@ func (c *Client) CreateServiceToken(
 	return &serviceTokenResponse.Data, nil
 }
 
-func (c *Client) UpdateServiceToken(serviceTokenID int, serviceToken ServiceToken) (*ServiceToken, error) {
+func (c *Client) UpdateServiceToken(ctx context.Context, serviceTokenID int, serviceToken ServiceToken) (*ServiceToken, error) {
 	serviceTokenData, err := json.Marshal(serviceToken)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v3/accounts/%s/service-tokens/%d/", c.HostURL, strconv.Itoa(c.AccountID), serviceTokenID), strings.NewReader(string(serviceTokenData)))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.V3AccountURL("service-tokens/%d/", serviceTokenID), strings.NewReader(string(serviceTokenData)))
 	if err != nil {
 		return nil, err
 	}
@@ -153,14 +154,14 @@ func (c *Client) UpdateServiceToken(serviceTokenID int, serviceToken ServiceToke
 	return &serviceTokenResponse.Data, nil
 }
 
-func (c *Client) UpdateServiceTokenPermissions(serviceTokenID int, serviceTokenPermissions []ServiceTokenPermission) (*[]ServiceTokenPermission, error) {
+func (c *Client) UpdateServiceTokenPermissions(ctx context.Context, serviceTokenID int, serviceTokenPermissions []ServiceTokenPermission) (*[]ServiceTokenPermission, error) {
 	serviceTokenPermissionData, err := json.Marshal(serviceTokenPermissions)
 
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v3/accounts/%s/service-tokens/%d/permissions/", c.HostURL, strconv.Itoa(c.AccountID), serviceTokenID), strings.NewReader(string(serviceTokenPermissionData)))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.V3AccountURL("service-tokens/%d/permissions/", serviceTokenID), strings.NewReader(string(serviceTokenPermissionData)))
 	if err != nil {
 		return nil, err
 	}
@@ -179,8 +180,8 @@ func (c *Client) UpdateServiceTokenPermissions(serviceTokenID int, serviceTokenP
 	return &serviceTokenPermissionResponse.Data, nil
 }
 
-func (c *Client) DeleteServiceToken(serviceTokenID int) (string, error) {
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/v3/accounts/%d/service-tokens/%d/", c.HostURL, c.AccountID, serviceTokenID), nil)
+func (c *Client) DeleteServiceToken(ctx context.Context, serviceTokenID int) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "DELETE", c.V3AccountURL("service-tokens/%d/", serviceTokenID), nil)
 	if err != nil {
 		return "", err
 	}
@@ -1,17 +1,9 @@
 package dbt_cloud
 
 import (
-	"encoding/json"
-	"fmt"
-	"net/http"
-	"strings"
+	"context"
 )
 
-type SnowflakeCredentialResponse struct {
-	Data   SnowflakeCredential `json:"data"`
-	Status ResponseStatus      `json:"status"`
-}
-
 type SnowflakeCredential struct {
 	ID                   *int   `json:"id"`
 	Account_Id           int    `json:"account_id"`
@@ -30,40 +22,19 @@ type SnowflakeCredential struct {
 	PrivateKeyPassphrase string `json:"private_key_passphrase,omitempty"`
 }
 
+func (SnowflakeCredential) IncludeRelated() []string { return nil }
+
 func (c *Client) GetSnowflakeCredential(
+	ctx context.Context,
 	projectId int,
 	credentialId int,
 ) (*SnowflakeCredential, error) {
-	req, err := http.NewRequest(
-		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-			credentialId,
-		),
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	snowflakeCredentialResponse := SnowflakeCredentialResponse{}
-	err = json.Unmarshal(body, &snowflakeCredentialResponse)
-	if err != nil {
-		return nil, err
-	}
-
-	return &snowflakeCredentialResponse.Data, nil
+	client := NewCredentialClient[SnowflakeCredential](c)
+	return client.Get(ctx, projectId, credentialId)
 }
 
 func (c *Client) CreateSnowflakeCredential(
+	ctx context.Context,
 	projectId int,
 	type_ string,
 	isActive bool,
@@ -98,81 +69,21 @@ func (c *Client) CreateSnowflakeCredential(
 		newSnowflakeCredential.PrivateKey = privateKey
 		newSnowflakeCredential.PrivateKeyPassphrase = privateKeyPassphrase
 	}
-	newSnowflakeCredentialData, err := json.Marshal(newSnowflakeCredential)
-	if err != nil {
-		return nil, err
-	}
 
-	req, err := http.NewRequest(
-		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/credentials/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-		),
-		strings.NewReader(string(newSnowflakeCredentialData)),
-	)
+	client := NewCredentialClient[SnowflakeCredential](c)
+	snowflakeCredential, err := client.Create(ctx, projectId, newSnowflakeCredential)
 	if err != nil {
 		return nil, err
 	}
 
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	snowflakeCredentialResponse := SnowflakeCredentialResponse{}
-	err = json.Unmarshal(body, &snowflakeCredentialResponse)
-	if err != nil {
-		return nil, err
-	}
+	// the secrets are not returned by the API
 	if authType == "password" {
-		snowflakeCredentialResponse.Data.Password = password
+		snowflakeCredential.Password = password
 	}
 	if authType == "keypair" {
-		snowflakeCredentialResponse.Data.PrivateKey = privateKey
-		snowflakeCredentialResponse.Data.PrivateKeyPassphrase = privateKeyPassphrase
-	}
-
-	return &snowflakeCredentialResponse.Data, nil
-}
-
-func (c *Client) UpdateSnowflakeCredential(
-	projectId int,
-	credentialId int,
-	snowflakeCredential SnowflakeCredential,
-) (*SnowflakeCredential, error) {
-	snowflakeCredentialData, err := json.Marshal(snowflakeCredential)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest(
-		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%d/projects/%d/credentials/%d/",
-			c.HostURL,
-			c.AccountID,
-			projectId,
-			credentialId,
-		),
-		strings.NewReader(string(snowflakeCredentialData)),
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	snowflakeCredentialResponse := SnowflakeCredentialResponse{}
-	err = json.Unmarshal(body, &snowflakeCredentialResponse)
-	if err != nil {
-		return nil, err
+		snowflakeCredential.PrivateKey = privateKey
+		snowflakeCredential.PrivateKeyPassphrase = privateKeyPassphrase
 	}
 
-	return &snowflakeCredentialResponse.Data, nil
+	return snowflakeCredential, nil
 }
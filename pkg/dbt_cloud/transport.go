@@ -0,0 +1,120 @@
+package dbt_cloud
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+const DEFAULT_HTTP_TIMEOUT = 30 * time.Second
+
+// newHTTPClient builds the HTTP client used to call dbt Cloud from the transport settings of the config
+// without any setting, it behaves like the default Go client with a timeout of 30s
+func newHTTPClient(config *ClientConfig) (*http.Client, error) {
+	timeout := DEFAULT_HTTP_TIMEOUT
+	if config.HTTPTimeout > 0 {
+		timeout = config.HTTPTimeout
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL %s: %w", config.ProxyURL, err)
+		}
+		switch proxyURL.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return nil, fmt.Errorf("invalid proxy URL %s: the scheme must be http, https or socks5", config.ProxyURL)
+		}
+		if proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %s: the host is missing", config.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	tlsConfig, err := newTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+	}, nil
+}
+
+// newTLSConfig returns the TLS config for custom CAs and client certificates, or nil when none are set
+func newTLSConfig(config *ClientConfig) (*tls.Config, error) {
+	caCertPEM, err := pemFromValueOrFile("CA certificate", config.CACertPEM, config.CACertFile)
+	if err != nil {
+		return nil, err
+	}
+	clientCertPEM, err := pemFromValueOrFile("client certificate", config.ClientCertPEM, config.ClientCertFile)
+	if err != nil {
+		return nil, err
+	}
+	clientKeyPEM, err := pemFromValueOrFile("client key", config.ClientKeyPEM, config.ClientKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if caCertPEM == nil && clientCertPEM == nil && clientKeyPEM == nil {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if caCertPEM != nil {
+		// the custom CAs are added to the system ones so that public endpoints keep working
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil || rootCAs == nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(caCertPEM) {
+			return nil, fmt.Errorf("the CA certificate doesn't contain any valid PEM encoded certificate")
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if (clientCertPEM == nil) != (clientKeyPEM == nil) {
+		return nil, fmt.Errorf("both the client certificate and the client key need to be set to use mTLS")
+	}
+	if clientCertPEM != nil {
+		certificate, err := tls.X509KeyPair(clientCertPEM, clientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate or key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return tlsConfig, nil
+}
+
+// pemFromValueOrFile returns the PEM content either given directly or read from a file
+func pemFromValueOrFile(name string, value string, file string) ([]byte, error) {
+	if value != "" && file != "" {
+		return nil, fmt.Errorf("only one of the %s PEM content or file can be set", name)
+	}
+	if value != "" {
+		return []byte(value), nil
+	}
+	if file != "" {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read the %s file: %w", name, err)
+		}
+		return content, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,190 @@
+package dbt_cloud
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func certificatePEM(cert *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+}
+
+// newClientCertificate generates a self-signed client certificate and its key, PEM encoded
+func newClientCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform-provider-dbtcloud"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+	return string(certPEM), string(keyPEM)
+}
+
+func okHandler(w http.ResponseWriter, r *http.Request) {
+	w.Write([]byte(`{}`))
+}
+
+func TestHTTPClientCustomCA(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(okHandler))
+	defer server.Close()
+
+	defaultClient, err := newHTTPClient(&ClientConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := defaultClient.Get(server.URL); err == nil {
+		t.Fatal("expected the request to fail without the custom CA")
+	}
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte(certificatePEM(server.Certificate())), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, config := range []*ClientConfig{
+		{CACertPEM: certificatePEM(server.Certificate())},
+		{CACertFile: caFile},
+	} {
+		client, err := newHTTPClient(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("expected the request to succeed with the custom CA, got %v", err)
+		}
+		res.Body.Close()
+	}
+}
+
+func TestHTTPClientMTLS(t *testing.T) {
+	t.Parallel()
+
+	clientCertPEM, clientKeyPEM := newClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AppendCertsFromPEM([]byte(clientCertPEM))
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(okHandler))
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	caCertPEM := certificatePEM(server.Certificate())
+
+	withoutCert, err := newHTTPClient(&ClientConfig{CACertPEM: caCertPEM})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := withoutCert.Get(server.URL); err == nil {
+		t.Fatal("expected the request to fail without a client certificate")
+	}
+
+	withCert, err := newHTTPClient(&ClientConfig{
+		CACertPEM:     caCertPEM,
+		ClientCertPEM: clientCertPEM,
+		ClientKeyPEM:  clientKeyPEM,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := withCert.Get(server.URL)
+	if err != nil {
+		t.Fatalf("expected the request to succeed with the client certificate, got %v", err)
+	}
+	res.Body.Close()
+}
+
+func TestHTTPClientProxy(t *testing.T) {
+	t.Parallel()
+
+	proxiedHosts := make(chan string, 1)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxiedHosts <- r.URL.Host
+		okHandler(w, r)
+	}))
+	defer proxy.Close()
+
+	client, err := newHTTPClient(&ClientConfig{ProxyURL: proxy.URL, HTTPTimeout: 5 * time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.Timeout != 5*time.Second {
+		t.Errorf("expected the timeout to be set, got %v", client.Timeout)
+	}
+
+	res, err := client.Get("http://cloud.example.com/api/v2/accounts/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+
+	if host := <-proxiedHosts; host != "cloud.example.com" {
+		t.Errorf("expected the request to go through the proxy, got host %s", host)
+	}
+}
+
+func TestHTTPClientInvalidConfig(t *testing.T) {
+	t.Parallel()
+
+	clientCertPEM, _ := newClientCertificate(t)
+
+	testCases := []struct {
+		name   string
+		config ClientConfig
+	}{
+		{name: "proxy without scheme", config: ClientConfig{ProxyURL: "proxy.example.com:3128"}},
+		{name: "CA PEM and file", config: ClientConfig{CACertPEM: "pem", CACertFile: "ca.pem"}},
+		{name: "invalid CA", config: ClientConfig{CACertPEM: "not a certificate"}},
+		{name: "missing CA file", config: ClientConfig{CACertFile: filepath.Join(t.TempDir(), "missing.pem")}},
+		{name: "certificate without key", config: ClientConfig{ClientCertPEM: clientCertPEM}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if _, err := newHTTPClient(&tc.config); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package dbt_cloud
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+const (
+	API_V2      = "v2"
+	API_V3      = "v3"
+	API_PRIVATE = "private"
+)
+
+// the subdomains of the multi-cell access URLs used for the other dbt Cloud APIs
+// e.g. ab123.metadata.us1.dbt.com is the Discovery API of the account at ab123.us1.dbt.com
+var cellAPISubdomains = []string{"metadata", "semantic-layer"}
+
+// NormalizeHostURL returns the base URL of the dbt Cloud API from the value given by the user, e.g.
+//   - cloud.getdbt.com -> https://cloud.getdbt.com/api
+//   - https://emea.dbt.com/ -> https://emea.dbt.com/api
+//   - ab123.us1.dbt.com -> https://ab123.us1.dbt.com/api
+//   - https://ab123.metadata.us1.dbt.com/api/v2 -> https://ab123.us1.dbt.com/api
+func NormalizeHostURL(hostURL string) (string, error) {
+	hostURL = strings.TrimSpace(hostURL)
+	if hostURL == "" {
+		return "", fmt.Errorf("the host URL is empty")
+	}
+
+	if !strings.Contains(hostURL, "://") {
+		hostURL = "https://" + hostURL
+	}
+
+	parsedURL, err := url.Parse(hostURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid host URL %s: %w", hostURL, err)
+	}
+
+	scheme := strings.ToLower(parsedURL.Scheme)
+	if scheme != "https" && scheme != "http" {
+		return "", fmt.Errorf(
+			"invalid host URL %s: the scheme must be http or https, e.g. https://cloud.getdbt.com/api",
+			hostURL,
+		)
+	}
+	if parsedURL.Hostname() == "" {
+		return "", fmt.Errorf("invalid host URL %s: the host is missing", hostURL)
+	}
+	// the token is sent in the Authorization header, so it can only be sent in clear to the local machine
+	if scheme == "http" && !isLocalhost(parsedURL.Hostname()) {
+		return "", fmt.Errorf(
+			"invalid host URL %s: http is only allowed for localhost as the token would be sent unencrypted, use https instead",
+			hostURL,
+		)
+	}
+
+	host := strings.ToLower(parsedURL.Host)
+	if strings.HasSuffix(parsedURL.Hostname(), ".dbt.com") {
+		labels := strings.Split(host, ".")
+		if len(labels) > 4 {
+			for _, subdomain := range cellAPISubdomains {
+				if labels[1] == subdomain {
+					labels = append(labels[:1], labels[2:]...)
+					break
+				}
+			}
+		}
+		host = strings.Join(labels, ".")
+	}
+
+	// the path is either empty, /api or /api followed by the version of the API
+	// we keep any prefix before /api for deployments behind a reverse proxy
+	path := strings.TrimRight(parsedURL.Path, "/")
+	for _, version := range []string{API_V2, API_V3} {
+		path = strings.TrimSuffix(path, "/"+version)
+	}
+	if !strings.HasSuffix(path, "/api") {
+		if path != "" {
+			return "", fmt.Errorf(
+				"invalid host URL %s: the path should be /api, e.g. https://cloud.getdbt.com/api",
+				hostURL,
+			)
+		}
+		path = "/api"
+	}
+
+	return fmt.Sprintf("%s://%s%s", scheme, host, path), nil
+}
+
+// isLocalhost returns true for the hostnames of the local machine, e.g. localhost or 127.0.0.1
+func isLocalhost(hostname string) bool {
+	hostname = strings.ToLower(hostname)
+	if hostname == "localhost" || strings.HasSuffix(hostname, ".localhost") {
+		return true
+	}
+	ip := net.ParseIP(hostname)
+	return ip != nil && ip.IsLoopback()
+}
+
+// APIURL returns the URL of an endpoint of a given version of the API, the path is formatted with the args
+// e.g. c.APIURL(API_V2, "accounts/") returns https://cloud.getdbt.com/api/v2/accounts/
+func (c *Client) APIURL(version string, format string, a ...any) string {
+	return fmt.Sprintf("%s/%s/%s", c.HostURL, version, fmt.Sprintf(format, a...))
+}
+
+// V2AccountURL returns the URL of an endpoint of the v2 API for the account of the client
+// e.g. c.V2AccountURL("jobs/%d/", 1) returns https://cloud.getdbt.com/api/v2/accounts/<account_id>/jobs/1/
+func (c *Client) V2AccountURL(format string, a ...any) string {
+	return c.APIURL(API_V2, "accounts/%d/%s", c.AccountID, fmt.Sprintf(format, a...))
+}
+
+// V3AccountURL returns the URL of an endpoint of the v3 API for the account of the client
+// e.g. c.V3AccountURL("projects/%d/", 1) returns https://cloud.getdbt.com/api/v3/accounts/<account_id>/projects/1/
+func (c *Client) V3AccountURL(format string, a ...any) string {
+	return c.APIURL(API_V3, "accounts/%d/%s", c.AccountID, fmt.Sprintf(format, a...))
+}
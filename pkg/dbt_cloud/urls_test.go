@@ -0,0 +1,81 @@
+package dbt_cloud
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNormalizeHostURL(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		hostURL  string
+		expected string
+		err      string
+	}{
+		{hostURL: "https://cloud.getdbt.com/api", expected: "https://cloud.getdbt.com/api"},
+		{hostURL: "https://cloud.getdbt.com/api/", expected: "https://cloud.getdbt.com/api"},
+		{hostURL: "https://cloud.getdbt.com", expected: "https://cloud.getdbt.com/api"},
+		{hostURL: "https://cloud.getdbt.com/", expected: "https://cloud.getdbt.com/api"},
+		{hostURL: " cloud.getdbt.com ", expected: "https://cloud.getdbt.com/api"},
+		{hostURL: "https://emea.dbt.com/api/v2/", expected: "https://emea.dbt.com/api"},
+		{hostURL: "https://EMEA.dbt.com/api/v3", expected: "https://emea.dbt.com/api"},
+		{hostURL: "ab123.us1.dbt.com", expected: "https://ab123.us1.dbt.com/api"},
+		{hostURL: "https://ab123.metadata.us1.dbt.com/api", expected: "https://ab123.us1.dbt.com/api"},
+		{hostURL: "ab123.semantic-layer.us1.dbt.com", expected: "https://ab123.us1.dbt.com/api"},
+		{hostURL: "https://dbt.example.com/proxy/api", expected: "https://dbt.example.com/proxy/api"},
+		{hostURL: "http://localhost:8080", expected: "http://localhost:8080/api"},
+		{hostURL: "http://127.0.0.1:8080/api", expected: "http://127.0.0.1:8080/api"},
+		{hostURL: "http://[::1]:8080/api", expected: "http://[::1]:8080/api"},
+		{hostURL: "http://dbt.localhost/api", expected: "http://dbt.localhost/api"},
+		{hostURL: "", err: "the host URL is empty"},
+		{hostURL: "ftp://cloud.getdbt.com/api", err: "the scheme must be http or https"},
+		{hostURL: "http://cloud.getdbt.com/api", err: "http is only allowed for localhost"},
+		{hostURL: "http://10.0.0.1/api", err: "http is only allowed for localhost"},
+		{hostURL: "https:///api", err: "the host is missing"},
+		{hostURL: "https://cloud.getdbt.com/deploy/1/projects", err: "the path should be /api"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.hostURL, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := NormalizeHostURL(tc.hostURL)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Errorf("expected an error containing %q, got %s and %v", tc.err, actual, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if actual != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestAPIURL(t *testing.T) {
+	t.Parallel()
+
+	c := &Client{HostURL: "https://cloud.getdbt.com/api", AccountID: 1}
+
+	testCases := []struct {
+		actual   string
+		expected string
+	}{
+		{actual: c.APIURL(API_V2, "accounts/"), expected: "https://cloud.getdbt.com/api/v2/accounts/"},
+		{actual: c.V2AccountURL("jobs/%d/", 3), expected: "https://cloud.getdbt.com/api/v2/accounts/1/jobs/3/"},
+		{actual: c.V3AccountURL("projects/%d/environments/", 2), expected: "https://cloud.getdbt.com/api/v3/accounts/1/projects/2/environments/"},
+		{actual: c.V3AccountURL("projects/?name__icontains=%s", "100%25"), expected: "https://cloud.getdbt.com/api/v3/accounts/1/projects/?name__icontains=100%25"},
+	}
+
+	for _, tc := range testCases {
+		if tc.actual != tc.expected {
+			t.Errorf("expected %s, got %s", tc.expected, tc.actual)
+		}
+	}
+}
@@ -1,10 +1,10 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"strconv"
 	"strings"
 )
 
@@ -34,78 +34,15 @@ type CurrentUserResponse struct {
 	Status ResponseStatus `json:"status"`
 }
 
-func (c *Client) GetUsers() ([]User, error) {
-	req, err := http.NewRequest(
-		"GET",
-		fmt.Sprintf("%s/v3/accounts/%s/users/", c.HostURL, strconv.Itoa(c.AccountID)),
-		nil,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := c.doRequest(req)
-	if err != nil {
-		return nil, err
-	}
-
-	userListResponse := UserListResponse{}
-	err = json.Unmarshal(body, &userListResponse)
-	if err != nil {
-		return nil, err
-	}
-
-	listAllUsers := userListResponse.Data
-
-	// if there are more than the limit, we need to paginate
-	if userListResponse.Extra.Pagination.TotalCount > userListResponse.Extra.Filters.Limit {
-		numUsers := userListResponse.Extra.Pagination.Count
-		for numUsers < userListResponse.Extra.Pagination.TotalCount {
-
-			req, err := http.NewRequest(
-				"GET",
-				fmt.Sprintf(
-					"%s/v3/accounts/%s/users/?offset=%d",
-					c.HostURL,
-					strconv.Itoa(c.AccountID),
-					numUsers,
-				),
-				nil,
-			)
-			if err != nil {
-				return nil, err
-			}
-
-			body, err := c.doRequest(req)
-			if err != nil {
-				return nil, err
-			}
-
-			userListResponse := UserListResponse{}
-			err = json.Unmarshal(body, &userListResponse)
-			if err != nil {
-				return nil, err
-			}
-
-			numUsersLastCall := userListResponse.Extra.Pagination.Count
-			if numUsersLastCall > 0 {
-				listAllUsers = append(listAllUsers, userListResponse.Data...)
-				numUsers += userListResponse.Extra.Pagination.Count
-			} else {
-				// this means that most likely one item was deleted since the first call
-				// so the number of items is less than the initial total, we can break the loop
-				break
-			}
-
-		}
-	}
+func (c *Client) GetUsers(ctx context.Context) ([]User, error) {
+	url := c.V3AccountURL("users/")
 
-	return listAllUsers, nil
+	return GetAll[User](ctx, c, url, nil)
 }
 
-func (c *Client) GetUser(email string) (*User, error) {
+func (c *Client) GetUser(ctx context.Context, email string) (*User, error) {
 
-	listAllUsers, err := c.GetUsers()
+	listAllUsers, err := c.GetUsers(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -119,8 +56,8 @@ func (c *Client) GetUser(email string) (*User, error) {
 	return nil, fmt.Errorf("did not find user with email %s", email)
 }
 
-func (c *Client) GetConnectedUser() (*User, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v2/whoami/", c.HostURL), nil)
+func (c *Client) GetConnectedUser(ctx context.Context) (*User, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.APIURL(API_V2, "whoami/"), nil)
 	if err != nil {
 		return nil, err
 	}
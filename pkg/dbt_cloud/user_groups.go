@@ -1,8 +1,8 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
 	"strings"
@@ -38,8 +38,8 @@ type AssignUserGroupsResponse struct {
 	Status ResponseStatus `json:"status"`
 }
 
-func (c *Client) GetUserGroups(userId int) (*UserGroupsCurrentAccount, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/v2/accounts/%s/users/%s/", c.HostURL, strconv.Itoa(c.AccountID), strconv.Itoa(userId)), nil)
+func (c *Client) GetUserGroups(ctx context.Context, userId int) (*UserGroupsCurrentAccount, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.V2AccountURL("users/%s/", strconv.Itoa(userId)), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +67,7 @@ func (c *Client) GetUserGroups(userId int) (*UserGroupsCurrentAccount, error) {
 	return &userGroupsCurrentAccount, nil
 }
 
-func (c *Client) AssignUserGroups(userId int, groupIDs []int) (*AssignUserGroupsResponse, error) {
+func (c *Client) AssignUserGroups(ctx context.Context, userId int, groupIDs []int) (*AssignUserGroupsResponse, error) {
 
 	userGroupsBody := UserGroupsBody{
 		UserID:   userId,
@@ -79,7 +79,7 @@ func (c *Client) AssignUserGroups(userId int, groupIDs []int) (*AssignUserGroups
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/v3/accounts/%s/assign-groups/", c.HostURL, strconv.Itoa(c.AccountID)), strings.NewReader(string(userGroupsData)))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.V3AccountURL("assign-groups/"), strings.NewReader(string(userGroupsData)))
 	if err != nil {
 		return nil, err
 	}
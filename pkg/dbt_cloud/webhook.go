@@ -1,10 +1,9 @@
 package dbt_cloud
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
-	"strconv"
 	"strings"
 )
 
@@ -36,15 +35,11 @@ type WebhookWrite struct {
 	Active      bool     `json:"active,omitempty"`
 }
 
-func (c *Client) GetWebhook(webhookID string) (*WebhookRead, error) {
-	req, err := http.NewRequest(
+func (c *Client) GetWebhook(ctx context.Context, webhookID string) (*WebhookRead, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/webhooks/subscription/%s",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			webhookID,
-		),
+		c.V3AccountURL("webhooks/subscription/%s", webhookID),
 		nil,
 	)
 	if err != nil {
@@ -66,6 +61,7 @@ func (c *Client) GetWebhook(webhookID string) (*WebhookRead, error) {
 }
 
 func (c *Client) CreateWebhook(
+	ctx context.Context,
 	webhookId string,
 	name string,
 	description string,
@@ -90,13 +86,10 @@ func (c *Client) CreateWebhook(
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"POST",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/webhooks/subscriptions",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-		),
+		c.V3AccountURL("webhooks/subscriptions"),
 		strings.NewReader(string(newWebhookData)),
 	)
 	if err != nil {
@@ -117,20 +110,16 @@ func (c *Client) CreateWebhook(
 	return &webhookResponse.Data, nil
 }
 
-func (c *Client) UpdateWebhook(webhookId string, webhook WebhookWrite) (*WebhookRead, error) {
+func (c *Client) UpdateWebhook(ctx context.Context, webhookId string, webhook WebhookWrite) (*WebhookRead, error) {
 	webhookData, err := json.Marshal(webhook)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"PUT",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/webhooks/subscription/%s",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			webhookId,
-		),
+		c.V3AccountURL("webhooks/subscription/%s", webhookId),
 		strings.NewReader(string(webhookData)),
 	)
 	if err != nil {
@@ -151,15 +140,11 @@ func (c *Client) UpdateWebhook(webhookId string, webhook WebhookWrite) (*Webhook
 	return &webhookResponse.Data, nil
 }
 
-func (c *Client) DeleteWebhook(webhookId string) (string, error) {
-	req, err := http.NewRequest(
+func (c *Client) DeleteWebhook(ctx context.Context, webhookId string) (string, error) {
+	req, err := http.NewRequestWithContext(
+		ctx,
 		"DELETE",
-		fmt.Sprintf(
-			"%s/v3/accounts/%s/webhooks/subscription/%s",
-			c.HostURL,
-			strconv.Itoa(c.AccountID),
-			webhookId,
-		),
+		c.V3AccountURL("webhooks/subscription/%s", webhookId),
 		nil,
 	)
 	if err != nil {
@@ -0,0 +1,107 @@
+package dbt_cloud_fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// environment variables don't follow the generic CRUD endpoints
+//   - they are created, updated and deleted by name with /projects/<id>/environment-variables/bulk/
+//   - they are all read at once with /projects/<id>/environment-variables/environment/
+//
+// each variable is stored as one object with its values per environment name
+func (s *Server) handleEnvironmentVariables(w http.ResponseWriter, r *http.Request, rt route) {
+	projectID, ok := rt.parents["project_id"]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Environment variables are only available for a project")
+		return
+	}
+	parents := map[string]int{"project_id": projectID}
+
+	switch {
+	case r.Method == http.MethodGet && rt.id == "environment":
+		s.listEnvironmentVariables(w, projectID)
+		return
+	case rt.id != "bulk":
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Unknown endpoint %s", r.URL.Path))
+		return
+	}
+
+	body := map[string]any{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		values, _ := body["env_var"].(map[string]any)
+		name := toString(values["new_name"])
+		delete(values, "new_name")
+		if _, found := s.findEnvironmentVariable(parents, name); found {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("The environment variable %s already exists", name))
+			return
+		}
+		obj := s.store.create("environment-variables", parents, object{"name": name, "values": values})
+		writeJSON(w, http.StatusCreated, response{Data: object{
+			"message":     "Environment variables created",
+			"new_var_ids": []any{obj["id"]},
+		}})
+	case http.MethodPut:
+		values, _ := body["env_vars"].(map[string]any)
+		name := toString(values["name"])
+		delete(values, "name")
+		obj, found := s.findEnvironmentVariable(parents, name)
+		if !found {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("The environment variable %s was not found", name))
+			return
+		}
+		s.store.update("environment-variables", toString(obj["id"]), object{"values": values})
+		writeJSON(w, http.StatusOK, response{Data: object{"message": "Environment variables updated"}})
+	case http.MethodDelete:
+		name := toString(body["name"])
+		obj, found := s.findEnvironmentVariable(parents, name)
+		if !found {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("The environment variable %s was not found", name))
+			return
+		}
+		s.store.delete("environment-variables", toString(obj["id"]))
+		writeJSON(w, http.StatusOK, response{Data: object{"message": "Environment variables deleted"}})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("Method %s not allowed on %s", r.Method, r.URL.Path))
+	}
+}
+
+func (s *Server) findEnvironmentVariable(parents map[string]int, name string) (object, bool) {
+	objects := s.store.list("environment-variables", parents, map[string]string{"name": name})
+	if len(objects) == 0 {
+		return nil, false
+	}
+	return objects[0], true
+}
+
+func (s *Server) listEnvironmentVariables(w http.ResponseWriter, projectID int) {
+	parents := map[string]int{"project_id": projectID}
+
+	environments := []string{"project"}
+	for _, environment := range s.store.list("environments", parents, nil) {
+		environments = append(environments, toString(environment["name"]))
+	}
+
+	variables := map[string]map[string]object{}
+	for _, obj := range s.store.list("environment-variables", parents, nil) {
+		values := map[string]object{}
+		if objValues, ok := obj["values"].(map[string]any); ok {
+			for environment, value := range objValues {
+				values[environment] = object{"id": obj["id"], "value": toString(value)}
+			}
+		}
+		variables[toString(obj["name"])] = values
+	}
+
+	writeJSON(w, http.StatusOK, response{Data: object{
+		"environments": environments,
+		"variables":    variables,
+	}})
+}
@@ -0,0 +1,350 @@
+// Package dbt_cloud_fake is a stateful in-memory fake of the dbt Cloud Admin API v2/v3
+// It implements the generic CRUD behaviour of the endpoints used by the client so that the
+// provider can be tested without a dbt Cloud account
+package dbt_cloud_fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+)
+
+const (
+	DEFAULT_PAGE_LIMIT = 100
+	FAKE_ACCOUNT_NAME  = "Fake dbt Cloud account"
+)
+
+type Server struct {
+	AccountID int
+	Token     string
+	// HostURL is the value to use for the `host_url` of the provider
+	HostURL string
+
+	server *httptest.Server
+	store  *store
+}
+
+// route is the result of parsing the path of a request
+// e.g. /api/v3/accounts/1/projects/2/environments/3/ gives
+// collection: environments, id: 3, parents: {project_id: 2}
+type route struct {
+	collection string
+	id         string
+	parents    map[string]int
+}
+
+type responseStatus struct {
+	Code             int    `json:"code"`
+	IsSuccess        bool   `json:"is_success"`
+	UserMessage      string `json:"user_message"`
+	DeveloperMessage string `json:"developer_message"`
+}
+
+type responseExtra struct {
+	Filters    map[string]int `json:"filters"`
+	Pagination map[string]int `json:"pagination"`
+}
+
+type response struct {
+	Data   any            `json:"data"`
+	Status responseStatus `json:"status"`
+	Extra  *responseExtra `json:"extra,omitempty"`
+}
+
+// NewServer starts a fake dbt Cloud API accepting the given token for the given account
+// the server needs to be closed with Close once the tests are done
+func NewServer(accountID int, token string) *Server {
+	s := &Server{
+		AccountID: accountID,
+		Token:     token,
+		store:     newStore(accountID),
+	}
+	s.server = httptest.NewServer(s)
+	s.HostURL = s.server.URL + "/api"
+	return s
+}
+
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Objects returns the objects of a collection (e.g. projects, environments, jobs), ordered by ID
+// it is useful to check the state of the fake in tests
+func (s *Server) Objects(collection string) []map[string]any {
+	results := []map[string]any{}
+	for _, obj := range s.store.list(collection, nil, nil) {
+		results = append(results, obj)
+	}
+	return results
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != fmt.Sprintf("Token %s", s.Token) {
+		writeError(w, http.StatusUnauthorized, "Invalid token.")
+		return
+	}
+
+	segments := []string{}
+	for _, segment := range strings.Split(strings.TrimPrefix(r.URL.Path, "/api"), "/") {
+		if segment != "" {
+			segments = append(segments, segment)
+		}
+	}
+
+	if len(segments) < 2 || (segments[0] != "v2" && segments[0] != "v3") || segments[1] != "accounts" {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Unknown endpoint %s", r.URL.Path))
+		return
+	}
+
+	account := object{"id": s.AccountID, "name": FAKE_ACCOUNT_NAME, "state": STATE_ACTIVE}
+	if len(segments) == 2 {
+		writeJSON(w, http.StatusOK, response{Data: []object{account}})
+		return
+	}
+	if segments[2] != strconv.Itoa(s.AccountID) {
+		writeError(w, http.StatusForbidden, "You do not have access to this account.")
+		return
+	}
+	if len(segments) == 3 {
+		writeJSON(w, http.StatusOK, response{Data: account})
+		return
+	}
+
+	rt, err := parseRoute(segments[3:])
+	if err != nil {
+		writeError(w, http.StatusNotFound, err.Error())
+		return
+	}
+
+	if rt.collection == "environment-variables" {
+		s.handleEnvironmentVariables(w, r, rt)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && rt.id != "":
+		s.getObject(w, rt)
+	case r.Method == http.MethodGet:
+		s.listObjects(w, r, rt)
+	case r.Method == http.MethodPost && rt.id == "":
+		s.createObjects(w, r, rt)
+	case r.Method == http.MethodPost || r.Method == http.MethodPut || r.Method == http.MethodPatch:
+		s.updateObject(w, r, rt)
+	case r.Method == http.MethodDelete && rt.id != "":
+		s.deleteObject(w, rt)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("Method %s not allowed on %s", r.Method, r.URL.Path))
+	}
+}
+
+// parseRoute reads the collection, ID and parents from the path segments after the account ID
+func parseRoute(segments []string) (route, error) {
+	rt := route{parents: map[string]int{}}
+
+	switch segments[0] {
+	case "webhooks":
+		// webhooks are created with /webhooks/subscriptions and read with /webhooks/subscription/<id>
+		rt.collection = "webhooks"
+		if len(segments) == 3 {
+			rt.id = segments[2]
+		}
+		return rt, nil
+	case "group-permissions":
+		// the permissions of a group are replaced as a whole with /group-permissions/<group_id>
+		if len(segments) != 2 {
+			return rt, fmt.Errorf("expected a group ID in group-permissions")
+		}
+		groupID, err := strconv.Atoi(segments[1])
+		if err != nil {
+			return rt, fmt.Errorf("invalid group ID %s", segments[1])
+		}
+		rt.collection = "group-permissions"
+		rt.parents["group_id"] = groupID
+		return rt, nil
+	}
+
+	lastParent := ""
+	for i := 0; i < len(segments); i += 2 {
+		name := segments[i]
+
+		// a collection followed by an ID and more segments is a parent
+		if i+2 < len(segments) {
+			parentID, err := strconv.Atoi(segments[i+1])
+			if err != nil {
+				return rt, fmt.Errorf("invalid ID %s for %s", segments[i+1], name)
+			}
+			rt.parents[parentKey(name)] = parentID
+			lastParent = name
+			continue
+		}
+
+		rt.collection = name
+		if name == "permissions" && lastParent != "" {
+			// e.g. /service-tokens/<id>/permissions/ are stored as service-token-permissions
+			rt.collection = fmt.Sprintf("%s-permissions", strings.TrimSuffix(lastParent, "s"))
+		}
+		if i+1 < len(segments) {
+			rt.id = segments[i+1]
+		}
+	}
+
+	return rt, nil
+}
+
+// parentKey returns the field holding the ID of a parent, e.g. project_id for projects
+func parentKey(collection string) string {
+	singular := strings.TrimSuffix(collection, "s")
+	return fmt.Sprintf("%s_id", strings.ReplaceAll(singular, "-", "_"))
+}
+
+func (s *Server) getObject(w http.ResponseWriter, rt route) {
+	obj, ok := s.store.get(rt.collection, rt.id)
+	if !ok || !matchesParents(obj, rt.parents) {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The requested %s %s was not found.", rt.collection, rt.id))
+		return
+	}
+	writeJSON(w, http.StatusOK, response{Data: s.decorate(rt.collection, obj, false)})
+}
+
+func (s *Server) listObjects(w http.ResponseWriter, r *http.Request, rt route) {
+	query := r.URL.Query()
+
+	filters := map[string]string{}
+	for key := range query {
+		if !nonFilterParams[key] {
+			filters[key] = query.Get(key)
+		}
+	}
+
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = DEFAULT_PAGE_LIMIT
+	}
+	offset, _ := strconv.Atoi(query.Get("offset"))
+
+	objects := s.store.list(rt.collection, rt.parents, filters)
+	totalCount := len(objects)
+
+	page := []object{}
+	for i := offset; i < min(offset+limit, totalCount); i++ {
+		page = append(page, s.decorate(rt.collection, objects[i], false))
+	}
+
+	writeJSON(w, http.StatusOK, response{
+		Data: page,
+		Extra: &responseExtra{
+			Filters:    map[string]int{"limit": limit, "offset": offset},
+			Pagination: map[string]int{"count": len(page), "total_count": totalCount},
+		},
+	})
+}
+
+// createObjects creates an object, or replaces all the children of a parent when a list is sent
+func (s *Server) createObjects(w http.ResponseWriter, r *http.Request, rt route) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if strings.HasPrefix(strings.TrimSpace(string(body)), "[") {
+		items := []object{}
+		if err := json.Unmarshal(body, &items); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		created := s.store.replaceChildren(rt.collection, rt.parents, items)
+		writeJSON(w, http.StatusOK, response{Data: created})
+		return
+	}
+
+	fields := object{}
+	if err := json.Unmarshal(body, &fields); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	delete(fields, "id")
+
+	obj := s.store.create(rt.collection, rt.parents, fields)
+	writeJSON(w, http.StatusCreated, response{Data: s.decorate(rt.collection, obj, true)})
+}
+
+func (s *Server) updateObject(w http.ResponseWriter, r *http.Request, rt route) {
+	fields := object{}
+	if err := json.NewDecoder(r.Body).Decode(&fields); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	obj, ok := s.store.update(rt.collection, rt.id, fields)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The requested %s %s was not found.", rt.collection, rt.id))
+		return
+	}
+	writeJSON(w, http.StatusOK, response{Data: s.decorate(rt.collection, obj, false)})
+}
+
+func (s *Server) deleteObject(w http.ResponseWriter, rt route) {
+	obj, ok := s.store.delete(rt.collection, rt.id)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("The requested %s %s was not found.", rt.collection, rt.id))
+		return
+	}
+	writeJSON(w, http.StatusOK, response{Data: s.decorate(rt.collection, obj, false)})
+}
+
+// decorate adds the fields computed by dbt Cloud that are not sent by the client
+func (s *Server) decorate(collection string, obj object, created bool) object {
+	id := toString(obj["id"])
+
+	switch collection {
+	case "groups":
+		groupID, _ := strconv.Atoi(id)
+		obj["group_permissions"] = s.store.list("group-permissions", map[string]int{"group_id": groupID}, nil)
+	case "service-tokens":
+		obj["uid"] = fmt.Sprintf("fake%s", id)
+		if created {
+			obj["token_string"] = fmt.Sprintf("dbtc_fake%s", id)
+		}
+	case "webhooks":
+		// job IDs are sent as integers but returned as strings
+		if jobIDs, ok := obj["job_ids"].([]any); ok {
+			jobIDStrings := []string{}
+			for _, jobID := range jobIDs {
+				jobIDStrings = append(jobIDStrings, toString(jobID))
+			}
+			obj["job_ids"] = jobIDStrings
+		}
+		obj["account_identifier"] = fmt.Sprintf("act_%d", s.AccountID)
+		if created {
+			obj["hmac_secret"] = fmt.Sprintf("fake-hmac-secret-%s", id)
+		}
+	}
+
+	return obj
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, resp response) {
+	resp.Status = responseStatus{Code: statusCode, IsSuccess: true}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(resp)
+}
+
+func writeError(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(response{
+		Status: responseStatus{
+			Code:             statusCode,
+			IsSuccess:        false,
+			UserMessage:      message,
+			DeveloperMessage: message,
+		},
+	})
+}
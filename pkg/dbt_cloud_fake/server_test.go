@@ -0,0 +1,248 @@
+package dbt_cloud_fake
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+)
+
+func newFakeClient(t *testing.T) (*Server, *dbt_cloud.Client) {
+	t.Helper()
+
+	server := NewServer(1, "fake-token")
+	t.Cleanup(server.Close)
+
+	client := &dbt_cloud.Client{
+		HTTPClient:       &http.Client{},
+		HostURL:          server.HostURL,
+		Token:            server.Token,
+		AccountID:        server.AccountID,
+		RetryMaxAttempts: 1,
+		PageConcurrency:  2,
+	}
+	return server, client
+}
+
+func TestFakeProjectsAndEnvironments(t *testing.T) {
+	t.Parallel()
+
+	_, c := newFakeClient(t)
+	ctx := context.Background()
+
+	project, err := c.CreateProject(ctx, "Analytics", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	projectID := strconv.Itoa(*project.ID)
+
+	readProject, err := c.GetProject(ctx, projectID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if readProject.Name != "Analytics" || readProject.State != dbt_cloud.STATE_ACTIVE {
+		t.Errorf("unexpected project %+v", readProject)
+	}
+
+	for _, name := range []string{"Dev", "Prod"} {
+		_, err := c.CreateEnvironment(ctx, true, *project.ID, name, "latest", "deployment", false, "", 0, "", 0, 0, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	otherProject, err := c.CreateProject(ctx, "Marketing", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CreateEnvironment(ctx, true, *otherProject.ID, "Dev", "latest", "development", false, "", 0, "", 0, 0, false); err != nil {
+		t.Fatal(err)
+	}
+
+	environments, err := c.GetAllEnvironments(ctx, *project.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(environments) != 2 {
+		t.Fatalf("expected 2 environments for the project, got %d", len(environments))
+	}
+
+	projects, err := c.GetAllProjects(ctx, "analy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(projects) != 1 || projects[0].Name != "Analytics" {
+		t.Errorf("expected to find the Analytics project, got %+v", projects)
+	}
+
+	if _, err := c.DeleteEnvironment(ctx, *project.ID, *environments[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.GetEnvironment(ctx, *project.ID, *environments[0].ID)
+	var notFoundErr *dbt_cloud.NotFoundError
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected a not found error after the deletion, got %v", err)
+	}
+}
+
+func TestFakeGroupPermissions(t *testing.T) {
+	t.Parallel()
+
+	_, c := newFakeClient(t)
+	ctx := context.Background()
+
+	group, err := c.CreateGroup(ctx, "Engineers", false, []string{"eng"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	permissions := []dbt_cloud.GroupPermission{
+		{GroupID: *group.ID, AccountID: c.AccountID, Set: "developer", AllProjects: true},
+		{GroupID: *group.ID, AccountID: c.AccountID, Set: "job_admin", AllProjects: true},
+	}
+	if _, err := c.UpdateGroupPermissions(ctx, *group.ID, permissions); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.UpdateGroupPermissions(ctx, *group.ID, permissions[:1]); err != nil {
+		t.Fatal(err)
+	}
+
+	readGroup, err := c.GetGroup(ctx, *group.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(readGroup.Permissions) != 1 || readGroup.Permissions[0].Set != "developer" {
+		t.Errorf("expected the permissions to be replaced, got %+v", readGroup.Permissions)
+	}
+}
+
+func TestFakeWebhooksAndServiceTokens(t *testing.T) {
+	t.Parallel()
+
+	server, c := newFakeClient(t)
+	ctx := context.Background()
+
+	webhook, err := c.CreateWebhook(ctx, "", "hook", "", "https://example.com", []string{"job.run.completed"}, []int{1, 2}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if webhook.HmacSecret == nil || len(webhook.JobIds) != 2 {
+		t.Errorf("unexpected webhook %+v", webhook)
+	}
+	if _, err := c.GetWebhook(ctx, webhook.WebhookId); err != nil {
+		t.Fatal(err)
+	}
+
+	serviceToken, err := c.CreateServiceToken(ctx, "token", dbt_cloud.STATE_ACTIVE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if serviceToken.TokenString == nil || serviceToken.UID == "" {
+		t.Errorf("unexpected service token %+v", serviceToken)
+	}
+	if _, err := c.GetServiceToken(ctx, *serviceToken.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if objects := server.Objects("webhooks"); len(objects) != 1 {
+		t.Errorf("expected 1 webhook in the fake, got %d", len(objects))
+	}
+}
+
+func TestFakeEnvironmentVariables(t *testing.T) {
+	t.Parallel()
+
+	_, c := newFakeClient(t)
+	ctx := context.Background()
+
+	project, err := c.CreateProject(ctx, "Analytics", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CreateEnvironment(ctx, true, *project.ID, "Prod", "latest", "deployment", false, "", 0, "", 0, 0, false); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"DBT_TARGET", "DBT_SCHEMA"} {
+		if _, err := c.CreateEnvironmentVariable(ctx, *project.ID, name, map[string]string{"project": "dev", "Prod": "prod"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := c.CreateEnvironmentVariable(ctx, *project.ID, "DBT_TARGET", map[string]string{"project": "dev"}); err == nil {
+		t.Error("expected an error when creating an existing environment variable")
+	}
+
+	_, err = c.UpdateEnvironmentVariable(ctx, *project.ID, dbt_cloud.EnvironmentVariable{
+		Name:                  "DBT_TARGET",
+		EnvironmentNameValues: map[string]string{"project": "dev", "Prod": "production"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.DeleteEnvironmentVariable(ctx, "DBT_SCHEMA", *project.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	environmentVariables, err := c.GetAllEnvironmentVariables(ctx, *project.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(environmentVariables) != 1 || environmentVariables[0].EnvironmentNameValues["Prod"] != "production" {
+		t.Errorf("unexpected environment variables %+v", environmentVariables)
+	}
+}
+
+func TestFakeJobFilters(t *testing.T) {
+	t.Parallel()
+
+	_, c := newFakeClient(t)
+	ctx := context.Background()
+
+	project, err := c.CreateProject(ctx, "Analytics", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	triggers := map[string]any{"github_webhook": false, "git_provider_webhook": false, "schedule": false, "on_merge": false}
+	environmentIDs := []int{}
+	for _, name := range []string{"Staging", "Prod"} {
+		environment, err := c.CreateEnvironment(ctx, true, *project.ID, name, "latest", "deployment", false, "", 0, "", 0, 0, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		environmentIDs = append(environmentIDs, *environment.ID)
+		if _, err := c.CreateJob(ctx, *project.ID, *environment.ID, "Daily run", "", []string{"dbt build"}, "", true, triggers, 1, "default", false, false, "every_day", 1, nil, nil, "", 0, 0, false, 0, false, nil, false); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	jobs, err := c.GetAllJobs(ctx, *project.ID, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 2 {
+		t.Errorf("expected 2 jobs for the project, got %d", len(jobs))
+	}
+
+	jobs, err = c.GetAllJobs(ctx, 0, environmentIDs[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 1 || jobs[0].Environment_Id != environmentIDs[1] {
+		t.Errorf("expected the job of the environment %d, got %+v", environmentIDs[1], jobs)
+	}
+}
+
+func TestFakeAuthentication(t *testing.T) {
+	t.Parallel()
+
+	_, c := newFakeClient(t)
+	c.Token = "wrong-token"
+
+	_, err := c.GetAllProjects(context.Background(), "")
+	var permissionErr *dbt_cloud.PermissionDeniedError
+	if !errors.As(err, &permissionErr) {
+		t.Errorf("expected a permission error with a wrong token, got %v", err)
+	}
+}
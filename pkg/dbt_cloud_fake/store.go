@@ -0,0 +1,212 @@
+package dbt_cloud_fake
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	STATE_ACTIVE  = 1
+	STATE_DELETED = 2
+)
+
+// query parameters used for pagination and formatting, they are not filters on the objects
+var nonFilterParams = map[string]bool{
+	"limit":           true,
+	"offset":          true,
+	"include_related": true,
+	"order_by":        true,
+}
+
+// object is a dbt Cloud object as stored by the fake, its fields are the JSON fields sent by the client
+type object map[string]any
+
+// store keeps the objects of all the collections in memory
+// the collections are created on the fly when an object is first created
+type store struct {
+	mu          sync.Mutex
+	accountID   int
+	nextID      int
+	collections map[string]map[string]object
+}
+
+func newStore(accountID int) *store {
+	return &store{
+		accountID:   accountID,
+		nextID:      1,
+		collections: map[string]map[string]object{},
+	}
+}
+
+func (s *store) collection(name string) map[string]object {
+	if _, ok := s.collections[name]; !ok {
+		s.collections[name] = map[string]object{}
+	}
+	return s.collections[name]
+}
+
+// create stores a new object with a generated ID and the IDs of its parents
+func (s *store) create(collection string, parents map[string]int, fields object) object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := s.nextID
+	s.nextID++
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	obj := object{}
+	for key, value := range fields {
+		obj[key] = value
+	}
+	for key, value := range parents {
+		obj[key] = value
+	}
+	obj["id"] = id
+	obj["account_id"] = s.accountID
+	if state, ok := obj["state"]; !ok || state == nil {
+		obj["state"] = STATE_ACTIVE
+	}
+	obj["created_at"] = now
+	obj["updated_at"] = now
+
+	key := strconv.Itoa(id)
+	if collection == "webhooks" {
+		// webhooks are the only objects with string IDs
+		key = fmt.Sprintf("wsu_%d", id)
+		obj["id"] = key
+	}
+
+	s.collection(collection)[key] = obj
+	return copyObject(obj)
+}
+
+func (s *store) get(collection string, id string) (object, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.collection(collection)[id]
+	if !ok {
+		return nil, false
+	}
+	return copyObject(obj), true
+}
+
+// update merges the fields sent with the existing ones, the API mostly expects full objects but
+// some endpoints (e.g. PATCH on connections) only receive the fields that changed
+func (s *store) update(collection string, id string, fields object) (object, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.collection(collection)[id]
+	if !ok {
+		return nil, false
+	}
+
+	for key, value := range fields {
+		if key == "id" || key == "account_id" || key == "created_at" {
+			continue
+		}
+		obj[key] = value
+	}
+	obj["updated_at"] = time.Now().UTC().Format(time.RFC3339)
+	return copyObject(obj), true
+}
+
+func (s *store) delete(collection string, id string) (object, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	obj, ok := s.collection(collection)[id]
+	if !ok {
+		return nil, false
+	}
+	delete(s.collection(collection), id)
+	return obj, true
+}
+
+// replaceChildren replaces all the objects of a parent, used for permissions that are sent as a whole list
+func (s *store) replaceChildren(collection string, parents map[string]int, items []object) []object {
+	s.mu.Lock()
+	for key, obj := range s.collection(collection) {
+		if matchesParents(obj, parents) {
+			delete(s.collection(collection), key)
+		}
+	}
+	s.mu.Unlock()
+
+	created := []object{}
+	for _, item := range items {
+		created = append(created, s.create(collection, parents, item))
+	}
+	return created
+}
+
+// list returns the objects matching the parents and the query filters, ordered by ID
+func (s *store) list(collection string, parents map[string]int, filters map[string]string) []object {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	results := []object{}
+	for _, obj := range s.collection(collection) {
+		if matchesParents(obj, parents) && matchesFilters(obj, filters) {
+			results = append(results, copyObject(obj))
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return idNumber(results[i]) < idNumber(results[j])
+	})
+	return results
+}
+
+func idNumber(obj object) int {
+	id := strings.TrimPrefix(toString(obj["id"]), "wsu_")
+	number, _ := strconv.Atoi(id)
+	return number
+}
+
+func matchesParents(obj object, parents map[string]int) bool {
+	for key, value := range parents {
+		if toString(obj[key]) != strconv.Itoa(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchesFilters supports exact matches on any field and the `__icontains` suffix used for names
+func matchesFilters(obj object, filters map[string]string) bool {
+	for key, value := range filters {
+		if field, ok := strings.CutSuffix(key, "__icontains"); ok {
+			if !strings.Contains(strings.ToLower(toString(obj[field])), strings.ToLower(value)) {
+				return false
+			}
+			continue
+		}
+		if toString(obj[key]) != value {
+			return false
+		}
+	}
+	return true
+}
+
+func copyObject(obj object) object {
+	copied := object{}
+	for key, value := range obj {
+		copied[key] = value
+	}
+	return copied
+}
+
+// toString formats the JSON values to compare them with query parameters
+// JSON numbers are decoded as float64 and need to be formatted without exponent
+func toString(value any) string {
+	if number, ok := value.(float64); ok {
+		return strconv.FormatFloat(number, 'f', -1, 64)
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,216 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/samber/lo"
+)
+
+// connectionConfig reads the config of a global connection of any adapter as a map
+type connectionConfig map[string]any
+
+func (connectionConfig) AdapterVersion() string {
+	return ""
+}
+
+type globalConnection struct {
+	dbt_cloud.GlobalConnectionSummary
+	common *dbt_cloud.GlobalConnectionCommon
+	config connectionConfig
+}
+
+// credential is a credential with its typed details, e.g. *dbt_cloud.SnowflakeCredential
+// details is nil when the type of credential is not supported by the export
+type credential struct {
+	dbt_cloud.Credential
+	details any
+}
+
+type project struct {
+	dbt_cloud.ProjectConnectionRepository
+	repository           *dbt_cloud.Repository
+	environments         []dbt_cloud.Environment
+	credentials          map[int]credential
+	environmentVariables []dbt_cloud.EnvironmentVariable
+	jobs                 []dbt_cloud.JobWithEnvironment
+}
+
+// account holds all the objects exported
+type account struct {
+	connections   []globalConnection
+	projects      []project
+	groups        []dbt_cloud.Group
+	notifications []dbt_cloud.Notification
+	webhooks      []dbt_cloud.WebhookRead
+}
+
+// fetchAccount reads the objects of the account with the list endpoints of the client
+// when projectIDs is not empty, only those projects and their objects are read
+func fetchAccount(ctx context.Context, c *dbt_cloud.Client, projectIDs []int) (*account, error) {
+	acc := &account{}
+
+	connections, err := c.GetAllConnections(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the global connections: %w", err)
+	}
+	connectionClient := dbt_cloud.NewGlobalConnectionClient[connectionConfig](c)
+	for _, summary := range connections {
+		common, config, err := connectionClient.Get(ctx, summary.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error reading the global connection %d: %w", summary.ID, err)
+		}
+		acc.connections = append(acc.connections, globalConnection{
+			GlobalConnectionSummary: summary,
+			common:                  common,
+			config:                  *config,
+		})
+	}
+
+	projects, err := c.GetAllProjects(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("error listing the projects: %w", err)
+	}
+	for _, p := range projects {
+		if p.State == dbt_cloud.STATE_DELETED {
+			continue
+		}
+		if len(projectIDs) > 0 && !lo.Contains(projectIDs, int(p.ID)) {
+			continue
+		}
+		exportedProject, err := fetchProject(ctx, c, p)
+		if err != nil {
+			return nil, fmt.Errorf("error reading the project %s (%d): %w", p.Name, p.ID, err)
+		}
+		acc.projects = append(acc.projects, *exportedProject)
+	}
+
+	groups, err := c.GetAllGroups(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the groups: %w", err)
+	}
+	for _, group := range groups {
+		if group.State != dbt_cloud.STATE_ACTIVE || group.ID == nil {
+			continue
+		}
+		// the list endpoint doesn't always return the permissions of the groups
+		groupWithPermissions, err := c.GetGroup(ctx, *group.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error reading the group %d: %w", *group.ID, err)
+		}
+		acc.groups = append(acc.groups, *groupWithPermissions)
+	}
+
+	notifications, err := c.GetAllNotifications(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the notifications: %w", err)
+	}
+	acc.notifications = lo.Filter(notifications, func(notification dbt_cloud.Notification, _ int) bool {
+		return notification.State == dbt_cloud.STATE_ACTIVE && notification.Id != nil
+	})
+
+	acc.webhooks, err = c.GetAllWebhooks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the webhooks: %w", err)
+	}
+
+	return acc, nil
+}
+
+func fetchProject(
+	ctx context.Context,
+	c *dbt_cloud.Client,
+	p dbt_cloud.ProjectConnectionRepository,
+) (*project, error) {
+	exportedProject := project{
+		ProjectConnectionRepository: p,
+		credentials:                 map[int]credential{},
+	}
+	projectID := int(p.ID)
+
+	if p.RepositoryID != 0 {
+		repository, err := c.GetRepository(
+			ctx,
+			strconv.FormatInt(p.RepositoryID, 10),
+			strconv.Itoa(projectID),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error reading the repository %d: %w", p.RepositoryID, err)
+		}
+		exportedProject.repository = repository
+	}
+
+	environments, err := c.GetAllEnvironments(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the environments: %w", err)
+	}
+	for _, environment := range environments {
+		if environment.State == dbt_cloud.STATE_DELETED || environment.ID == nil {
+			continue
+		}
+		exportedProject.environments = append(exportedProject.environments, environment)
+
+		if environment.Credential_Id == nil {
+			continue
+		}
+		credentialID := *environment.Credential_Id
+		if _, ok := exportedProject.credentials[credentialID]; ok {
+			continue
+		}
+		cred, err := fetchCredential(ctx, c, projectID, credentialID)
+		if err != nil {
+			return nil, fmt.Errorf("error reading the credential %d: %w", credentialID, err)
+		}
+		exportedProject.credentials[credentialID] = *cred
+	}
+
+	exportedProject.environmentVariables, err = c.GetAllEnvironmentVariables(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the environment variables: %w", err)
+	}
+
+	jobs, err := c.GetAllJobs(ctx, projectID, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the jobs: %w", err)
+	}
+	exportedProject.jobs = lo.Filter(jobs, func(job dbt_cloud.JobWithEnvironment, _ int) bool {
+		return job.State != dbt_cloud.STATE_DELETED && job.ID != nil
+	})
+
+	return &exportedProject, nil
+}
+
+// fetchCredential reads the type of a credential and then its details with the typed client method
+func fetchCredential(
+	ctx context.Context,
+	c *dbt_cloud.Client,
+	projectID int,
+	credentialID int,
+) (*credential, error) {
+	common, err := c.GetCredential(ctx, projectID, credentialID)
+	if err != nil {
+		return nil, err
+	}
+	cred := credential{Credential: *common}
+
+	switch {
+	case common.Type == "snowflake":
+		cred.details, err = c.GetSnowflakeCredential(ctx, projectID, credentialID)
+	case common.Type == "bigquery":
+		cred.details, err = c.GetBigQueryCredential(ctx, projectID, credentialID)
+	case common.Type == "postgres" || common.Type == "redshift":
+		cred.details, err = c.GetPostgresCredential(ctx, projectID, credentialID)
+	case strings.HasPrefix(common.AdapterVersion, "databricks"):
+		cred.details, err = c.GetDatabricksCredential(ctx, projectID, credentialID)
+	case strings.HasPrefix(common.AdapterVersion, "fabric"):
+		cred.details, err = c.GetFabricCredential(ctx, projectID, credentialID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &cred, nil
+}
@@ -0,0 +1,114 @@
+// Package export generates the Terraform configuration and the import blocks of the objects of an
+// existing dbt Cloud account, to bring the projects created in the UI under Terraform
+// It is run with `terraform-provider-dbtcloud export`
+package export
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/provider"
+)
+
+const COMMAND_NAME = "export"
+
+// Export reads the objects of the account and writes their configuration to outputDir
+// it returns the names of the files written and the warnings for the objects that couldn't be exported
+func Export(
+	ctx context.Context,
+	c *dbt_cloud.Client,
+	outputDir string,
+	projectIDs []int,
+) ([]string, []string, error) {
+	acc, err := fetchAccount(ctx, c, projectIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	w := newWriter()
+	if err := w.render(ctx, acc); err != nil {
+		return nil, nil, err
+	}
+
+	files, err := w.save(outputDir)
+	if err != nil {
+		return nil, nil, err
+	}
+	return files, w.warnings, nil
+}
+
+// Run parses the arguments of the export command and runs the export
+// the credentials are resolved like for the provider, from the flags, the environment variables and the profiles
+func Run(ctx context.Context, args []string, stdout io.Writer, stderr io.Writer) error {
+	flags := flag.NewFlagSet(COMMAND_NAME, flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: terraform-provider-dbtcloud %s [flags]\n\n", COMMAND_NAME)
+		fmt.Fprint(stderr, "Writes the Terraform configuration and the import blocks of the objects of a dbt Cloud account.\n")
+		fmt.Fprint(stderr, "The token is read from DBT_CLOUD_TOKEN, DBT_CLOUD_TOKEN_FILE, DBT_CLOUD_TOKEN_COMMAND or the profile.\n\n")
+		flags.PrintDefaults()
+	}
+
+	outputDir := flags.String("output-dir", ".", "directory where the .tf files are written, existing files are never overwritten")
+	accountID := flags.Int("account-id", 0, "ID of the account to export, defaults to DBT_CLOUD_ACCOUNT_ID")
+	hostURL := flags.String("host-url", "", "URL of the dbt Cloud API, defaults to DBT_CLOUD_HOST_URL or https://cloud.getdbt.com/api")
+	profile := flags.String("profile", "", "name of the profile to read the credentials from, defaults to DBT_CLOUD_PROFILE")
+	projectIDsFlag := flags.String("project-ids", "", "comma-separated IDs of the projects to export, defaults to all the projects")
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+		return err
+	}
+
+	projectIDs := []int{}
+	for _, projectID := range strings.Split(*projectIDsFlag, ",") {
+		projectID = strings.TrimSpace(projectID)
+		if projectID == "" {
+			continue
+		}
+		id, err := strconv.Atoi(projectID)
+		if err != nil {
+			return fmt.Errorf("invalid project ID %s in -project-ids", projectID)
+		}
+		projectIDs = append(projectIDs, id)
+	}
+
+	resolvedAccountID, resolvedHostURL, token, err := provider.ResolveCredentials(ctx, *accountID, *hostURL, *profile)
+	if err != nil {
+		return err
+	}
+	if resolvedAccountID == 0 || token == "" {
+		return fmt.Errorf("the account ID and the token are required, set them with the flags, the environment variables or a profile")
+	}
+
+	c, err := dbt_cloud.NewClient(ctx, &resolvedAccountID, &token, &resolvedHostURL, &dbt_cloud.ClientConfig{
+		RetryMaxAttempts: dbt_cloud.DEFAULT_RETRY_MAX_ATTEMPTS,
+		RetryMaxWait:     dbt_cloud.DEFAULT_RETRY_MAX_WAIT,
+		PageConcurrency:  dbt_cloud.DEFAULT_PAGE_CONCURRENCY,
+	})
+	if err != nil {
+		return err
+	}
+
+	files, warnings, err := Export(ctx, c, *outputDir, projectIDs)
+	if err != nil {
+		return err
+	}
+
+	for _, warning := range warnings {
+		fmt.Fprintf(stderr, "Warning: %s\n", warning)
+	}
+	fmt.Fprintf(stdout, "Exported the account %d to %s: %s\n", resolvedAccountID, *outputDir, strings.Join(files, ", "))
+	if len(files) > 0 {
+		fmt.Fprint(stdout, "Set the variables of variables.tf if any, then run `terraform plan` to import the resources\n")
+	}
+	return nil
+}
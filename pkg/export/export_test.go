@@ -0,0 +1,232 @@
+package export
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud_fake"
+	"github.com/hashicorp/hcl/v2/hclparse"
+)
+
+func TestResourceName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		parts    []string
+		expected string
+	}{
+		{parts: []string{"Analytics"}, expected: "analytics"},
+		{parts: []string{"Analytics", "Prod (EU)"}, expected: "analytics_prod_eu"},
+		{parts: []string{"2024 migration"}, expected: "_2024_migration"},
+		{parts: []string{"--", ""}, expected: "unnamed"},
+	}
+
+	for _, tc := range testCases {
+		if name := resourceName(tc.parts...); name != tc.expected {
+			t.Errorf("expected %s for %v, got %s", tc.expected, tc.parts, name)
+		}
+	}
+
+	w := newWriter()
+	for _, expected := range []string{"analytics", "analytics_2", "analytics_3"} {
+		if name := w.uniqueName("dbtcloud_project", "Analytics"); name != expected {
+			t.Errorf("expected %s for a duplicate name, got %s", expected, name)
+		}
+	}
+}
+
+// newExportedAccount creates a project with its related objects in the fake dbt Cloud API
+func newExportedAccount(t *testing.T, ctx context.Context, c *dbt_cloud.Client) {
+	t.Helper()
+
+	snowflakeConnection := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.SnowflakeConfig](c)
+	connectionName := "Snowflake"
+	account, database, warehouse := "acme", "analytics", "transforming"
+	connection, _, err := snowflakeConnection.Create(
+		ctx,
+		dbt_cloud.GlobalConnectionCommon{Name: &connectionName},
+		dbt_cloud.SnowflakeConfig{Account: &account, Database: &database, Warehouse: &warehouse},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	project, err := c.CreateProject(ctx, "Analytics", "Main project", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	repository, err := c.CreateRepository(ctx, *project.ID, "git@github.com:acme/analytics.git", true, "deploy_key", 0, 0, "", "", false, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	project.RepositoryID = repository.ID
+	if _, err := c.UpdateProject(ctx, strconv.Itoa(*project.ID), *project); err != nil {
+		t.Fatal(err)
+	}
+
+	credential, err := c.CreateSnowflakeCredential(ctx, *project.ID, "snowflake", true, "", "transformer", "", "analytics", "dbt", "secret", "", "", "password", 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	environment, err := c.CreateEnvironment(ctx, true, *project.ID, "Prod", "latest", "deployment", false, "", *credential.ID, "production", 0, int(*connection.ID), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.CreateEnvironmentVariable(ctx, *project.ID, "DBT_TARGET", map[string]string{"project": "dev", "Prod": "prod"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CreateEnvironmentVariable(ctx, *project.ID, "DBT_ENV_SECRET_TOKEN", map[string]string{"project": "secret"}); err != nil {
+		t.Fatal(err)
+	}
+
+	triggers := map[string]any{"github_webhook": false, "git_provider_webhook": false, "schedule": true, "on_merge": false}
+	dailyJob, err := c.CreateJob(ctx, *project.ID, *environment.ID, "Daily run", "", []string{"dbt build"}, "", true, triggers, 4, "prod", false, false, "custom_cron", 0, nil, nil, "0 6 * * *", 0, 0, false, 0, false, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	completionTrigger := map[string]any{"job_id": *dailyJob.ID, "project_id": *project.ID, "statuses": []int{10}}
+	triggers["schedule"] = false
+	docsJob, err := c.CreateJob(ctx, *project.ID, *environment.ID, "Docs", "", []string{"dbt docs generate"}, "", true, triggers, 1, "default", false, false, "every_day", 1, nil, nil, "", 0, 0, false, 0, false, completionTrigger, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	group, err := c.CreateGroup(ctx, "Analysts", false, []string{"analysts"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	permissions := []dbt_cloud.GroupPermission{
+		{GroupID: *group.ID, AccountID: c.AccountID, Set: "analyst", ProjectID: *project.ID},
+	}
+	if _, err := c.UpdateGroupPermissions(ctx, *group.ID, permissions); err != nil {
+		t.Fatal(err)
+	}
+
+	email := "data@acme.com"
+	if _, err := c.CreateNotification(ctx, 1, nil, []int{*dailyJob.ID, *docsJob.ID}, nil, nil, dbt_cloud.STATE_ACTIVE, 4, &email, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CreateWebhook(ctx, "", "Alerts", "", "https://acme.com/hook", []string{"job.run.errored"}, []int{*dailyJob.ID}, true); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExport(t *testing.T) {
+	t.Parallel()
+
+	server := dbt_cloud_fake.NewServer(1, "fake-token")
+	t.Cleanup(server.Close)
+	c := &dbt_cloud.Client{
+		HTTPClient:       &http.Client{},
+		HostURL:          server.HostURL,
+		Token:            server.Token,
+		AccountID:        server.AccountID,
+		RetryMaxAttempts: 1,
+		PageConcurrency:  1,
+	}
+	ctx := context.Background()
+
+	newExportedAccount(t, ctx, c)
+
+	outputDir := t.TempDir()
+	files, warnings, err := Export(ctx, c, outputDir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) > 0 {
+		t.Errorf("unexpected warnings %v", warnings)
+	}
+
+	expectedContents := map[string][]string{
+		"global_connections.tf": {
+			`resource "dbtcloud_global_connection" "snowflake" {`,
+			`account = "acme"`,
+		},
+		"projects.tf": {
+			`resource "dbtcloud_project" "analytics" {`,
+			`description = "Main project"`,
+		},
+		"repositories.tf": {
+			`resource "dbtcloud_repository" "analytics" {`,
+			`resource "dbtcloud_project_repository" "analytics" {`,
+			`repository_id = dbtcloud_repository.analytics.repository_id`,
+		},
+		"credentials.tf": {
+			`resource "dbtcloud_snowflake_credential" "analytics_prod" {`,
+			`password = var.analytics_prod_password`,
+		},
+		"environments.tf": {
+			`credential_id = dbtcloud_snowflake_credential.analytics_prod.credential_id`,
+			`connection_id = dbtcloud_global_connection.snowflake.id`,
+		},
+		"environment_variables.tf": {
+			`environment_values = var.analytics_dbt_env_secret_token_environment_values`,
+			`depends_on = [dbtcloud_environment.analytics_prod]`,
+		},
+		"jobs.tf": {
+			`environment_id = dbtcloud_environment.analytics_prod.environment_id`,
+			`schedule_cron = "0 6 * * *"`,
+			`job_id = dbtcloud_job.analytics_daily_run.id`,
+		},
+		"groups.tf": {
+			`project_id = dbtcloud_project.analytics.id`,
+		},
+		"notifications.tf": {
+			`on_failure = [dbtcloud_job.analytics_daily_run.id, dbtcloud_job.analytics_docs.id]`,
+		},
+		"webhooks.tf": {
+			`job_ids = [dbtcloud_job.analytics_daily_run.id]`,
+		},
+		"imports.tf": {
+			`to = dbtcloud_environment.analytics_prod`,
+			`id = "2:5"`,
+		},
+		"variables.tf": {
+			`variable "analytics_prod_password" {`,
+			`sensitive = true`,
+		},
+	}
+
+	parser := hclparse.NewParser()
+	for _, file := range files {
+		path := filepath.Join(outputDir, file)
+		if _, diags := parser.ParseHCLFile(path); diags.HasErrors() {
+			t.Errorf("the file %s is not valid HCL: %s", file, diags.Error())
+		}
+	}
+
+	for file, expectedLines := range expectedContents {
+		content, err := os.ReadFile(filepath.Join(outputDir, file))
+		if err != nil {
+			t.Errorf("expected the file %s to be written: %s", file, err)
+			continue
+		}
+		// the alignment of the attributes depends on the other attributes of the block
+		normalizedContent := strings.Join(strings.Fields(string(content)), " ")
+		for _, expectedLine := range expectedLines {
+			if !strings.Contains(normalizedContent, expectedLine) {
+				t.Errorf("expected %s to contain %q, got\n%s", file, expectedLine, content)
+			}
+		}
+	}
+
+	// the secrets are never written
+	for _, file := range files {
+		content, _ := os.ReadFile(filepath.Join(outputDir, file))
+		if strings.Contains(string(content), `"secret"`) {
+			t.Errorf("the file %s contains a secret value\n%s", file, content)
+		}
+	}
+
+	// existing files are not overwritten
+	if _, _, err := Export(ctx, c, outputDir, nil); err == nil || !strings.Contains(err.Error(), "already exists") {
+		t.Errorf("expected an error when exporting to a directory with existing files, got %v", err)
+	}
+}
@@ -0,0 +1,641 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/global_connection"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/utils"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/samber/lo"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const SECRET_ENVIRONMENT_VARIABLE_PREFIX = "DBT_ENV_SECRET"
+
+// render registers the objects referenced by others and then writes all the resources
+func (w *writer) render(ctx context.Context, acc *account) error {
+	for _, connection := range acc.connections {
+		w.register("connection", connection.ID, "dbtcloud_global_connection", "id", connection.Name)
+	}
+	for _, p := range acc.projects {
+		w.registerProject(p)
+	}
+
+	w.writeProviders()
+
+	connectionSchema, err := globalConnectionSchema(ctx)
+	if err != nil {
+		return err
+	}
+	for _, connection := range acc.connections {
+		w.writeGlobalConnection(connection, connectionSchema)
+	}
+
+	for _, p := range acc.projects {
+		w.writeProject(p)
+	}
+
+	for _, group := range acc.groups {
+		w.writeGroup(group)
+	}
+	for _, notification := range acc.notifications {
+		w.writeNotification(notification)
+	}
+	for _, webhook := range acc.webhooks {
+		w.writeWebhook(webhook)
+	}
+
+	return nil
+}
+
+func (w *writer) registerProject(p project) {
+	projectID := int(p.ID)
+	w.register("project", projectID, "dbtcloud_project", "id", p.Name)
+
+	if p.repository != nil {
+		w.register("repository", p.RepositoryID, "dbtcloud_repository", "repository_id", p.Name)
+	}
+
+	for _, environment := range p.environments {
+		w.register("environment", *environment.ID, "dbtcloud_environment", "environment_id", p.Name, environment.Name)
+
+		if environment.Credential_Id == nil {
+			continue
+		}
+		cred := p.credentials[*environment.Credential_Id]
+		resourceType := credentialResourceType(cred)
+		if resourceType == "" {
+			continue
+		}
+		if _, ok := w.lookup("credential", *environment.Credential_Id); !ok {
+			w.register("credential", *environment.Credential_Id, resourceType, "credential_id", p.Name, environment.Name)
+		}
+	}
+
+	for _, job := range p.jobs {
+		w.register("job", *job.ID, "dbtcloud_job", "id", p.Name, job.Name)
+	}
+}
+
+func (w *writer) writeProject(p project) {
+	projectID := int(p.ID)
+	projectRef, _ := w.lookup("project", projectID)
+
+	body := w.resource("projects.tf", projectRef, strconv.Itoa(projectID))
+	body.SetAttributeValue("name", cty.StringVal(p.Name))
+	if p.Description != "" {
+		body.SetAttributeValue("description", cty.StringVal(p.Description))
+	}
+	if p.DbtProjectSubdirectory != "" {
+		body.SetAttributeValue("dbt_project_subdirectory", cty.StringVal(p.DbtProjectSubdirectory))
+	}
+
+	if p.repository != nil {
+		w.writeRepository(p)
+	}
+
+	credentialIDs := lo.Keys(p.credentials)
+	sort.Ints(credentialIDs)
+	for _, credentialID := range credentialIDs {
+		w.writeCredential(p, p.credentials[credentialID])
+	}
+
+	for _, environment := range p.environments {
+		w.writeEnvironment(environment)
+	}
+	for _, environmentVariable := range p.environmentVariables {
+		w.writeEnvironmentVariable(p, environmentVariable)
+	}
+	for _, job := range p.jobs {
+		w.writeJob(job.Job)
+	}
+}
+
+func (w *writer) writeRepository(p project) {
+	repository := p.repository
+	projectID := int(p.ID)
+	repositoryID := int(p.RepositoryID)
+	repositoryRef, _ := w.lookup("repository", p.RepositoryID)
+
+	body := w.resource("repositories.tf", repositoryRef, importID(projectID, repositoryID))
+	w.setRef(body, "project_id", "project", projectID)
+	body.SetAttributeValue("remote_url", cty.StringVal(repository.RemoteUrl))
+	if repository.GitCloneStrategy != "" {
+		body.SetAttributeValue("git_clone_strategy", cty.StringVal(repository.GitCloneStrategy))
+	}
+	if repository.GithubInstallationID != nil {
+		body.SetAttributeValue("github_installation_id", cty.NumberIntVal(int64(*repository.GithubInstallationID)))
+	}
+	if repository.GitlabProjectID != nil {
+		body.SetAttributeValue("gitlab_project_id", cty.NumberIntVal(int64(*repository.GitlabProjectID)))
+	}
+	if repository.AzureActiveDirectoryProjectID != nil {
+		body.SetAttributeValue("azure_active_directory_project_id", cty.StringVal(*repository.AzureActiveDirectoryProjectID))
+	}
+	if repository.AzureActiveDirectoryRepositoryID != nil {
+		body.SetAttributeValue("azure_active_directory_repository_id", cty.StringVal(*repository.AzureActiveDirectoryRepositoryID))
+	}
+	if repository.AzureBypassWebhookRegistrationFailure != nil && *repository.AzureBypassWebhookRegistrationFailure {
+		body.SetAttributeValue("azure_bypass_webhook_registration_failure", cty.True)
+	}
+	if repository.PullRequestURLTemplate != "" {
+		body.SetAttributeValue("pull_request_url_template", cty.StringVal(repository.PullRequestURLTemplate))
+	}
+
+	linkRef := reference{
+		resourceType: "dbtcloud_project_repository",
+		name:         w.uniqueName("dbtcloud_project_repository", p.Name),
+	}
+	linkBody := w.resource("repositories.tf", linkRef, importID(projectID, repositoryID))
+	w.setRef(linkBody, "project_id", "project", projectID)
+	w.setRef(linkBody, "repository_id", "repository", repositoryID)
+}
+
+func credentialResourceType(cred credential) string {
+	switch cred.details.(type) {
+	case *dbt_cloud.SnowflakeCredential:
+		return "dbtcloud_snowflake_credential"
+	case *dbt_cloud.BigQueryCredential:
+		return "dbtcloud_bigquery_credential"
+	case *dbt_cloud.PostgresCredential:
+		return "dbtcloud_postgres_credential"
+	case *dbt_cloud.DatabricksCredential:
+		return "dbtcloud_databricks_credential"
+	case *dbt_cloud.FabricCredential:
+		return "dbtcloud_fabric_credential"
+	}
+	return ""
+}
+
+// writeCredential writes the non-secret fields of a credential, the secrets are read from variables
+func (w *writer) writeCredential(p project, cred credential) {
+	projectID := int(p.ID)
+	credentialID := *cred.ID
+
+	credentialRef, ok := w.lookup("credential", credentialID)
+	if !ok {
+		adapter := cred.Type
+		if cred.AdapterVersion != "" {
+			adapter = cred.AdapterVersion
+		}
+		w.warn(
+			"the credential %d of the project %s uses the adapter %s which is not supported by the export, it needs to be added manually",
+			credentialID,
+			p.Name,
+			adapter,
+		)
+		return
+	}
+
+	body := w.resource("credentials.tf", credentialRef, importID(projectID, credentialID))
+	w.setRef(body, "project_id", "project", projectID)
+
+	secretDescription := func(field string) string {
+		return fmt.Sprintf("The %s of the credential %s, it can't be read from dbt Cloud", field, credentialRef.name)
+	}
+
+	switch details := cred.details.(type) {
+	case *dbt_cloud.SnowflakeCredential:
+		body.SetAttributeValue("auth_type", cty.StringVal(details.Auth_Type))
+		body.SetAttributeValue("user", cty.StringVal(details.User))
+		body.SetAttributeValue("schema", cty.StringVal(details.Schema))
+		for _, attribute := range []struct{ name, value string }{
+			{"database", details.Database},
+			{"role", details.Role},
+			{"warehouse", details.Warehouse},
+		} {
+			if attribute.value != "" {
+				body.SetAttributeValue(attribute.name, cty.StringVal(attribute.value))
+			}
+		}
+		body.SetAttributeValue("num_threads", cty.NumberIntVal(int64(details.Threads)))
+		if details.Auth_Type == "keypair" {
+			body.SetAttributeRaw("private_key", w.stringSecret(secretDescription("private key"), credentialRef.name, "private_key"))
+		} else {
+			body.SetAttributeRaw("password", w.stringSecret(secretDescription("password"), credentialRef.name, "password"))
+		}
+
+	case *dbt_cloud.BigQueryCredential:
+		body.SetAttributeValue("dataset", cty.StringVal(details.Dataset))
+		body.SetAttributeValue("num_threads", cty.NumberIntVal(int64(details.Threads)))
+
+	case *dbt_cloud.PostgresCredential:
+		body.SetAttributeValue("type", cty.StringVal(details.Type))
+		body.SetAttributeValue("username", cty.StringVal(details.Username))
+		body.SetAttributeValue("default_schema", cty.StringVal(details.Default_Schema))
+		if details.Target_Name != "" && details.Target_Name != "default" {
+			body.SetAttributeValue("target_name", cty.StringVal(details.Target_Name))
+		}
+		body.SetAttributeValue("num_threads", cty.NumberIntVal(int64(details.Threads)))
+		body.SetAttributeRaw("password", w.stringSecret(secretDescription("password"), credentialRef.name, "password"))
+
+	case *dbt_cloud.DatabricksCredential:
+		body.SetAttributeValue("adapter_type", cty.StringVal("databricks"))
+		if details.Adapter_Id != 0 {
+			body.SetAttributeValue("adapter_id", cty.NumberIntVal(int64(details.Adapter_Id)))
+		}
+		if details.Target_Name != "" && details.Target_Name != "default" {
+			body.SetAttributeValue("target_name", cty.StringVal(details.Target_Name))
+		}
+		if details.UnencryptedCredentialDetails.Catalog != "" {
+			body.SetAttributeValue("catalog", cty.StringVal(details.UnencryptedCredentialDetails.Catalog))
+		}
+		body.SetAttributeValue("schema", cty.StringVal(details.UnencryptedCredentialDetails.Schema))
+		body.SetAttributeRaw("token", w.stringSecret(secretDescription("token"), credentialRef.name, "token"))
+
+	case *dbt_cloud.FabricCredential:
+		unencrypted := details.UnencryptedCredentialDetails
+		body.SetAttributeValue("adapter_id", cty.NumberIntVal(int64(details.Adapter_Id)))
+		body.SetAttributeValue("schema", cty.StringVal(unencrypted.Schema))
+		for _, attribute := range []struct{ name, value string }{
+			{"user", unencrypted.User},
+			{"tenant_id", unencrypted.TenantId},
+			{"client_id", unencrypted.ClientId},
+			{"schema_authorization", unencrypted.SchemaAuthorization},
+		} {
+			if attribute.value != "" {
+				body.SetAttributeValue(attribute.name, cty.StringVal(attribute.value))
+			}
+		}
+		// Fabric credentials use either a user and password or a service principal
+		if unencrypted.User != "" {
+			body.SetAttributeRaw("password", w.stringSecret(secretDescription("password"), credentialRef.name, "password"))
+		} else {
+			body.SetAttributeRaw("client_secret", w.stringSecret(secretDescription("client secret"), credentialRef.name, "client_secret"))
+		}
+	}
+}
+
+func (w *writer) writeEnvironment(environment dbt_cloud.Environment) {
+	environmentRef, _ := w.lookup("environment", *environment.ID)
+
+	body := w.resource("environments.tf", environmentRef, importID(environment.Project_Id, *environment.ID))
+	w.setRef(body, "project_id", "project", environment.Project_Id)
+	body.SetAttributeValue("name", cty.StringVal(environment.Name))
+	body.SetAttributeValue("type", cty.StringVal(environment.Type))
+	if environment.Dbt_Version != "" {
+		body.SetAttributeValue("dbt_version", cty.StringVal(environment.Dbt_Version))
+	}
+	if environment.DeploymentType != nil && *environment.DeploymentType != "" {
+		body.SetAttributeValue("deployment_type", cty.StringVal(*environment.DeploymentType))
+	}
+	if environment.Use_Custom_Branch {
+		body.SetAttributeValue("use_custom_branch", cty.True)
+	}
+	if environment.Custom_Branch != nil && *environment.Custom_Branch != "" {
+		body.SetAttributeValue("custom_branch", cty.StringVal(*environment.Custom_Branch))
+	}
+	if environment.Credential_Id != nil {
+		w.setRef(body, "credential_id", "credential", *environment.Credential_Id)
+	}
+	if environment.ConnectionID != nil {
+		w.setRef(body, "connection_id", "connection", *environment.ConnectionID)
+	}
+	if environment.ExtendedAttributesID != nil {
+		body.SetAttributeValue("extended_attributes_id", cty.NumberIntVal(int64(*environment.ExtendedAttributesID)))
+	}
+	if environment.EnableModelQueryHistory {
+		body.SetAttributeValue("enable_model_query_history", cty.True)
+	}
+}
+
+// writeEnvironmentVariable writes the values of the variable for each environment
+// the values of secret variables are not returned by dbt Cloud and are read from a variable
+func (w *writer) writeEnvironmentVariable(p project, environmentVariable dbt_cloud.EnvironmentVariable) {
+	ref := reference{
+		resourceType: "dbtcloud_environment_variable",
+		name:         w.uniqueName("dbtcloud_environment_variable", p.Name, environmentVariable.Name),
+	}
+
+	body := w.resource(
+		"environment_variables.tf",
+		ref,
+		fmt.Sprintf("%d%s%s", p.ID, dbt_cloud.ID_DELIMITER, environmentVariable.Name),
+	)
+	w.setRef(body, "project_id", "project", int(p.ID))
+	body.SetAttributeValue("name", cty.StringVal(environmentVariable.Name))
+
+	if strings.HasPrefix(environmentVariable.Name, SECRET_ENVIRONMENT_VARIABLE_PREFIX) {
+		body.SetAttributeRaw("environment_values", w.mapSecret(
+			fmt.Sprintf("The values per environment of the secret environment variable %s of the project %s", environmentVariable.Name, p.Name),
+			ref.name,
+			"environment_values",
+		))
+	} else {
+		values := map[string]cty.Value{}
+		for environmentName, value := range environmentVariable.EnvironmentNameValues {
+			values[environmentName] = cty.StringVal(value)
+		}
+		body.SetAttributeValue("environment_values", cty.MapVal(values))
+	}
+
+	// the values are set per environment name, so the environments need to exist first
+	environments := []hclwrite.Tokens{}
+	for _, environment := range p.environments {
+		if environmentRef, ok := w.lookup("environment", *environment.ID); ok {
+			environments = append(environments, hclwrite.TokensForTraversal(environmentRef.traversal("")))
+		}
+	}
+	if len(environments) > 0 {
+		body.SetAttributeRaw("depends_on", hclwrite.TokensForTuple(environments))
+	}
+}
+
+func (w *writer) writeJob(job dbt_cloud.Job) {
+	jobID := *job.ID
+	jobRef, _ := w.lookup("job", jobID)
+
+	body := w.resource("jobs.tf", jobRef, strconv.Itoa(jobID))
+	w.setRef(body, "project_id", "project", job.Project_Id)
+	w.setRef(body, "environment_id", "environment", job.Environment_Id)
+	body.SetAttributeValue("name", cty.StringVal(job.Name))
+	if job.Description != "" {
+		body.SetAttributeValue("description", cty.StringVal(job.Description))
+	}
+	body.SetAttributeValue("execute_steps", stringList(job.Execute_Steps))
+	if job.Dbt_Version != nil {
+		body.SetAttributeValue("dbt_version", cty.StringVal(*job.Dbt_Version))
+	}
+	body.SetAttributeValue("triggers", cty.MapVal(map[string]cty.Value{
+		"github_webhook":       cty.BoolVal(job.Triggers.Github_Webhook),
+		"git_provider_webhook": cty.BoolVal(job.Triggers.GitProviderWebhook),
+		"schedule":             cty.BoolVal(job.Triggers.Schedule),
+		"on_merge":             cty.BoolVal(job.Triggers.OnMerge),
+	}))
+	if job.Settings.Threads != 0 && job.Settings.Threads != 1 {
+		body.SetAttributeValue("num_threads", cty.NumberIntVal(int64(job.Settings.Threads)))
+	}
+	if job.Settings.Target_Name != "" && job.Settings.Target_Name != "default" {
+		body.SetAttributeValue("target_name", cty.StringVal(job.Settings.Target_Name))
+	}
+	if job.Generate_Docs {
+		body.SetAttributeValue("generate_docs", cty.True)
+	}
+	if job.Run_Generate_Sources {
+		body.SetAttributeValue("run_generate_sources", cty.True)
+	}
+
+	if job.Schedule.Date.Type != "" {
+		body.SetAttributeValue("schedule_type", cty.StringVal(job.Schedule.Date.Type))
+	}
+	if job.Schedule.Time.Interval > 1 {
+		body.SetAttributeValue("schedule_interval", cty.NumberIntVal(int64(job.Schedule.Time.Interval)))
+	}
+	if job.Schedule.Time.Hours != nil {
+		body.SetAttributeValue("schedule_hours", intList(*job.Schedule.Time.Hours))
+	}
+	if job.Schedule.Date.Days != nil {
+		body.SetAttributeValue("schedule_days", intList(*job.Schedule.Date.Days))
+	}
+	if job.Schedule.Date.Cron != nil && *job.Schedule.Date.Cron != "" {
+		body.SetAttributeValue("schedule_cron", cty.StringVal(*job.Schedule.Date.Cron))
+	}
+
+	if job.Deferring_Job_Id != nil {
+		if *job.Deferring_Job_Id == jobID {
+			body.SetAttributeValue("self_deferring", cty.True)
+		} else {
+			w.setRef(body, "deferring_job_id", "job", *job.Deferring_Job_Id)
+		}
+	}
+	if job.DeferringEnvironmentId != nil {
+		w.setRef(body, "deferring_environment_id", "environment", *job.DeferringEnvironmentId)
+	}
+	if job.Execution.Timeout_Seconds != 0 {
+		body.SetAttributeValue("timeout_seconds", cty.NumberIntVal(int64(job.Execution.Timeout_Seconds)))
+	}
+	if job.TriggersOnDraftPR {
+		body.SetAttributeValue("triggers_on_draft_pr", cty.True)
+	}
+	if job.RunCompareChanges {
+		body.SetAttributeValue("run_compare_changes", cty.True)
+	}
+
+	if job.JobCompletionTrigger != nil {
+		condition := job.JobCompletionTrigger.Condition
+		statuses := lo.Map(condition.Statuses, func(status int, _ int) string {
+			return fmt.Sprint(utils.JobCompletionTriggerConditionsMappingCodeHuman[status])
+		})
+
+		body.AppendNewline()
+		conditionBody := body.AppendNewBlock("job_completion_trigger_condition", nil).Body()
+		w.setRef(conditionBody, "job_id", "job", condition.JobID)
+		w.setRef(conditionBody, "project_id", "project", condition.ProjectID)
+		conditionBody.SetAttributeValue("statuses", stringList(statuses))
+	}
+}
+
+func (w *writer) writeGroup(group dbt_cloud.Group) {
+	ref := reference{
+		resourceType: "dbtcloud_group",
+		name:         w.uniqueName("dbtcloud_group", group.Name),
+	}
+
+	body := w.resource("groups.tf", ref, strconv.Itoa(*group.ID))
+	body.SetAttributeValue("name", cty.StringVal(group.Name))
+	if group.AssignByDefault {
+		body.SetAttributeValue("assign_by_default", cty.True)
+	}
+	if len(group.SSOMappingGroups) > 0 {
+		body.SetAttributeValue("sso_mapping_groups", stringList(group.SSOMappingGroups))
+	}
+
+	for _, permission := range group.Permissions {
+		body.AppendNewline()
+		permissionBody := body.AppendNewBlock("group_permissions", nil).Body()
+		permissionBody.SetAttributeValue("permission_set", cty.StringVal(permission.Set))
+		permissionBody.SetAttributeValue("all_projects", cty.BoolVal(permission.AllProjects))
+		if !permission.AllProjects && permission.ProjectID != 0 {
+			w.setRef(permissionBody, "project_id", "project", permission.ProjectID)
+		}
+		if len(permission.WritableEnvironmentCategories) > 0 {
+			permissionBody.SetAttributeValue(
+				"writable_environment_categories",
+				stringList(permission.WritableEnvironmentCategories),
+			)
+		}
+	}
+}
+
+func (w *writer) writeNotification(notification dbt_cloud.Notification) {
+	nameParts := []string{"notification", strconv.Itoa(*notification.Id)}
+	ref := reference{
+		resourceType: "dbtcloud_notification",
+		name:         w.uniqueName("dbtcloud_notification", nameParts...),
+	}
+
+	body := w.resource("notifications.tf", ref, strconv.Itoa(*notification.Id))
+	body.SetAttributeValue("user_id", cty.NumberIntVal(int64(notification.UserId)))
+	body.SetAttributeValue("notification_type", cty.NumberIntVal(int64(notification.NotificationType)))
+	for _, jobs := range []struct {
+		attribute string
+		jobIDs    []int
+	}{
+		{"on_success", notification.OnSuccess},
+		{"on_failure", notification.OnFailure},
+		{"on_warning", notification.OnWarning},
+		{"on_cancel", notification.OnCancel},
+	} {
+		if len(jobs.jobIDs) > 0 {
+			w.setRefs(body, jobs.attribute, "job", jobs.jobIDs)
+		}
+	}
+	if notification.ExternalEmail != nil && *notification.ExternalEmail != "" {
+		body.SetAttributeValue("external_email", cty.StringVal(*notification.ExternalEmail))
+	}
+	if notification.SlackChannelID != nil && *notification.SlackChannelID != "" {
+		body.SetAttributeValue("slack_channel_id", cty.StringVal(*notification.SlackChannelID))
+	}
+	if notification.SlackChannelName != nil && *notification.SlackChannelName != "" {
+		body.SetAttributeValue("slack_channel_name", cty.StringVal(*notification.SlackChannelName))
+	}
+}
+
+func (w *writer) writeWebhook(webhook dbt_cloud.WebhookRead) {
+	ref := reference{
+		resourceType: "dbtcloud_webhook",
+		name:         w.uniqueName("dbtcloud_webhook", webhook.Name),
+	}
+
+	body := w.resource("webhooks.tf", ref, webhook.WebhookId)
+	body.SetAttributeValue("name", cty.StringVal(webhook.Name))
+	if webhook.Description != "" {
+		body.SetAttributeValue("description", cty.StringVal(webhook.Description))
+	}
+	body.SetAttributeValue("client_url", cty.StringVal(webhook.ClientUrl))
+	body.SetAttributeValue("event_types", stringList(webhook.EventTypes))
+
+	jobIDs := []int{}
+	for _, jobID := range webhook.JobIds {
+		id, err := strconv.Atoi(jobID)
+		if err != nil {
+			w.warn("the webhook %s refers to the invalid job ID %s which was ignored", webhook.Name, jobID)
+			continue
+		}
+		jobIDs = append(jobIDs, id)
+	}
+	if len(jobIDs) > 0 {
+		w.setRefs(body, "job_ids", "job", jobIDs)
+	}
+	if !webhook.Active {
+		body.SetAttributeValue("active", cty.False)
+	}
+}
+
+// globalConnectionSchema returns the schema of the global connection resource
+// the config of each adapter is written from its nested attribute in the schema
+func globalConnectionSchema(ctx context.Context) (resource_schema.Schema, error) {
+	resp := resource.SchemaResponse{}
+	global_connection.GlobalConnectionResource().Schema(ctx, resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		return resp.Schema, fmt.Errorf("error reading the schema of the global connections: %v", resp.Diagnostics)
+	}
+	return resp.Schema, nil
+}
+
+// connectionAdapterAttribute returns the attribute holding the config of an adapter, e.g. apache_spark for apache_spark_v0
+func connectionAdapterAttribute(adapterVersion string) string {
+	adapter := adapterVersion
+	if lastUnderscoreIndex := strings.LastIndex(adapterVersion, "_"); lastUnderscoreIndex != -1 {
+		adapter = adapterVersion[:lastUnderscoreIndex]
+	}
+	// Trino connections are configured under starburst
+	if adapter == "trino" {
+		return "starburst"
+	}
+	return adapter
+}
+
+func (w *writer) writeGlobalConnection(connection globalConnection, connectionSchema resource_schema.Schema) {
+	connectionRef, _ := w.lookup("connection", connection.ID)
+
+	adapterAttribute := connectionAdapterAttribute(connection.AdapterVersion)
+	adapterSchema, ok := connectionSchema.Attributes[adapterAttribute].(resource_schema.SingleNestedAttribute)
+	if !ok {
+		w.warn(
+			"the global connection %s uses the adapter %s which is not supported by the export, it needs to be added manually",
+			connection.Name,
+			connection.AdapterVersion,
+		)
+		return
+	}
+
+	body := w.resource("global_connections.tf", connectionRef, strconv.FormatInt(connection.ID, 10))
+	body.SetAttributeValue("name", cty.StringVal(connection.Name))
+	if connection.PrivateLinkEndpointID != nil && *connection.PrivateLinkEndpointID != "" {
+		body.SetAttributeValue("private_link_endpoint_id", cty.StringVal(*connection.PrivateLinkEndpointID))
+	}
+	if connection.OauthConfigurationID != nil {
+		body.SetAttributeValue("oauth_configuration_id", cty.NumberIntVal(*connection.OauthConfigurationID))
+	}
+
+	attributeNames := lo.Keys(adapterSchema.Attributes)
+	sort.Strings(attributeNames)
+
+	config := []hclwrite.ObjectAttrTokens{}
+	for _, attributeName := range attributeNames {
+		attribute := adapterSchema.Attributes[attributeName]
+		if !attribute.IsRequired() && !attribute.IsOptional() {
+			continue
+		}
+
+		var valueTokens hclwrite.Tokens
+		if attribute.IsSensitive() {
+			// secrets are not returned by dbt Cloud, only the required ones are read from variables
+			if !attribute.IsRequired() {
+				continue
+			}
+			valueTokens = w.stringSecret(
+				fmt.Sprintf("The %s of the global connection %s, it can't be read from dbt Cloud", attributeName, connection.Name),
+				connectionRef.name,
+				attributeName,
+			)
+		} else {
+			value, ok := ctyValue(connection.config[attributeName])
+			if !ok {
+				continue
+			}
+			valueTokens = hclwrite.TokensForValue(value)
+		}
+
+		config = append(config, hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForIdentifier(attributeName),
+			Value: valueTokens,
+		})
+	}
+	body.SetAttributeRaw(adapterAttribute, hclwrite.TokensForObject(config))
+}
+
+// ctyValue converts the JSON values of the global connection configs
+// nulls and nested objects are not converted as they are not part of the configs in the schema
+func ctyValue(value any) (cty.Value, bool) {
+	switch v := value.(type) {
+	case string:
+		return cty.StringVal(v), true
+	case bool:
+		return cty.BoolVal(v), true
+	case float64:
+		return cty.NumberFloatVal(v), true
+	case []any:
+		elements := []cty.Value{}
+		for _, element := range v {
+			elementValue, ok := ctyValue(element)
+			if !ok {
+				return cty.NilVal, false
+			}
+			elements = append(elements, elementValue)
+		}
+		if len(elements) == 0 {
+			return cty.EmptyTupleVal, true
+		}
+		return cty.TupleVal(elements), true
+	}
+	return cty.NilVal, false
+}
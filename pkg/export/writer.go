@@ -0,0 +1,266 @@
+package export
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	IMPORTS_FILE   = "imports.tf"
+	PROVIDERS_FILE = "providers.tf"
+	VARIABLES_FILE = "variables.tf"
+)
+
+var nonIdentifierChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// reference is the address of a generated resource and the attribute other resources use to refer to it
+// e.g. dbtcloud_environment.analytics_prod and environment_id
+type reference struct {
+	resourceType string
+	name         string
+	attribute    string
+}
+
+func (r reference) traversal(attribute string) hcl.Traversal {
+	traversal := hcl.Traversal{
+		hcl.TraverseRoot{Name: r.resourceType},
+		hcl.TraverseAttr{Name: r.name},
+	}
+	if attribute != "" {
+		traversal = append(traversal, hcl.TraverseAttr{Name: attribute})
+	}
+	return traversal
+}
+
+// writer builds the content of the generated files
+// the objects referenced by others need to be registered before writing any resource so that
+// references work whatever the order of the resources, e.g. for job completion triggers
+type writer struct {
+	files    map[string]*hclwrite.File
+	names    map[string]map[string]bool
+	refs     map[string]reference
+	warnings []string
+}
+
+func newWriter() *writer {
+	return &writer{
+		files: map[string]*hclwrite.File{},
+		names: map[string]map[string]bool{},
+		refs:  map[string]reference{},
+	}
+}
+
+// resourceName returns a valid Terraform identifier from the names of an object and its parents
+// e.g. "Analytics", "Prod (EU)" gives analytics_prod_eu
+func resourceName(parts ...string) string {
+	words := []string{}
+	for _, part := range parts {
+		word := strings.Trim(nonIdentifierChars.ReplaceAllString(strings.ToLower(part), "_"), "_")
+		if word != "" {
+			words = append(words, word)
+		}
+	}
+
+	name := strings.Join(words, "_")
+	if name == "" {
+		return "unnamed"
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		return "_" + name
+	}
+	return name
+}
+
+// uniqueName returns a name not used yet for the resource type, adding a suffix for duplicates
+func (w *writer) uniqueName(resourceType string, parts ...string) string {
+	if _, ok := w.names[resourceType]; !ok {
+		w.names[resourceType] = map[string]bool{}
+	}
+
+	baseName := resourceName(parts...)
+	name := baseName
+	for i := 2; w.names[resourceType][name]; i++ {
+		name = fmt.Sprintf("%s_%d", baseName, i)
+	}
+	w.names[resourceType][name] = true
+	return name
+}
+
+func refKey(kind string, id any) string {
+	return fmt.Sprintf("%s/%v", kind, id)
+}
+
+// register assigns the name of the resource of an object that other resources refer to
+func (w *writer) register(
+	kind string,
+	id any,
+	resourceType string,
+	attribute string,
+	parts ...string,
+) reference {
+	ref := reference{
+		resourceType: resourceType,
+		name:         w.uniqueName(resourceType, parts...),
+		attribute:    attribute,
+	}
+	w.refs[refKey(kind, id)] = ref
+	return ref
+}
+
+func (w *writer) lookup(kind string, id any) (reference, bool) {
+	ref, ok := w.refs[refKey(kind, id)]
+	return ref, ok
+}
+
+// refTokens refers to an exported object, or uses its ID when it is not part of the export
+func (w *writer) refTokens(kind string, id int) hclwrite.Tokens {
+	if ref, ok := w.lookup(kind, id); ok {
+		return hclwrite.TokensForTraversal(ref.traversal(ref.attribute))
+	}
+	return hclwrite.TokensForValue(cty.NumberIntVal(int64(id)))
+}
+
+func (w *writer) setRef(body *hclwrite.Body, attribute string, kind string, id int) {
+	body.SetAttributeRaw(attribute, w.refTokens(kind, id))
+}
+
+func (w *writer) setRefs(body *hclwrite.Body, attribute string, kind string, ids []int) {
+	elements := []hclwrite.Tokens{}
+	for _, id := range ids {
+		elements = append(elements, w.refTokens(kind, id))
+	}
+	body.SetAttributeRaw(attribute, hclwrite.TokensForTuple(elements))
+}
+
+func (w *writer) file(name string) *hclwrite.Body {
+	if _, ok := w.files[name]; !ok {
+		w.files[name] = hclwrite.NewEmptyFile()
+	}
+	return w.files[name].Body()
+}
+
+func appendBlock(body *hclwrite.Body, blockType string, labels []string) *hclwrite.Body {
+	if len(body.Blocks()) > 0 {
+		body.AppendNewline()
+	}
+	return body.AppendNewBlock(blockType, labels).Body()
+}
+
+// resource adds the block of a resource to a file and the matching import block
+func (w *writer) resource(fileName string, ref reference, importID string) *hclwrite.Body {
+	importBody := appendBlock(w.file(IMPORTS_FILE), "import", nil)
+	importBody.SetAttributeTraversal("to", ref.traversal(""))
+	importBody.SetAttributeValue("id", cty.StringVal(importID))
+
+	return appendBlock(w.file(fileName), "resource", []string{ref.resourceType, ref.name})
+}
+
+// secret adds a sensitive variable for a value that can't be read from dbt Cloud and returns a reference to it
+func (w *writer) secret(description string, variableType hclwrite.Tokens, parts ...string) hclwrite.Tokens {
+	name := w.uniqueName("variable", parts...)
+
+	body := appendBlock(w.file(VARIABLES_FILE), "variable", []string{name})
+	body.SetAttributeValue("description", cty.StringVal(description))
+	body.SetAttributeRaw("type", variableType)
+	body.SetAttributeValue("sensitive", cty.True)
+
+	return hclwrite.TokensForTraversal(hcl.Traversal{
+		hcl.TraverseRoot{Name: "var"},
+		hcl.TraverseAttr{Name: name},
+	})
+}
+
+func (w *writer) stringSecret(description string, parts ...string) hclwrite.Tokens {
+	return w.secret(description, hclwrite.TokensForIdentifier("string"), parts...)
+}
+
+func (w *writer) mapSecret(description string, parts ...string) hclwrite.Tokens {
+	return w.secret(
+		description,
+		hclwrite.TokensForFunctionCall("map", hclwrite.TokensForIdentifier("string")),
+		parts...,
+	)
+}
+
+func (w *writer) warn(format string, a ...any) {
+	w.warnings = append(w.warnings, fmt.Sprintf(format, a...))
+}
+
+func (w *writer) writeProviders() {
+	terraform := appendBlock(w.file(PROVIDERS_FILE), "terraform", nil)
+	// import blocks are supported from Terraform 1.5
+	terraform.SetAttributeValue("required_version", cty.StringVal(">= 1.5.0"))
+	requiredProviders := appendBlock(terraform, "required_providers", nil)
+	requiredProviders.SetAttributeValue("dbtcloud", cty.ObjectVal(map[string]cty.Value{
+		"source": cty.StringVal("dbt-labs/dbtcloud"),
+	}))
+}
+
+// save writes the files to the output directory, existing files are never overwritten
+func (w *writer) save(outputDir string) ([]string, error) {
+	fileNames := []string{}
+	for fileName := range w.files {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+
+	for _, fileName := range fileNames {
+		if _, err := os.Stat(filepath.Join(outputDir, fileName)); err == nil {
+			return nil, fmt.Errorf(
+				"the file %s already exists in %s, the export needs to be written to a new directory",
+				fileName,
+				outputDir,
+			)
+		}
+	}
+
+	if err := os.MkdirAll(outputDir, 0o755); err != nil {
+		return nil, err
+	}
+	for _, fileName := range fileNames {
+		content := hclwrite.Format(w.files[fileName].Bytes())
+		if err := os.WriteFile(filepath.Join(outputDir, fileName), content, 0o644); err != nil {
+			return nil, err
+		}
+	}
+	return fileNames, nil
+}
+
+func stringList(values []string) cty.Value {
+	if len(values) == 0 {
+		return cty.ListValEmpty(cty.String)
+	}
+	elements := []cty.Value{}
+	for _, value := range values {
+		elements = append(elements, cty.StringVal(value))
+	}
+	return cty.ListVal(elements)
+}
+
+func intList(values []int) cty.Value {
+	if len(values) == 0 {
+		return cty.ListValEmpty(cty.Number)
+	}
+	elements := []cty.Value{}
+	for _, value := range values {
+		elements = append(elements, cty.NumberIntVal(int64(value)))
+	}
+	return cty.ListVal(elements)
+}
+
+func importID(ids ...int) string {
+	parts := []string{}
+	for _, id := range ids {
+		parts = append(parts, strconv.Itoa(id))
+	}
+	return strings.Join(parts, ":")
+}
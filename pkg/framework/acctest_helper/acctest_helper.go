@@ -6,10 +6,12 @@ import (
 	"net/http"
 	"os"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud_fake"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/provider"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -19,7 +21,34 @@ import (
 	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 )
 
+const (
+	FAKE_API_ACCOUNT_ID = 100000
+	FAKE_API_TOKEN      = "fake-api-token"
+)
+
+var (
+	fakeAPI     *dbt_cloud_fake.Server
+	fakeAPIOnce sync.Once
+)
+
+// useFakeAPI starts the in-memory fake of the dbt Cloud API when DBT_CLOUD_FAKE_API is true
+// the provider and the shared client then connect to it via the usual environment variables
+// the fake is kept for the whole test run as the tests of a package share the same objects
+func useFakeAPI() {
+	fakeAPIOnce.Do(func() {
+		if enabled, _ := strconv.ParseBool(os.Getenv("DBT_CLOUD_FAKE_API")); !enabled {
+			return
+		}
+
+		fakeAPI = dbt_cloud_fake.NewServer(FAKE_API_ACCOUNT_ID, FAKE_API_TOKEN)
+		os.Setenv("DBT_CLOUD_ACCOUNT_ID", strconv.Itoa(fakeAPI.AccountID))
+		os.Setenv("DBT_CLOUD_TOKEN", fakeAPI.Token)
+		os.Setenv("DBT_CLOUD_HOST_URL", fakeAPI.HostURL)
+	})
+}
+
 func SharedClient() (*dbt_cloud.Client, error) {
+	useFakeAPI()
 
 	accountIDString := os.Getenv("DBT_CLOUD_ACCOUNT_ID")
 	accountID, _ := strconv.Atoi(accountIDString)
@@ -31,10 +60,13 @@ func SharedClient() (*dbt_cloud.Client, error) {
 	}
 
 	client := dbt_cloud.Client{
-		HTTPClient: &http.Client{Timeout: 30 * time.Second},
-		HostURL:    hostURL,
-		Token:      token,
-		AccountID:  accountID,
+		HTTPClient:       &http.Client{Timeout: 30 * time.Second},
+		HostURL:          hostURL,
+		Token:            token,
+		AccountID:        accountID,
+		RetryMaxAttempts: dbt_cloud.DEFAULT_RETRY_MAX_ATTEMPTS,
+		RetryMaxWait:     dbt_cloud.DEFAULT_RETRY_MAX_WAIT,
+		PageConcurrency:  dbt_cloud.DEFAULT_PAGE_CONCURRENCY,
 	}
 
 	return &client, nil
@@ -46,6 +78,8 @@ const (
 
 var TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 	"dbtcloud": func() (tfprotov6.ProviderServer, error) {
+		useFakeAPI()
+
 		upgradedSdkProvider, err := tf5to6server.UpgradeServer(
 			context.Background(),
 			provider.SDKProvider("test")().GRPCProvider,
@@ -65,6 +99,8 @@ var TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 }
 
 func TestAccPreCheck(t *testing.T) {
+	useFakeAPI()
+
 	if v := os.Getenv("DBT_CLOUD_ACCOUNT_ID"); v == "" {
 		t.Fatal("DBT_CLOUD_ACCOUNT_ID must be set for acceptance tests")
 	}
@@ -1,6 +1,7 @@
 package account_features
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -9,4 +10,6 @@ type AccountFeaturesResourceModel struct {
 	AdvancedCI     types.Bool   `tfsdk:"advanced_ci"`
 	PartialParsing types.Bool   `tfsdk:"partial_parsing"`
 	RepoCaching    types.Bool   `tfsdk:"repo_caching"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
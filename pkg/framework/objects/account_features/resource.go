@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -22,8 +23,8 @@ func AccountFeaturesResource() resource.Resource {
 	return &accountFeaturesResource{}
 }
 
-func readFeatures(client *dbt_cloud.Client) (AccountFeaturesResourceModel, error) {
-	features, err := client.GetAccountFeatures()
+func readFeatures(ctx context.Context, client *dbt_cloud.Client) (AccountFeaturesResourceModel, error) {
+	features, err := client.GetAccountFeatures(ctx)
 	if err != nil {
 		return AccountFeaturesResourceModel{}, err
 	}
@@ -57,9 +58,12 @@ func (r *accountFeaturesResource) Create(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// Update features
 	if !plan.AdvancedCI.IsUnknown() {
-		err := r.client.UpdateAccountFeature("advanced-ci", plan.AdvancedCI.ValueBool())
+		err := r.client.UpdateAccountFeature(ctx, "advanced-ci", plan.AdvancedCI.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating advanced-ci feature", err.Error())
 			return
@@ -67,7 +71,7 @@ func (r *accountFeaturesResource) Create(
 	}
 
 	if !plan.PartialParsing.IsUnknown() {
-		err := r.client.UpdateAccountFeature("partial-parsing", plan.PartialParsing.ValueBool())
+		err := r.client.UpdateAccountFeature(ctx, "partial-parsing", plan.PartialParsing.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating partial-parsing feature", err.Error())
 			return
@@ -75,18 +79,19 @@ func (r *accountFeaturesResource) Create(
 	}
 
 	if !plan.RepoCaching.IsUnknown() {
-		err := r.client.UpdateAccountFeature("repo-caching", plan.RepoCaching.ValueBool())
+		err := r.client.UpdateAccountFeature(ctx, "repo-caching", plan.RepoCaching.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating repo-caching feature", err.Error())
 			return
 		}
 	}
 
-	features, err := readFeatures(r.client)
+	features, err := readFeatures(ctx, r.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading account features", err.Error())
 		return
 	}
+	features.Timeouts = plan.Timeouts
 
 	diags = resp.State.Set(ctx, &features)
 	resp.Diagnostics.Append(diags...)
@@ -97,12 +102,21 @@ func (r *accountFeaturesResource) Read(
 	req resource.ReadRequest,
 	resp *resource.ReadResponse,
 ) {
+	var state AccountFeaturesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
 
-	features, err := readFeatures(r.client)
+	features, err := readFeatures(ctx, r.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading account features", err.Error())
 		return
 	}
+	features.Timeouts = state.Timeouts
 
 	diags := resp.State.Set(ctx, &features)
 	resp.Diagnostics.Append(diags...)
@@ -127,9 +141,12 @@ func (r *accountFeaturesResource) Update(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Update changed values
 	if !plan.AdvancedCI.IsUnknown() && !plan.AdvancedCI.Equal(state.AdvancedCI) {
-		err := r.client.UpdateAccountFeature("advanced-ci", plan.AdvancedCI.ValueBool())
+		err := r.client.UpdateAccountFeature(ctx, "advanced-ci", plan.AdvancedCI.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating advanced-ci feature", err.Error())
 			return
@@ -137,7 +154,7 @@ func (r *accountFeaturesResource) Update(
 	}
 
 	if !plan.PartialParsing.IsUnknown() && !plan.PartialParsing.Equal(state.PartialParsing) {
-		err := r.client.UpdateAccountFeature("partial-parsing", plan.PartialParsing.ValueBool())
+		err := r.client.UpdateAccountFeature(ctx, "partial-parsing", plan.PartialParsing.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating partial-parsing feature", err.Error())
 			return
@@ -145,18 +162,19 @@ func (r *accountFeaturesResource) Update(
 	}
 
 	if !plan.RepoCaching.IsUnknown() && !plan.RepoCaching.Equal(state.RepoCaching) {
-		err := r.client.UpdateAccountFeature("repo-caching", plan.RepoCaching.ValueBool())
+		err := r.client.UpdateAccountFeature(ctx, "repo-caching", plan.RepoCaching.ValueBool())
 		if err != nil {
 			resp.Diagnostics.AddError("Error updating repo-caching feature", err.Error())
 			return
 		}
 	}
 
-	features, err := readFeatures(r.client)
+	features, err := readFeatures(ctx, r.client)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reading account features", err.Error())
 		return
 	}
+	features.Timeouts = plan.Timeouts
 
 	diags = resp.State.Set(ctx, &features)
 	resp.Diagnostics.Append(diags...)
@@ -11,7 +11,7 @@ import (
 )
 
 func (r *accountFeaturesResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -45,5 +45,8 @@ func (r *accountFeaturesResource) Schema(
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helper.TimeoutsBlock(ctx),
+		},
 	}
 }
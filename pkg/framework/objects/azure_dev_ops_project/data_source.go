@@ -40,7 +40,7 @@ func (d *azureDevOpsProjectDataSource) Read(
 
 	projectName := state.Name.ValueString()
 
-	azureDevOpsProject, err := d.client.GetAzureDevOpsProject(projectName)
+	azureDevOpsProject, err := d.client.GetAzureDevOpsProject(ctx, projectName)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
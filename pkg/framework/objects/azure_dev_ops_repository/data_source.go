@@ -37,7 +37,7 @@ func (d *azureDevOpsRepositoryDataSource) Read(
 	repositoryName := state.Name.ValueString()
 	azureDevOpsProjectID := state.AzureDevOpsProjectID.ValueString()
 
-	azureDevOpsRepository, err := d.client.GetAzureDevOpsRepository(repositoryName, azureDevOpsProjectID)
+	azureDevOpsRepository, err := d.client.GetAzureDevOpsRepository(ctx, repositoryName, azureDevOpsProjectID)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -0,0 +1,27 @@
+package bigquery_credential
+
+import (
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/credential"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type BigQueryCredentialResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	ProjectID    types.Int64    `tfsdk:"project_id"`
+	CredentialID types.Int64    `tfsdk:"credential_id"`
+	IsActive     types.Bool     `tfsdk:"is_active"`
+	Dataset      types.String   `tfsdk:"dataset"`
+	NumThreads   types.Int64    `tfsdk:"num_threads"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m *BigQueryCredentialResourceModel) setFromCredential(bigqueryCredential dbt_cloud.BigQueryCredential) {
+	m.ID = credential.ID(bigqueryCredential.Project_Id, *bigqueryCredential.ID)
+	m.ProjectID = types.Int64Value(int64(bigqueryCredential.Project_Id))
+	m.CredentialID = types.Int64Value(int64(*bigqueryCredential.ID))
+	m.IsActive = types.BoolValue(bigqueryCredential.State == dbt_cloud.STATE_ACTIVE)
+	m.Dataset = types.StringValue(bigqueryCredential.Dataset)
+	m.NumThreads = types.Int64Value(int64(bigqueryCredential.Threads))
+}
@@ -0,0 +1,139 @@
+package bigquery_credential
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/credential"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                 = &bigqueryCredentialResource{}
+	_ resource.ResourceWithConfigure    = &bigqueryCredentialResource{}
+	_ resource.ResourceWithImportState  = &bigqueryCredentialResource{}
+	_ resource.ResourceWithUpgradeState = &bigqueryCredentialResource{}
+)
+
+func BigQueryCredentialResource() resource.Resource {
+	return &bigqueryCredentialResource{
+		Base: credential.Base[dbt_cloud.BigQueryCredential]{TypeName: "bigquery_credential"},
+	}
+}
+
+type bigqueryCredentialResource struct {
+	credential.Base[dbt_cloud.BigQueryCredential]
+}
+
+func (r *bigqueryCredentialResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state BigQueryCredentialResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	bigqueryCredential := r.ReadCredential(ctx, state.ID, resp)
+	if bigqueryCredential == nil {
+		return
+	}
+
+	state.setFromCredential(*bigqueryCredential)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *bigqueryCredentialResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan BigQueryCredentialResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	bigqueryCredential, err := r.Client.CreateBigQueryCredential(
+		ctx,
+		int(plan.ProjectID.ValueInt64()),
+		"bigquery",
+		plan.IsActive.ValueBool(),
+		plan.Dataset.ValueString(),
+		int(plan.NumThreads.ValueInt64()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create BigQuery credential",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = credential.ID(bigqueryCredential.Project_Id, *bigqueryCredential.ID)
+	plan.CredentialID = types.Int64Value(int64(*bigqueryCredential.ID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *bigqueryCredentialResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state BigQueryCredentialResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	projectID, credentialID, ok := r.SplitID(state.ID.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	client := r.Credentials()
+	bigqueryCredential, err := client.Get(ctx, projectID, credentialID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue getting BigQuery credential",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	bigqueryCredential.Dataset = plan.Dataset.ValueString()
+	bigqueryCredential.Threads = int(plan.NumThreads.ValueInt64())
+
+	_, err = client.Update(ctx, projectID, credentialID, *bigqueryCredential)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update BigQuery credential",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *bigqueryCredentialResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return credential.UpgradeStateV0[BigQueryCredentialResourceModel](ctx, r, nil)
+}
@@ -1,6 +1,7 @@
-package resources_test
+package bigquery_credential_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -19,7 +20,7 @@ func TestAccDbtCloudBigQueryCredentialResource(t *testing.T) {
 	dataset := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckDbtCloudBigQueryCredentialDestroy,
 		Steps: []resource.TestStep{
@@ -85,7 +86,7 @@ func testAccCheckDbtCloudBigQueryCredentialExists(resource string) resource.Test
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		_, err = apiClient.GetBigQueryCredential(projectId, credentialId)
+		_, err = apiClient.GetBigQueryCredential(context.Background(), projectId, credentialId)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -111,7 +112,7 @@ func testAccCheckDbtCloudBigQueryCredentialDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = apiClient.GetBigQueryCredential(projectId, credentialId)
+		_, err = apiClient.GetBigQueryCredential(context.Background(), projectId, credentialId)
 		if err == nil {
 			return fmt.Errorf("BigQuery credential still exists")
 		}
@@ -0,0 +1,46 @@
+package bigquery_credential
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/credential"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/samber/lo"
+)
+
+func (r *bigqueryCredentialResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the credentials of the BigQuery environments of a project",
+		// the SDKv2 resource was at version 0, the state is upgraded with UpgradeState
+		Version: 1,
+		Attributes: lo.Assign(
+			credential.CommonAttributes("BigQuery"),
+			map[string]schema.Attribute{
+				"is_active": schema.BoolAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(true),
+					Description: "Whether the BigQuery credential is active",
+				},
+				"dataset": schema.StringAttribute{
+					Required:    true,
+					Description: "Default dataset name",
+				},
+				"num_threads": schema.Int64Attribute{
+					Required:    true,
+					Description: "Number of threads to use",
+				},
+			},
+		),
+		Blocks: map[string]schema.Block{
+			"timeouts": helper.TimeoutsBlock(ctx),
+		},
+	}
+}
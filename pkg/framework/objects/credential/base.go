@@ -0,0 +1,216 @@
+package credential
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Base has the methods shared by the resources of the different credential types
+// the resources embed it and implement the Schema, Create, Read and Update methods
+// the credentials are identified by an ID in the format project_id:credential_id
+type Base[T dbt_cloud.CredentialConfig] struct {
+	// TypeName is the name of the resource without the provider prefix, e.g. snowflake_credential
+	TypeName string
+	Client   *dbt_cloud.Client
+}
+
+func (b *Base[T]) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_" + b.TypeName
+}
+
+func (b *Base[T]) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	b.Client = req.ProviderData.(*dbt_cloud.Client)
+}
+
+// Credentials returns the client reading and writing the credentials of the resource type
+func (b *Base[T]) Credentials() dbt_cloud.CredentialClient[T] {
+	return dbt_cloud.NewCredentialClient[T](b.Client)
+}
+
+// SplitID returns the project and credential IDs of the resource ID, ok is false when the ID is not valid
+func (b *Base[T]) SplitID(id string, diags *diag.Diagnostics) (projectID int, credentialID int, ok bool) {
+	projectID, credentialID, err := helper.SplitIDToInts(id, "dbtcloud_"+b.TypeName)
+	if err != nil {
+		diags.AddError("Error parsing the credential ID", err.Error())
+		return 0, 0, false
+	}
+	return projectID, credentialID, true
+}
+
+// ReadCredential gets the credential of the state
+// it returns nil and removes the resource from the state when the credential doesn't exist anymore
+func (b *Base[T]) ReadCredential(ctx context.Context, id types.String, resp *resource.ReadResponse) *T {
+	projectID, credentialID, ok := b.SplitID(id.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return nil
+	}
+
+	client := b.Credentials()
+	credential, err := client.Get(ctx, projectID, credentialID)
+	if err != nil {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			resp.Diagnostics.AddWarning(
+				"Resource not found",
+				"The credential was not found and has been removed from the state.",
+			)
+			resp.State.RemoveResource(ctx)
+			return nil
+		}
+		helper.AddReadError(&resp.Diagnostics, "Error getting the credential", err)
+		return nil
+	}
+
+	return credential
+}
+
+// Delete deletes the credential with the DELETE endpoint
+// the adapter credentials created for legacy connections override it to set their state to deleted instead
+func (b *Base[T]) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var id types.String
+	var timeoutsValue timeouts.Value
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("timeouts"), &timeoutsValue)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, timeoutsValue.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	projectID, credentialID, ok := b.SplitID(id.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	client := b.Credentials()
+	err := client.Delete(ctx, projectID, credentialID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete credential",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the credentials with their ID in the format project_id:credential_id, like the SDKv2 resources
+func (b *Base[T]) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	if _, _, ok := b.SplitID(req.ID, &resp.Diagnostics); !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// ID returns the ID of the resource in the format project_id:credential_id
+func ID(projectID int, credentialID int) types.String {
+	return types.StringValue(fmt.Sprintf("%d%s%d", projectID, dbt_cloud.ID_DELIMITER, credentialID))
+}
+
+// CommonAttributes returns the ID attributes of the credential resources
+// adapterName is the name of the adapter in the descriptions, e.g. Snowflake
+func CommonAttributes(adapterName string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The ID of the credential, in the format `project_id:credential_id`",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"project_id": schema.Int64Attribute{
+			Required:    true,
+			Description: fmt.Sprintf("Project ID to create the %s credential in", adapterName),
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"credential_id": schema.Int64Attribute{
+			Computed:    true,
+			Description: fmt.Sprintf("The system %s credential ID", adapterName),
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+// UpgradeStateV0 returns the upgrader of the states saved by the SDKv2 resources, at version 0
+// the attributes didn't change, normalize converts the zero values saved by the SDKv2 for the attributes not set to null
+func UpgradeStateV0[M any](
+	ctx context.Context,
+	r resource.Resource,
+	normalize func(*M),
+) map[int64]resource.StateUpgrader {
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	schemaV0 := schemaResp.Schema
+	schemaV0.Version = 0
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: &schemaV0,
+			StateUpgrader: func(
+				ctx context.Context,
+				req resource.UpgradeStateRequest,
+				resp *resource.UpgradeStateResponse,
+			) {
+				var state M
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				if normalize != nil {
+					normalize(&state)
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			},
+		},
+	}
+}
+
+// NullIfEmpty returns null for the empty strings, which the SDKv2 resources saved for the attributes not set
+func NullIfEmpty(value types.String) types.String {
+	if value.ValueString() == "" {
+		return types.StringNull()
+	}
+	return value
+}
@@ -0,0 +1,71 @@
+package credential
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type CredentialResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	ProjectID      types.Int64    `tfsdk:"project_id"`
+	CredentialID   types.Int64    `tfsdk:"credential_id"`
+	AdapterVersion types.String   `tfsdk:"adapter_version"`
+	Fields         types.Map      `tfsdk:"fields"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m *CredentialResourceModel) fieldValues(ctx context.Context) (map[string]string, diag.Diagnostics) {
+	values := map[string]string{}
+	if m.Fields.IsNull() || m.Fields.IsUnknown() {
+		return values, nil
+	}
+	diags := m.Fields.ElementsAs(ctx, &values, false)
+	return values, diags
+}
+
+// setFromCredential updates the model with the credential returned by the API
+// the encrypted fields are not returned and keep the value of the model, the fields not configured are not added
+// except on import, when all the unencrypted fields of the adapter are added
+func (m *CredentialResourceModel) setFromCredential(
+	ctx context.Context,
+	credential dbt_cloud.AdapterCredential,
+) diag.Diagnostics {
+	m.ID = ID(credential.ProjectID, *credential.ID)
+	m.ProjectID = types.Int64Value(int64(credential.ProjectID))
+	m.CredentialID = types.Int64Value(int64(*credential.ID))
+	if credential.AdapterVersion != "" {
+		m.AdapterVersion = types.StringValue(credential.AdapterVersion)
+	}
+
+	importing := m.Fields.IsNull()
+	values, diags := m.fieldValues(ctx)
+	if diags.HasError() {
+		return diags
+	}
+
+	adapterFields := map[string]dbt_cloud.AdapterCredentialField{}
+	if details, err := dbt_cloud.AdapterCredentialSchema(m.AdapterVersion.ValueString()); err == nil {
+		adapterFields = details.Fields
+	}
+
+	for key, value := range credential.UnencryptedCredentialDetails {
+		field, isAdapterField := adapterFields[key]
+		_, isConfigured := values[key]
+		if value == nil || field.Metadata.Encrypt {
+			continue
+		}
+		if isConfigured || (importing && isAdapterField && value != "") {
+			values[key] = fmt.Sprint(value)
+		}
+	}
+
+	fields, mapDiags := types.MapValueFrom(ctx, types.StringType, values)
+	diags.Append(mapDiags...)
+	m.Fields = fields
+	return diags
+}
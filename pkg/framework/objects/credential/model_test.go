@@ -0,0 +1,64 @@
+package credential
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCredentialResourceModelSetFromCredential(t *testing.T) {
+	ctx := context.Background()
+	credentialID := 20
+	credential := dbt_cloud.AdapterCredential{
+		ID:             &credentialID,
+		ProjectID:      10,
+		AdapterVersion: "athena_v0",
+		UnencryptedCredentialDetails: map[string]any{
+			"schema":  "analytics_v2",
+			"threads": float64(8),
+		},
+	}
+
+	// the encrypted fields configured are kept and the fields not configured are not added
+	configured, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{
+		"aws_access_key_id":     "key",
+		"aws_secret_access_key": "secret",
+		"schema":                "analytics",
+	})
+	state := CredentialResourceModel{AdapterVersion: types.StringValue("athena_v0"), Fields: configured}
+	if diags := state.setFromCredential(ctx, credential); diags.HasError() {
+		t.Fatal(diags)
+	}
+	values, _ := state.fieldValues(ctx)
+	expected := map[string]string{
+		"aws_access_key_id":     "key",
+		"aws_secret_access_key": "secret",
+		"schema":                "analytics_v2",
+	}
+	if len(values) != len(expected) {
+		t.Fatalf("expected the fields %v, got %v", expected, values)
+	}
+	for key, value := range expected {
+		if values[key] != value {
+			t.Errorf("expected the field %s to be %q, got %q", key, value, values[key])
+		}
+	}
+	if state.ID.ValueString() != "10:20" {
+		t.Errorf("expected the ID 10:20, got %s", state.ID)
+	}
+
+	// on import, all the unencrypted fields are added
+	imported := CredentialResourceModel{Fields: types.MapNull(types.StringType)}
+	if diags := imported.setFromCredential(ctx, credential); diags.HasError() {
+		t.Fatal(diags)
+	}
+	values, _ = imported.fieldValues(ctx)
+	if len(values) != 2 || values["schema"] != "analytics_v2" || values["threads"] != "8" {
+		t.Errorf("expected the schema and threads to be imported, got %v", values)
+	}
+	if imported.AdapterVersion.ValueString() != "athena_v0" {
+		t.Errorf("expected the adapter version athena_v0, got %s", imported.AdapterVersion)
+	}
+}
@@ -0,0 +1,203 @@
+package credential
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &credentialResource{}
+	_ resource.ResourceWithConfigure      = &credentialResource{}
+	_ resource.ResourceWithImportState    = &credentialResource{}
+	_ resource.ResourceWithValidateConfig = &credentialResource{}
+)
+
+func CredentialResource() resource.Resource {
+	return &credentialResource{
+		Base: Base[dbt_cloud.AdapterCredential]{TypeName: "credential"},
+	}
+}
+
+type credentialResource struct {
+	Base[dbt_cloud.AdapterCredential]
+}
+
+// ValidateConfig checks the fields against the credential details of the adapter at plan time
+func (r *credentialResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config CredentialResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the values coming from other resources are only checked when they are known
+	if config.AdapterVersion.IsUnknown() || config.AdapterVersion.IsNull() || config.Fields.IsUnknown() {
+		return
+	}
+	for _, value := range config.Fields.Elements() {
+		if value.IsUnknown() {
+			return
+		}
+	}
+
+	values, diags := config.fieldValues(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := dbt_cloud.GenerateAdapterCredentialDetails(config.AdapterVersion.ValueString(), values); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("fields"),
+			"Invalid credential fields",
+			err.Error(),
+		)
+	}
+}
+
+func (r *credentialResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state CredentialResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	credential := r.ReadCredential(ctx, state.ID, resp)
+	if credential == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromCredential(ctx, *credential)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *credentialResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan CredentialResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	values, diags := plan.fieldValues(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentialDetails, err := dbt_cloud.GenerateAdapterCredentialDetails(plan.AdapterVersion.ValueString(), values)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("fields"), "Invalid credential fields", err.Error())
+		return
+	}
+
+	credential, err := r.Client.CreateAdapterCredential(
+		ctx,
+		int(plan.ProjectID.ValueInt64()),
+		plan.AdapterVersion.ValueString(),
+		credentialDetails,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create credential",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = ID(credential.ProjectID, *credential.ID)
+	plan.CredentialID = types.Int64Value(int64(*credential.ID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *credentialResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state CredentialResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	projectID, credentialID, ok := r.SplitID(state.ID.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	planValues, diags := plan.fieldValues(ctx)
+	resp.Diagnostics.Append(diags...)
+	stateValues, diags := state.fieldValues(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	credentialDetails, err := dbt_cloud.GenerateAdapterCredentialDetails(plan.AdapterVersion.ValueString(), planValues)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("fields"), "Invalid credential fields", err.Error())
+		return
+	}
+
+	// like for the Databricks credentials of global connections, only the fields changed are sent
+	// the fields removed from the config are sent with their default value
+	for key := range credentialDetails.Fields {
+		planValue, inPlan := planValues[key]
+		stateValue, inState := stateValues[key]
+		if inPlan == inState && planValue == stateValue {
+			delete(credentialDetails.Fields, key)
+		}
+	}
+
+	if len(credentialDetails.Fields) > 0 {
+		client := r.Credentials()
+		_, err = client.Patch(ctx, projectID, credentialID, dbt_cloud.AdapterCredentialPatch{
+			ID:                credentialID,
+			CredentialDetails: credentialDetails,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to update credential",
+				"Error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
@@ -0,0 +1,163 @@
+package credential_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccDbtCloudCredentialResource(t *testing.T) {
+
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	schema := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	schema2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudCredentialResourceAthenaConfig(projectName, `
+    schema = "`+schema+`"
+`),
+				ExpectError: regexp.MustCompile(`the field "aws_access_key_id" is required`),
+			},
+			{
+				Config: testAccDbtCloudCredentialResourceAthenaConfig(projectName, `
+    aws_access_key_id = "key"
+    aws_secret_access_key = "secret"
+    schema = "`+schema+`"
+    threads = "many"
+`),
+				ExpectError: regexp.MustCompile(`the field "threads" needs to be a number`),
+			},
+			{
+				Config: testAccDbtCloudCredentialResourceAthenaConfig(projectName, `
+    aws_access_key_id = "key"
+    aws_secret_access_key = "secret"
+    schema = "`+schema+`"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudCredentialExists("dbtcloud_credential.test_credential"),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_credential.test_credential",
+						"adapter_version",
+						"athena_v0",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_credential.test_credential",
+						"fields.schema",
+						schema,
+					),
+				),
+			},
+			// MODIFY
+			{
+				Config: testAccDbtCloudCredentialResourceAthenaConfig(projectName, `
+    aws_access_key_id = "key"
+    aws_secret_access_key = "secret"
+    schema = "`+schema2+`"
+    threads = "8"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudCredentialExists("dbtcloud_credential.test_credential"),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_credential.test_credential",
+						"fields.schema",
+						schema2,
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_credential.test_credential",
+						"fields.threads",
+						"8",
+					),
+				),
+			},
+			// IMPORT
+			{
+				ResourceName:            "dbtcloud_credential.test_credential",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"fields"},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudCredentialResourceAthenaConfig(projectName string, fields string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name        = "%s"
+}
+resource "dbtcloud_credential" "test_credential" {
+  project_id = dbtcloud_project.test_project.id
+  adapter_version = "athena_v0"
+  fields = {%s}
+}
+`, projectName, fields)
+}
+
+func testAccCheckDbtCloudCredentialExists(resource string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		rs, ok := state.RootModule().Resources[resource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resource)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+
+		projectId, credentialId, err := helper.SplitIDToInts(rs.Primary.ID, "dbtcloud_credential")
+		if err != nil {
+			return err
+		}
+
+		apiClient, err := acctest_helper.SharedClient()
+		if err != nil {
+			return fmt.Errorf("Issue getting the client")
+		}
+		_, err = apiClient.GetCredential(context.Background(), projectId, credentialId)
+		if err != nil {
+			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDbtCloudCredentialDestroy(s *terraform.State) error {
+	apiClient, err := acctest_helper.SharedClient()
+	if err != nil {
+		return fmt.Errorf("Issue getting the client")
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "dbtcloud_credential" {
+			continue
+		}
+		projectId, credentialId, err := helper.SplitIDToInts(rs.Primary.ID, "dbtcloud_credential")
+		if err != nil {
+			return err
+		}
+
+		_, err = apiClient.GetCredential(context.Background(), projectId, credentialId)
+		if err == nil {
+			return fmt.Errorf("Credential still exists")
+		}
+		notFoundErr := "resource-not-found"
+		expectedErr := regexp.MustCompile(notFoundErr)
+		if !expectedErr.Match([]byte(err.Error())) {
+			return fmt.Errorf("expected %s, got %s", notFoundErr, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package credential
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+func (r *credentialResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the credentials of the environments of a project for any adapter of the global connections, " +
+			"including the ones without a dedicated credential resource like Athena, Starburst, Synapse, Spark and Redshift. " +
+			"The fields are validated against the credential details of the adapter.",
+		Attributes: lo.Assign(
+			CommonAttributes("adapter"),
+			map[string]schema.Attribute{
+				"adapter_version": schema.StringAttribute{
+					Required:    true,
+					Description: "The adapter version of the global connection of the credential, e.g. `athena_v0`",
+					Validators: []validator.String{
+						stringvalidator.OneOf(dbt_cloud.AdapterCredentialVersions()...),
+					},
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+				"fields": schema.MapAttribute{
+					ElementType: types.StringType,
+					Required:    true,
+					Sensitive:   true,
+					Description: "The fields of the credential details of the adapter, e.g. `schema` or `threads`. " +
+						"The values are converted to the type of their field, the numbers and booleans are provided as strings. " +
+						"The encrypted fields, like tokens and passwords, are not returned by the API and are only read from the config",
+				},
+			},
+		),
+		Blocks: map[string]schema.Block{
+			"timeouts": helper.TimeoutsBlock(ctx),
+		},
+	}
+}
@@ -0,0 +1,38 @@
+package databricks_credential
+
+import (
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/credential"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DatabricksCredentialResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	ProjectID    types.Int64    `tfsdk:"project_id"`
+	CredentialID types.Int64    `tfsdk:"credential_id"`
+	AdapterID    types.Int64    `tfsdk:"adapter_id"`
+	TargetName   types.String   `tfsdk:"target_name"`
+	Token        types.String   `tfsdk:"token"`
+	Catalog      types.String   `tfsdk:"catalog"`
+	Schema       types.String   `tfsdk:"schema"`
+	AdapterType  types.String   `tfsdk:"adapter_type"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+// isLegacy returns true for the credentials of the connections created with the legacy connection resource
+func (m *DatabricksCredentialResourceModel) isLegacy() bool {
+	return m.AdapterID.ValueInt64() != 0
+}
+
+// setFromCredential updates the model with the credential returned by the API
+// the token and the adapter type are not returned and keep the value of the model
+func (m *DatabricksCredentialResourceModel) setFromCredential(databricksCredential dbt_cloud.DatabricksCredential) {
+	m.ID = credential.ID(databricksCredential.Project_Id, *databricksCredential.ID)
+	m.ProjectID = types.Int64Value(int64(databricksCredential.Project_Id))
+	m.CredentialID = types.Int64Value(int64(*databricksCredential.ID))
+	m.AdapterID = types.Int64Value(int64(databricksCredential.Adapter_Id))
+	m.TargetName = types.StringValue(databricksCredential.Target_Name)
+	m.Catalog = types.StringValue(databricksCredential.UnencryptedCredentialDetails.Catalog)
+	m.Schema = types.StringValue(databricksCredential.UnencryptedCredentialDetails.Schema)
+}
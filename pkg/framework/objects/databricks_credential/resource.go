@@ -0,0 +1,300 @@
+package databricks_credential
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/credential"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                 = &databricksCredentialResource{}
+	_ resource.ResourceWithConfigure    = &databricksCredentialResource{}
+	_ resource.ResourceWithImportState  = &databricksCredentialResource{}
+	_ resource.ResourceWithUpgradeState = &databricksCredentialResource{}
+)
+
+func DatabricksCredentialResource() resource.Resource {
+	return &databricksCredentialResource{
+		Base: credential.Base[dbt_cloud.DatabricksCredential]{TypeName: "databricks_credential"},
+	}
+}
+
+type databricksCredentialResource struct {
+	credential.Base[dbt_cloud.DatabricksCredential]
+}
+
+func (r *databricksCredentialResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state DatabricksCredentialResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	databricksCredential := r.ReadCredential(ctx, state.ID, resp)
+	if databricksCredential == nil {
+		return
+	}
+
+	state.setFromCredential(*databricksCredential)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *databricksCredentialResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan DatabricksCredentialResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	projectID := int(plan.ProjectID.ValueInt64())
+
+	if plan.isLegacy() {
+		databricksCredential, err := r.Client.CreateDatabricksCredentialLegacy(
+			ctx,
+			projectID,
+			"adapter",
+			plan.TargetName.ValueString(),
+			int(plan.AdapterID.ValueInt64()),
+			plan.Token.ValueString(),
+			plan.Catalog.ValueString(),
+			plan.Schema.ValueString(),
+			plan.AdapterType.ValueString(),
+		)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to create Databricks credential",
+				"Error: "+err.Error(),
+			)
+			return
+		}
+		plan.ID = credential.ID(databricksCredential.Project_Id, *databricksCredential.ID)
+		plan.CredentialID = types.Int64Value(int64(*databricksCredential.ID))
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	// for now, just supporting databricks
+	if plan.AdapterType.ValueString() == "spark" {
+		resp.Diagnostics.AddError(
+			"Unsupported adapter type",
+			"Spark adapter is not supported currently for global connections credentials. Please raise a GitHub issue if you need it",
+		)
+		return
+	}
+
+	databricksCredential, err := r.Client.CreateDatabricksCredential(
+		ctx,
+		projectID,
+		plan.Token.ValueString(),
+		plan.Schema.ValueString(),
+		plan.TargetName.ValueString(),
+		plan.Catalog.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create Databricks credential",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = credential.ID(databricksCredential.ProjectID, *databricksCredential.ID)
+	plan.CredentialID = types.Int64Value(int64(*databricksCredential.ID))
+	plan.AdapterID = types.Int64Value(0)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *databricksCredentialResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state DatabricksCredentialResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	projectID, credentialID, ok := r.SplitID(state.ID.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	var err error
+	if state.isLegacy() {
+		err = r.updateLegacy(ctx, projectID, credentialID, plan, state)
+	} else {
+		err = r.updateGlobalConnection(ctx, projectID, credentialID, plan, state)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update Databricks credential",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// updateLegacy updates the credentials of the legacy connections, which require all their details
+// except the token for the databricks adapter, only sent when it changed
+func (r *databricksCredentialResource) updateLegacy(
+	ctx context.Context,
+	projectID int,
+	credentialID int,
+	plan DatabricksCredentialResourceModel,
+	state DatabricksCredentialResourceModel,
+) error {
+	client := r.Credentials()
+	databricksCredential, err := client.Get(ctx, projectID, credentialID)
+	if err != nil {
+		return err
+	}
+
+	databricksCredential.Adapter_Id = int(plan.AdapterID.ValueInt64())
+	databricksCredential.Target_Name = plan.TargetName.ValueString()
+
+	token := plan.Token.ValueString()
+	if plan.AdapterType.ValueString() == "databricks" && plan.Token.Equal(state.Token) {
+		token = ""
+	}
+	databricksCredential.Credential_Details = dbt_cloud.GenerateDatabricksCredentialDetailsLegacy(
+		plan.AdapterType.ValueString(),
+		token,
+		plan.Catalog.ValueString(),
+		plan.Schema.ValueString(),
+	)
+
+	_, err = client.Update(ctx, projectID, credentialID, *databricksCredential)
+	return err
+}
+
+// updateGlobalConnection patches the details of the credentials of the global connections that changed
+func (r *databricksCredentialResource) updateGlobalConnection(
+	ctx context.Context,
+	projectID int,
+	credentialID int,
+	plan DatabricksCredentialResourceModel,
+	state DatabricksCredentialResourceModel,
+) error {
+	changedFields := map[string]bool{
+		"token":       !plan.Token.Equal(state.Token),
+		"schema":      !plan.Schema.Equal(state.Schema),
+		"target_name": !plan.TargetName.Equal(state.TargetName),
+		"catalog":     !plan.Catalog.Equal(state.Catalog),
+	}
+
+	patchCredentialsDetails, err := dbt_cloud.GenerateDatabricksCredentialDetails(
+		plan.Token.ValueString(),
+		plan.Schema.ValueString(),
+		plan.TargetName.ValueString(),
+		plan.Catalog.ValueString(),
+	)
+	if err != nil {
+		return err
+	}
+
+	for key := range patchCredentialsDetails.Fields {
+		if !changedFields[key] {
+			delete(patchCredentialsDetails.Fields, key)
+		}
+	}
+	if len(patchCredentialsDetails.Fields) == 0 {
+		return nil
+	}
+
+	databricksPatch := dbt_cloud.DatabricksCredentialGLobConnPatch{
+		ID:                credentialID,
+		CredentialDetails: patchCredentialsDetails,
+	}
+
+	client := r.Credentials()
+	_, err = client.Patch(ctx, projectID, credentialID, databricksPatch)
+	return err
+}
+
+// Delete sets the credentials of the legacy connections to deleted, the ones of the global connections are deleted
+func (r *databricksCredentialResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state DatabricksCredentialResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.isLegacy() {
+		r.Base.Delete(ctx, req, resp)
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	projectID, credentialID, ok := r.SplitID(state.ID.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	client := r.Credentials()
+	databricksCredential, err := client.Get(ctx, projectID, credentialID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue getting Databricks credential",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	databricksCredential.State = dbt_cloud.STATE_DELETED
+	// those values don't mean anything for delete operation but they are required by the API
+	databricksCredential.Credential_Details = dbt_cloud.GenerateDatabricksCredentialDetailsLegacy(
+		"databricks",
+		"",
+		"NA",
+		"NA",
+	)
+
+	_, err = client.Update(ctx, projectID, credentialID, *databricksCredential)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete Databricks credential",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *databricksCredentialResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return credential.UpgradeStateV0[DatabricksCredentialResourceModel](ctx, r, nil)
+}
@@ -1,6 +1,7 @@
-package resources_test
+package databricks_credential_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -22,7 +23,7 @@ func TestAccDbtCloudDatabricksCredentialResourceLegacy(t *testing.T) {
 	token2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckDbtCloudDatabricksCredentialDestroy,
 		Steps: []resource.TestStep{
@@ -114,7 +115,7 @@ func TestAccDbtCloudDatabricksCredentialResourceGlobConn(t *testing.T) {
 	token2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckDbtCloudDatabricksCredentialDestroy,
 		Steps: []resource.TestStep{
@@ -231,7 +232,7 @@ func testAccCheckDbtCloudDatabricksCredentialExists(resource string) resource.Te
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		_, err = apiClient.GetDatabricksCredential(projectId, credentialId)
+		_, err = apiClient.GetDatabricksCredential(context.Background(), projectId, credentialId)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -257,7 +258,7 @@ func testAccCheckDbtCloudDatabricksCredentialDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = apiClient.GetDatabricksCredential(projectId, credentialId)
+		_, err = apiClient.GetDatabricksCredential(context.Background(), projectId, credentialId)
 		if err == nil {
 			return fmt.Errorf("Databricks credential still exists")
 		}
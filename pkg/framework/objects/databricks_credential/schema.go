@@ -0,0 +1,83 @@
+package databricks_credential
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/credential"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/samber/lo"
+)
+
+var adapterTypes = []string{
+	"databricks",
+	"spark",
+}
+
+func (r *databricksCredentialResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the credentials of the Databricks and Spark environments of a project",
+		// the SDKv2 resource was at version 0, the state is upgraded with UpgradeState
+		Version: 1,
+		Attributes: lo.Assign(
+			credential.CommonAttributes("Databricks"),
+			map[string]schema.Attribute{
+				"adapter_id": schema.Int64Attribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Databricks adapter ID for the credential (do not fill in when using global connections, only to be used for connections created with the legacy connection resource `dbtcloud_connection`)",
+					PlanModifiers: []planmodifier.Int64{
+						int64planmodifier.UseStateForUnknown(),
+						int64planmodifier.RequiresReplace(),
+					},
+				},
+				"target_name": schema.StringAttribute{
+					Optional:           true,
+					Computed:           true,
+					Default:            stringdefault.StaticString("default"),
+					Description:        "Target name",
+					DeprecationMessage: "This field is deprecated at the environment level (it was never possible to set it in the UI) and will be removed in a future release. Please remove it and set the target name at the job level or leverage environment variables.",
+				},
+				"token": schema.StringAttribute{
+					Required:    true,
+					Sensitive:   true,
+					Description: "Token for Databricks user",
+				},
+				"catalog": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString(""),
+					Description: "The catalog where to create models (only for the databricks adapter)",
+				},
+				"schema": schema.StringAttribute{
+					Required:    true,
+					Description: "The schema where to create models",
+				},
+				"adapter_type": schema.StringAttribute{
+					Required:    true,
+					Description: "The type of the adapter (databricks or spark)",
+					Validators: []validator.String{
+						stringvalidator.OneOf(adapterTypes...),
+					},
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.RequiresReplace(),
+					},
+				},
+			},
+		),
+		Blocks: map[string]schema.Block{
+			"timeouts": helper.TimeoutsBlock(ctx),
+		},
+	}
+}
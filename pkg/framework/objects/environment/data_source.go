@@ -5,9 +5,7 @@ import (
 	"fmt"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
-	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
@@ -41,6 +39,7 @@ func (d *environmentDataSource) Read(
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	environment, err := d.client.GetEnvironment(
+		ctx,
 		int(config.ProjectID.ValueInt64()),
 		int(config.EnvironmentID.ValueInt64()),
 	)
@@ -52,21 +51,10 @@ func (d *environmentDataSource) Read(
 		return
 	}
 
-	state := config
-
-	state.CredentialsID = types.Int64PointerValue(
-		helper.IntPointerToInt64Pointer(environment.Credential_Id),
-	)
-	state.Name = types.StringValue(environment.Name)
-	state.DbtVersion = types.StringValue(environment.Dbt_Version)
-	state.Type = types.StringValue(environment.Type)
-	state.UseCustomBranch = types.BoolValue(environment.Use_Custom_Branch)
-	state.CustomBranch = types.StringPointerValue(environment.Custom_Branch)
-	state.DeploymentType = types.StringPointerValue(environment.DeploymentType)
-	state.ExtendedAttributesID = types.Int64PointerValue(
-		helper.IntPointerToInt64Pointer(environment.ExtendedAttributesID),
-	)
-	state.EnableModelQueryHistory = types.BoolValue(environment.EnableModelQueryHistory)
+	state := NewEnvironmentDataSourceModel(*environment)
+	// the IDs are kept from the config
+	state.EnvironmentID = config.EnvironmentID
+	state.ProjectID = config.ProjectID
 
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -4,9 +4,7 @@ import (
 	"context"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
-	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var (
@@ -46,7 +44,7 @@ func (d *environmentsDataSources) Read(
 		projectID = int(config.ProjectID.ValueInt64())
 	}
 
-	environments, err := d.client.GetAllEnvironments(projectID)
+	environments, err := d.client.GetAllEnvironments(ctx, projectID)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -60,26 +58,7 @@ func (d *environmentsDataSources) Read(
 
 	allEnvs := []EnvironmentDataSourceModel{}
 	for _, environment := range environments {
-		currentEnv := EnvironmentDataSourceModel{}
-
-		currentEnv.EnvironmentID = types.Int64PointerValue(
-			helper.IntPointerToInt64Pointer(environment.ID),
-		)
-		currentEnv.ProjectID = types.Int64Value(int64(environment.Project_Id))
-
-		currentEnv.CredentialsID = types.Int64PointerValue(
-			helper.IntPointerToInt64Pointer(environment.Credential_Id),
-		)
-		currentEnv.Name = types.StringValue(environment.Name)
-		currentEnv.DbtVersion = types.StringValue(environment.Dbt_Version)
-		currentEnv.Type = types.StringValue(environment.Type)
-		currentEnv.UseCustomBranch = types.BoolValue(environment.Use_Custom_Branch)
-		currentEnv.CustomBranch = types.StringPointerValue(environment.Custom_Branch)
-		currentEnv.DeploymentType = types.StringPointerValue(environment.DeploymentType)
-		currentEnv.ExtendedAttributesID = types.Int64PointerValue(
-			helper.IntPointerToInt64Pointer(environment.ExtendedAttributesID),
-		)
-		currentEnv.EnableModelQueryHistory = types.BoolValue(environment.EnableModelQueryHistory)
+		currentEnv := NewEnvironmentDataSourceModel(environment)
 		allEnvs = append(allEnvs, currentEnv)
 	}
 	state.Environments = allEnvs
@@ -1,6 +1,13 @@
 package environment
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 type EnvironmentDataSourceModel struct {
 	EnvironmentID           types.Int64  `tfsdk:"environment_id"`
@@ -21,3 +28,73 @@ type EnvironmentsDataSourceModel struct {
 	ProjectID    types.Int64                  `tfsdk:"project_id"`
 	Environments []EnvironmentDataSourceModel `tfsdk:"environments"`
 }
+
+// EnvironmentResourceModel has the attributes of the data source, with the ID, is_active and the timeouts
+// credentials_id of the data source is credential_id in the resource
+type EnvironmentResourceModel struct {
+	ID                      types.String   `tfsdk:"id"`
+	EnvironmentID           types.Int64    `tfsdk:"environment_id"`
+	ProjectID               types.Int64    `tfsdk:"project_id"`
+	CredentialID            types.Int64    `tfsdk:"credential_id"`
+	Name                    types.String   `tfsdk:"name"`
+	DbtVersion              types.String   `tfsdk:"dbt_version"`
+	Type                    types.String   `tfsdk:"type"`
+	UseCustomBranch         types.Bool     `tfsdk:"use_custom_branch"`
+	CustomBranch            types.String   `tfsdk:"custom_branch"`
+	DeploymentType          types.String   `tfsdk:"deployment_type"`
+	ExtendedAttributesID    types.Int64    `tfsdk:"extended_attributes_id"`
+	ConnectionID            types.Int64    `tfsdk:"connection_id"`
+	EnableModelQueryHistory types.Bool     `tfsdk:"enable_model_query_history"`
+	IsActive                types.Bool     `tfsdk:"is_active"`
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+}
+
+// NewEnvironmentDataSourceModel converts an environment returned by the API
+// it is used by the data sources and by the resource, so that they return the same values
+func NewEnvironmentDataSourceModel(environment dbt_cloud.Environment) EnvironmentDataSourceModel {
+	return EnvironmentDataSourceModel{
+		EnvironmentID: types.Int64PointerValue(helper.IntPointerToInt64Pointer(environment.ID)),
+		ProjectID:     types.Int64Value(int64(environment.Project_Id)),
+		CredentialsID: types.Int64PointerValue(
+			helper.IntPointerToInt64Pointer(environment.Credential_Id),
+		),
+		Name:            types.StringValue(environment.Name),
+		DbtVersion:      types.StringValue(environment.Dbt_Version),
+		Type:            types.StringValue(environment.Type),
+		UseCustomBranch: types.BoolValue(environment.Use_Custom_Branch),
+		CustomBranch:    types.StringPointerValue(environment.Custom_Branch),
+		DeploymentType:  types.StringPointerValue(environment.DeploymentType),
+		ExtendedAttributesID: types.Int64PointerValue(
+			helper.IntPointerToInt64Pointer(environment.ExtendedAttributesID),
+		),
+		ConnectionID: types.Int64PointerValue(
+			helper.IntPointerToInt64Pointer(environment.ConnectionID),
+		),
+		EnableModelQueryHistory: types.BoolValue(environment.EnableModelQueryHistory),
+	}
+}
+
+// setFromEnvironment updates the resource model with the environment returned by the API
+// connection_id is only read when it is configured, the environments can also get their connection from the project
+func (m *EnvironmentResourceModel) setFromEnvironment(environment dbt_cloud.Environment) {
+	data := NewEnvironmentDataSourceModel(environment)
+
+	m.ID = types.StringValue(
+		fmt.Sprintf("%d%s%d", environment.Project_Id, dbt_cloud.ID_DELIMITER, *environment.ID),
+	)
+	m.EnvironmentID = data.EnvironmentID
+	m.ProjectID = data.ProjectID
+	m.CredentialID = data.CredentialsID
+	m.Name = data.Name
+	m.DbtVersion = data.DbtVersion
+	m.Type = data.Type
+	m.UseCustomBranch = data.UseCustomBranch
+	m.CustomBranch = types.StringValue(data.CustomBranch.ValueString())
+	m.DeploymentType = types.StringValue(data.DeploymentType.ValueString())
+	m.ExtendedAttributesID = data.ExtendedAttributesID
+	if !m.ConnectionID.IsNull() {
+		m.ConnectionID = data.ConnectionID
+	}
+	m.EnableModelQueryHistory = data.EnableModelQueryHistory
+	m.IsActive = types.BoolValue(environment.State == dbt_cloud.STATE_ACTIVE)
+}
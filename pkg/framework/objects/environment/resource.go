@@ -0,0 +1,346 @@
+package environment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                 = &environmentResource{}
+	_ resource.ResourceWithConfigure    = &environmentResource{}
+	_ resource.ResourceWithImportState  = &environmentResource{}
+	_ resource.ResourceWithUpgradeState = &environmentResource{}
+)
+
+func EnvironmentResource() resource.Resource {
+	return &environmentResource{}
+}
+
+type environmentResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *environmentResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_environment"
+}
+
+func (r *environmentResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state EnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	projectID, environmentID, err := helper.SplitIDToInts(state.ID.ValueString(), "dbtcloud_environment")
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing the environment ID", err.Error())
+		return
+	}
+
+	environment, err := r.client.GetEnvironment(ctx, projectID, environmentID)
+	if err != nil {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			resp.Diagnostics.AddWarning(
+				"Resource not found",
+				"The environment was not found and has been removed from the state.",
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		helper.AddReadError(&resp.Diagnostics, "Error getting the environment", err)
+		return
+	}
+
+	state.setFromEnvironment(*environment)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *environmentResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan EnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	environment, err := r.client.CreateEnvironment(
+		ctx,
+		plan.IsActive.ValueBool(),
+		int(plan.ProjectID.ValueInt64()),
+		plan.Name.ValueString(),
+		plan.DbtVersion.ValueString(),
+		plan.Type.ValueString(),
+		plan.UseCustomBranch.ValueBool(),
+		plan.CustomBranch.ValueString(),
+		int(plan.CredentialID.ValueInt64()),
+		plan.DeploymentType.ValueString(),
+		int(plan.ExtendedAttributesID.ValueInt64()),
+		int(plan.ConnectionID.ValueInt64()),
+		plan.EnableModelQueryHistory.ValueBool(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create environment",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(
+		fmt.Sprintf("%d%s%d", environment.Project_Id, dbt_cloud.ID_DELIMITER, *environment.ID),
+	)
+	plan.EnvironmentID = types.Int64Value(int64(*environment.ID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *environmentResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state EnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	projectID, environmentID, err := helper.SplitIDToInts(state.ID.ValueString(), "dbtcloud_environment")
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing the environment ID", err.Error())
+		return
+	}
+
+	environment, err := r.client.GetEnvironment(ctx, projectID, environmentID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue getting Environment",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	// only the fields changed in the config are updated, the others keep the value returned by the API
+	if !plan.Name.Equal(state.Name) {
+		environment.Name = plan.Name.ValueString()
+	}
+	if !plan.DbtVersion.Equal(state.DbtVersion) {
+		environment.Dbt_Version = plan.DbtVersion.ValueString()
+	}
+	if !plan.CredentialID.Equal(state.CredentialID) {
+		environment.Credential_Id = optionalID(plan.CredentialID)
+	}
+	if !plan.ProjectID.Equal(state.ProjectID) {
+		environment.Project_Id = int(plan.ProjectID.ValueInt64())
+	}
+	if !plan.CustomBranch.Equal(state.CustomBranch) {
+		environment.Custom_Branch = plan.CustomBranch.ValueStringPointer()
+	}
+	if !plan.UseCustomBranch.Equal(state.UseCustomBranch) {
+		environment.Use_Custom_Branch = plan.UseCustomBranch.ValueBool()
+	}
+	if !plan.DeploymentType.Equal(state.DeploymentType) {
+		environment.DeploymentType = nil
+		if plan.DeploymentType.ValueString() != "" {
+			environment.DeploymentType = plan.DeploymentType.ValueStringPointer()
+		}
+	}
+	if !plan.ExtendedAttributesID.Equal(state.ExtendedAttributesID) {
+		environment.ExtendedAttributesID = optionalID(plan.ExtendedAttributesID)
+	}
+	if !plan.ConnectionID.Equal(state.ConnectionID) {
+		environment.ConnectionID = optionalID(plan.ConnectionID)
+	}
+	if !plan.EnableModelQueryHistory.Equal(state.EnableModelQueryHistory) {
+		environment.EnableModelQueryHistory = plan.EnableModelQueryHistory.ValueBool()
+	}
+
+	_, err = r.client.UpdateEnvironment(ctx, projectID, environmentID, *environment)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update environment",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *environmentResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state EnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	projectID, environmentID, err := helper.SplitIDToInts(state.ID.ValueString(), "dbtcloud_environment")
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing the environment ID", err.Error())
+		return
+	}
+
+	_, err = r.client.DeleteEnvironment(ctx, projectID, environmentID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete environment",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *environmentResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	environmentID, err := r.client.ResolveEnvironmentImportID(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error finding the environment to import", err.Error())
+		return
+	}
+
+	if _, _, err := helper.SplitIDToInts(environmentID, "dbtcloud_environment"); err != nil {
+		resp.Diagnostics.AddError("Error parsing environment ID for import", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), environmentID)...)
+}
+
+func (r *environmentResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	// the attributes didn't change from the SDKv2 resource, only the way the missing values are saved
+	schemaV0 := schemaResp.Schema
+	schemaV0.Version = 0
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradeEnvironmentStateV0,
+		},
+	}
+}
+
+// upgradeEnvironmentStateV0 converts the state of the SDKv2 resource
+// the SDKv2 saved the IDs not set as 0, they are saved as null to match the configs not setting them
+func upgradeEnvironmentStateV0(
+	ctx context.Context,
+	req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse,
+) {
+	var state EnvironmentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.CredentialID = nullIfZero(state.CredentialID)
+	state.ExtendedAttributesID = nullIfZero(state.ExtendedAttributesID)
+	state.ConnectionID = nullIfZero(state.ConnectionID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *environmentResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
+
+// equivalentDbtVersion keeps the dbt_version of the state when the config uses the other name of the same version
+// dbt Cloud accepts both versionless and latest for environments always on the latest version of dbt
+func equivalentDbtVersion() planmodifier.String {
+	return equivalentDbtVersionModifier{}
+}
+
+type equivalentDbtVersionModifier struct{}
+
+func (m equivalentDbtVersionModifier) Description(_ context.Context) string {
+	return "The values versionless and latest are considered equal."
+}
+
+func (m equivalentDbtVersionModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m equivalentDbtVersionModifier) PlanModifyString(
+	_ context.Context,
+	req planmodifier.StringRequest,
+	resp *planmodifier.StringResponse,
+) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	versionlessValues := map[string]bool{"versionless": true, "latest": true}
+	if versionlessValues[req.StateValue.ValueString()] && versionlessValues[req.PlanValue.ValueString()] {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// optionalID returns nil when the ID is not set, for the API to remove it
+func optionalID(id types.Int64) *int {
+	if id.ValueInt64() == 0 {
+		return nil
+	}
+	optionalID := int(id.ValueInt64())
+	return &optionalID
+}
+
+func nullIfZero(value types.Int64) types.Int64 {
+	if value.ValueInt64() == 0 {
+		return types.Int64Null()
+	}
+	return value
+}
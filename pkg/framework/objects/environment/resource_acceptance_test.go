@@ -1,6 +1,7 @@
-package resources_test
+package environment_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -11,6 +12,7 @@ import (
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -23,7 +25,7 @@ func TestAccDbtCloudEnvironmentResourceNoConnection(t *testing.T) {
 	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckDbtCloudEnvironmentDestroy,
 		Steps: []resource.TestStep{
@@ -102,10 +104,9 @@ func TestAccDbtCloudEnvironmentResourceNoConnection(t *testing.T) {
 						"deployment_type",
 						"production",
 					),
-					resource.TestCheckResourceAttr(
+					resource.TestCheckNoResourceAttr(
 						"dbtcloud_environment.test_env",
 						"connection_id",
-						"0",
 					),
 				),
 			},
@@ -143,10 +144,24 @@ func TestAccDbtCloudEnvironmentResourceNoConnection(t *testing.T) {
 						"dbtcloud_environment.test_env",
 						"credential_id",
 					),
-					resource.TestCheckResourceAttr(
+					resource.TestCheckNoResourceAttr(
 						"dbtcloud_environment.test_env",
 						"connection_id",
-						"0",
+					),
+				),
+			},
+			// MODIFY REMOVING CRED
+			{
+				Config: testAccDbtCloudEnvironmentResourceNoConnectionBasicConfig(
+					projectName,
+					environmentName2,
+					dbtVersionLatest,
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudEnvironmentExists("dbtcloud_environment.test_env"),
+					resource.TestCheckNoResourceAttr(
+						"dbtcloud_environment.test_env",
+						"credential_id",
 					),
 				),
 			},
@@ -204,7 +219,52 @@ resource "dbtcloud_bigquery_credential" "test_credential" {
 	num_threads = 16
   }
   
-`, projectName, environmentName, DBT_CLOUD_VERSION, customBranch, useCustomBranch)
+`, projectName, environmentName, acctest_helper.DBT_CLOUD_VERSION, customBranch, useCustomBranch)
+}
+
+func TestAccDbtCloudEnvironmentResourceUpgradeFromSDKv2(t *testing.T) {
+
+	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	config := testAccDbtCloudEnvironmentResourceNoConnectionModifiedConfig(
+		projectName,
+		environmentName,
+		"main",
+		"true",
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest_helper.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckDbtCloudEnvironmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"dbtcloud": {
+						Source:            "dbt-labs/dbtcloud",
+						VersionConstraint: "0.3.22",
+					},
+				},
+				Config: config,
+			},
+			{
+				ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+				Config:                   config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudEnvironmentExists("dbtcloud_environment.test_env"),
+					resource.TestCheckNoResourceAttr(
+						"dbtcloud_environment.test_env",
+						"connection_id",
+					),
+				),
+			},
+		},
+	})
 }
 
 // testing for the global connection use case where connection_id is added at the env level
@@ -215,7 +275,7 @@ func TestAccDbtCloudEnvironmentResourceConnection(t *testing.T) {
 	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckDbtCloudEnvironmentDestroy,
 		Steps: []resource.TestStep{
@@ -438,7 +498,7 @@ func TestAccDbtCloudEnvironmentResourceVersionless(t *testing.T) {
 	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckDbtCloudEnvironmentDestroy,
 		Steps: []resource.TestStep{
@@ -484,7 +544,7 @@ func testAccCheckDbtCloudEnvironmentExists(resource string) resource.TestCheckFu
 			return fmt.Errorf("Can't get environmentId")
 		}
 
-		_, err = apiClient.GetEnvironment(projectId, environmentId)
+		_, err = apiClient.GetEnvironment(context.Background(), projectId, environmentId)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -511,7 +571,7 @@ func testAccCheckDbtCloudEnvironmentDestroy(s *terraform.State) error {
 		if err != nil {
 			return fmt.Errorf("Can't get environmentId")
 		}
-		_, err = apiClient.GetEnvironment(projectId, environmentId)
+		_, err = apiClient.GetEnvironment(context.Background(), projectId, environmentId)
 		if err == nil {
 			return fmt.Errorf("Environment still exists")
 		}
@@ -0,0 +1,75 @@
+package environment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/testutil"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// a state saved by the SDKv2 version of the resource, with the zero values of the attributes not configured
+const environmentStateV0 = `{
+	"id": "10:20",
+	"is_active": true,
+	"project_id": 10,
+	"credential_id": 30,
+	"name": "Prod",
+	"dbt_version": "latest",
+	"type": "deployment",
+	"use_custom_branch": false,
+	"custom_branch": "",
+	"deployment_type": "production",
+	"environment_id": 20,
+	"extended_attributes_id": 0,
+	"connection_id": 0,
+	"enable_model_query_history": false,
+	"timeouts": null
+}`
+
+func TestEnvironmentResourceUpgradeStateV0(t *testing.T) {
+	state := testutil.UpgradeState[EnvironmentResourceModel](t, &environmentResource{}, 0, environmentStateV0)
+
+	if state.ID.ValueString() != "10:20" || state.EnvironmentID.ValueInt64() != 20 {
+		t.Errorf("expected the ID 10:20 and the environment 20, got %s and %s", state.ID, state.EnvironmentID)
+	}
+	if !state.ExtendedAttributesID.IsNull() || !state.ConnectionID.IsNull() {
+		t.Errorf(
+			"expected the IDs not set to be null, got extended_attributes_id %s and connection_id %s",
+			state.ExtendedAttributesID,
+			state.ConnectionID,
+		)
+	}
+	if state.CredentialID.ValueInt64() != 30 {
+		t.Errorf("expected the credential 30, got %s", state.CredentialID)
+	}
+	if state.CustomBranch.IsNull() || state.CustomBranch.ValueString() != "" {
+		t.Errorf("expected custom_branch to be kept as an empty string, got %s", state.CustomBranch)
+	}
+}
+
+func TestEquivalentDbtVersion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		state    types.String
+		plan     types.String
+		expected types.String
+	}{
+		{state: types.StringValue("latest"), plan: types.StringValue("versionless"), expected: types.StringValue("latest")},
+		{state: types.StringValue("versionless"), plan: types.StringValue("latest"), expected: types.StringValue("versionless")},
+		{state: types.StringValue("latest"), plan: types.StringValue("1.7.0-latest"), expected: types.StringValue("1.7.0-latest")},
+		{state: types.StringNull(), plan: types.StringValue("versionless"), expected: types.StringValue("versionless")},
+	}
+
+	for _, tc := range testCases {
+		req := planmodifier.StringRequest{StateValue: tc.state, PlanValue: tc.plan}
+		resp := planmodifier.StringResponse{PlanValue: tc.plan}
+		equivalentDbtVersion().PlanModifyString(context.Background(), req, &resp)
+
+		if !resp.PlanValue.Equal(tc.expected) {
+			t.Errorf("expected %s for the state %s and the plan %s, got %s", tc.expected, tc.state, tc.plan, resp.PlanValue)
+		}
+	}
+}
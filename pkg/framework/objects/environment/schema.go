@@ -3,8 +3,18 @@ package environment
 import (
 	"context"
 
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
 func (r *environmentDataSource) Schema(
@@ -12,54 +22,54 @@ func (r *environmentDataSource) Schema(
 	_ datasource.SchemaRequest,
 	resp *datasource.SchemaResponse,
 ) {
-	resp.Schema = schema.Schema{
+	resp.Schema = datasource_schema.Schema{
 		Description: "Retrieve data for a single environment",
-		Attributes: map[string]schema.Attribute{
-			"environment_id": schema.Int64Attribute{
+		Attributes: map[string]datasource_schema.Attribute{
+			"environment_id": datasource_schema.Int64Attribute{
 				Required:    true,
 				Description: "The ID of the environment",
 			},
-			"project_id": schema.Int64Attribute{
+			"project_id": datasource_schema.Int64Attribute{
 				Required:    true,
 				Description: "The project ID to which the environment belong",
 			},
-			"credentials_id": schema.Int64Attribute{
+			"credentials_id": datasource_schema.Int64Attribute{
 				Computed:    true,
 				Description: "The project ID to which the environment belong",
 			},
-			"name": schema.StringAttribute{
+			"name": datasource_schema.StringAttribute{
 				Computed:    true,
 				Description: "The name of the environment",
 			},
-			"dbt_version": schema.StringAttribute{
+			"dbt_version": datasource_schema.StringAttribute{
 				Computed:    true,
 				Description: "Version number of dbt to use in this environment.",
 			},
-			"type": schema.StringAttribute{
+			"type": datasource_schema.StringAttribute{
 				Computed:    true,
 				Description: "The type of environment (must be either development or deployment)",
 			},
-			"use_custom_branch": schema.BoolAttribute{
+			"use_custom_branch": datasource_schema.BoolAttribute{
 				Computed:    true,
 				Description: "Whether to use a custom git branch in this environment",
 			},
-			"custom_branch": schema.StringAttribute{
+			"custom_branch": datasource_schema.StringAttribute{
 				Computed:    true,
 				Description: "The custom branch name to use",
 			},
-			"deployment_type": schema.StringAttribute{
+			"deployment_type": datasource_schema.StringAttribute{
 				Computed:    true,
 				Description: "The type of deployment environment (currently 'production', 'staging' or empty)",
 			},
-			"extended_attributes_id": schema.Int64Attribute{
+			"extended_attributes_id": datasource_schema.Int64Attribute{
 				Computed:    true,
 				Description: "The ID of the extended attributes applied",
 			},
-			"connection_id": schema.Int64Attribute{
+			"connection_id": datasource_schema.Int64Attribute{
 				Computed:    true,
 				Description: "A connection ID (used with Global Connections)",
 			},
-			"enable_model_query_history": schema.BoolAttribute{
+			"enable_model_query_history": datasource_schema.BoolAttribute{
 				Computed:    true,
 				Description: "Whether model query history is on",
 			},
@@ -72,63 +82,63 @@ func (r *environmentsDataSources) Schema(
 	_ datasource.SchemaRequest,
 	resp *datasource.SchemaResponse,
 ) {
-	resp.Schema = schema.Schema{
+	resp.Schema = datasource_schema.Schema{
 		Description: "Retrieve data for multiple environments",
-		Attributes: map[string]schema.Attribute{
-			"project_id": schema.Int64Attribute{
+		Attributes: map[string]datasource_schema.Attribute{
+			"project_id": datasource_schema.Int64Attribute{
 				Optional:    true,
 				Description: "The project ID to filter the environments for [Optional]",
 			},
-			"environments": schema.SetNestedAttribute{
+			"environments": datasource_schema.SetNestedAttribute{
 				Description: "The list of environments",
 				Computed:    true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"environment_id": schema.Int64Attribute{
+				NestedObject: datasource_schema.NestedAttributeObject{
+					Attributes: map[string]datasource_schema.Attribute{
+						"environment_id": datasource_schema.Int64Attribute{
 							Computed:    true,
 							Description: "The ID of the environment",
 						},
-						"project_id": schema.Int64Attribute{
+						"project_id": datasource_schema.Int64Attribute{
 							Computed:    true,
 							Description: "The project ID to which the environment belong",
 						},
-						"credentials_id": schema.Int64Attribute{
+						"credentials_id": datasource_schema.Int64Attribute{
 							Computed:    true,
 							Description: "Credential ID to create the environment with. A credential is not required for development environments but is required for deployment environments",
 						},
-						"name": schema.StringAttribute{
+						"name": datasource_schema.StringAttribute{
 							Computed:    true,
 							Description: "The name of the environment",
 						},
-						"dbt_version": schema.StringAttribute{
+						"dbt_version": datasource_schema.StringAttribute{
 							Computed:    true,
 							Description: "Version number of dbt to use in this environment.",
 						},
-						"type": schema.StringAttribute{
+						"type": datasource_schema.StringAttribute{
 							Computed:    true,
 							Description: "The type of environment (must be either development or deployment)",
 						},
-						"use_custom_branch": schema.BoolAttribute{
+						"use_custom_branch": datasource_schema.BoolAttribute{
 							Computed:    true,
 							Description: "Whether to use a custom git branch in this environment",
 						},
-						"custom_branch": schema.StringAttribute{
+						"custom_branch": datasource_schema.StringAttribute{
 							Computed:    true,
 							Description: "The custom branch name to use",
 						},
-						"deployment_type": schema.StringAttribute{
+						"deployment_type": datasource_schema.StringAttribute{
 							Computed:    true,
 							Description: "The type of deployment environment (currently 'production', 'staging' or empty)",
 						},
-						"extended_attributes_id": schema.Int64Attribute{
+						"extended_attributes_id": datasource_schema.Int64Attribute{
 							Computed:    true,
 							Description: "The ID of the extended attributes applied",
 						},
-						"connection_id": schema.Int64Attribute{
+						"connection_id": datasource_schema.Int64Attribute{
 							Computed:    true,
 							Description: "A connection ID (used with Global Connections)",
 						},
-						"enable_model_query_history": schema.BoolAttribute{
+						"enable_model_query_history": datasource_schema.BoolAttribute{
 							Computed:    true,
 							Description: "Whether model query history is on",
 						},
@@ -138,3 +148,117 @@ func (r *environmentsDataSources) Schema(
 		},
 	}
 }
+
+func (r *environmentResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resource_schema.Schema{
+		Description: helper.DocString(
+			`Resource to manage dbt Cloud environments for the different dbt Cloud projects.
+
+			In a given dbt Cloud project, one development environment can be defined and as many deployment environments as needed can be created.
+
+			~> In August 2024, dbt Cloud released the "global connection" feature, allowing connections to be defined at the account level and reused across environments and projects.
+			This version of the provider has the ~~~connection_id~~~ as an optional field but it is recommended to start setting it up in your projects. In future versions, this field will become mandatory.
+			`,
+		),
+		// the SDKv2 resource was at version 0, the state is upgraded with UpgradeState
+		Version: 1,
+		Attributes: map[string]resource_schema.Attribute{
+			"id": resource_schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the environment, in the format `project_id:environment_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"is_active": resource_schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether the environment is active",
+			},
+			"project_id": resource_schema.Int64Attribute{
+				Required:    true,
+				Description: "Project ID to create the environment in",
+			},
+			"credential_id": resource_schema.Int64Attribute{
+				Optional:    true,
+				Description: "Credential ID to create the environment with. A credential is not required for development environments but is required for deployment environments",
+			},
+			"name": resource_schema.StringAttribute{
+				Required:    true,
+				Description: "Environment name",
+			},
+			"dbt_version": resource_schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("latest"),
+				Description: "Version number of dbt to use in this environment. It needs to be in the format `major.minor.0-latest` (e.g. `1.5.0-latest`), `major.minor.0-pre`, `versionless`, or `latest`. While `versionless` is still supported, using `latest` is recommended. Defaults to `latest` if no version is provided",
+				PlanModifiers: []planmodifier.String{
+					equivalentDbtVersion(),
+				},
+			},
+			"type": resource_schema.StringAttribute{
+				Required:    true,
+				Description: "The type of environment (must be either development or deployment)",
+				Validators: []validator.String{
+					stringvalidator.OneOf("development", "deployment"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"use_custom_branch": resource_schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to use a custom git branch in this environment",
+			},
+			"custom_branch": resource_schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Which custom branch to use in this environment",
+			},
+			"deployment_type": resource_schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "The type of environment. Only valid for environments of type 'deployment' and for now can only be 'production', 'staging' or left empty for generic environments",
+			},
+			"environment_id": resource_schema.Int64Attribute{
+				Computed:    true,
+				Description: "Environment ID within the project",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"extended_attributes_id": resource_schema.Int64Attribute{
+				Optional:    true,
+				Description: "ID of the extended attributes for the environment",
+			},
+			"connection_id": resource_schema.Int64Attribute{
+				Optional: true,
+				Description: helper.DocString(
+					`The ID of the connection to use (can be the ~~~id~~~ of a ~~~dbtcloud_global_connection~~~ or the ~~~connection_id~~~ of a legacy connection). 
+					  - At the moment, it is optional and the environment will use the connection set in ~~~dbtcloud_project_connection~~~ if ~~~connection_id~~~ is not set in this resource
+					  - In future versions this field will become required, so it is recommended to set it from now on
+					  - When configuring this field, it needs to be configured for all the environments of the project
+					  - To avoid Terraform state issues, when using this field, the ~~~dbtcloud_project_connection~~~ resource should be removed from the project or you need to make sure that the ~~~connection_id~~~ is the same in ~~~dbtcloud_project_connection~~~ and in the ~~~connection_id~~~ of the Development environment of the project`,
+				),
+			},
+			"enable_model_query_history": resource_schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether to enable model query history in this environment. As of Oct 2024, works only for Snowflake and BigQuery.",
+			},
+		},
+		Blocks: map[string]resource_schema.Block{
+			"timeouts": helper.TimeoutsBlock(ctx),
+		},
+	}
+}
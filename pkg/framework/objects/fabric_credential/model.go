@@ -0,0 +1,52 @@
+package fabric_credential
+
+import (
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/credential"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type FabricCredentialResourceModel struct {
+	ID                  types.String   `tfsdk:"id"`
+	ProjectID           types.Int64    `tfsdk:"project_id"`
+	CredentialID        types.Int64    `tfsdk:"credential_id"`
+	AdapterID           types.Int64    `tfsdk:"adapter_id"`
+	User                types.String   `tfsdk:"user"`
+	Password            types.String   `tfsdk:"password"`
+	TenantID            types.String   `tfsdk:"tenant_id"`
+	ClientID            types.String   `tfsdk:"client_id"`
+	ClientSecret        types.String   `tfsdk:"client_secret"`
+	Schema              types.String   `tfsdk:"schema"`
+	SchemaAuthorization types.String   `tfsdk:"schema_authorization"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+// setFromCredential updates the model with the credential returned by the API
+// the password and the client secret are not returned and keep the value of the model
+func (m *FabricCredentialResourceModel) setFromCredential(fabricCredential dbt_cloud.FabricCredential) {
+	m.ID = credential.ID(fabricCredential.Project_Id, *fabricCredential.ID)
+	m.ProjectID = types.Int64Value(int64(fabricCredential.Project_Id))
+	m.CredentialID = types.Int64Value(int64(*fabricCredential.ID))
+	m.AdapterID = types.Int64Value(int64(fabricCredential.Adapter_Id))
+	m.User = types.StringValue(fabricCredential.UnencryptedCredentialDetails.User)
+	m.TenantID = types.StringValue(fabricCredential.UnencryptedCredentialDetails.TenantId)
+	m.ClientID = types.StringValue(fabricCredential.UnencryptedCredentialDetails.ClientId)
+	m.Schema = types.StringValue(fabricCredential.UnencryptedCredentialDetails.Schema)
+	m.SchemaAuthorization = types.StringValue(
+		fabricCredential.UnencryptedCredentialDetails.SchemaAuthorization,
+	)
+}
+
+// credentialDetails returns the details of the credential sent to the API
+func (m *FabricCredentialResourceModel) credentialDetails() (dbt_cloud.AdapterCredentialDetails, error) {
+	return dbt_cloud.GenerateFabricCredentialDetails(
+		m.User.ValueString(),
+		m.Password.ValueString(),
+		m.TenantID.ValueString(),
+		m.ClientID.ValueString(),
+		m.ClientSecret.ValueString(),
+		m.Schema.ValueString(),
+		m.SchemaAuthorization.ValueString(),
+	)
+}
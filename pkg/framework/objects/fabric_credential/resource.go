@@ -0,0 +1,255 @@
+package fabric_credential
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/credential"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &fabricCredentialResource{}
+	_ resource.ResourceWithConfigure      = &fabricCredentialResource{}
+	_ resource.ResourceWithImportState    = &fabricCredentialResource{}
+	_ resource.ResourceWithUpgradeState   = &fabricCredentialResource{}
+	_ resource.ResourceWithValidateConfig = &fabricCredentialResource{}
+)
+
+func FabricCredentialResource() resource.Resource {
+	return &fabricCredentialResource{
+		Base: credential.Base[dbt_cloud.FabricCredential]{TypeName: "fabric_credential"},
+	}
+}
+
+type fabricCredentialResource struct {
+	credential.Base[dbt_cloud.FabricCredential]
+}
+
+// ValidateConfig checks that the credential uses either a user/password or a service principal
+func (r *fabricCredentialResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config FabricCredentialResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authValues := []types.String{
+		config.User,
+		config.Password,
+		config.TenantID,
+		config.ClientID,
+		config.ClientSecret,
+	}
+	for _, value := range authValues {
+		// the values coming from other resources are only checked when they are known
+		if value.IsUnknown() {
+			return
+		}
+	}
+
+	userPasswordDefined := config.User.ValueString() != "" && config.Password.ValueString() != ""
+	servicePrincipalDefined := config.TenantID.ValueString() != "" &&
+		config.ClientID.ValueString() != "" &&
+		config.ClientSecret.ValueString() != ""
+
+	if !userPasswordDefined && !servicePrincipalDefined {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user"),
+			"Missing authentication",
+			"either user/password or service principal auth must be defined",
+		)
+	}
+}
+
+func (r *fabricCredentialResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state FabricCredentialResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	fabricCredential := r.ReadCredential(ctx, state.ID, resp)
+	if fabricCredential == nil {
+		return
+	}
+
+	state.setFromCredential(*fabricCredential)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *fabricCredentialResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan FabricCredentialResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	fabricCredential, err := r.Client.CreateFabricCredential(
+		ctx,
+		int(plan.ProjectID.ValueInt64()),
+		int(plan.AdapterID.ValueInt64()),
+		plan.User.ValueString(),
+		plan.Password.ValueString(),
+		plan.TenantID.ValueString(),
+		plan.ClientID.ValueString(),
+		plan.ClientSecret.ValueString(),
+		plan.Schema.ValueString(),
+		plan.SchemaAuthorization.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create Fabric credential",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = credential.ID(fabricCredential.Project_Id, *fabricCredential.ID)
+	plan.CredentialID = types.Int64Value(int64(*fabricCredential.ID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *fabricCredentialResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state FabricCredentialResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	projectID, credentialID, ok := r.SplitID(state.ID.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	client := r.Credentials()
+	fabricCredential, err := client.Get(ctx, projectID, credentialID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue getting Fabric credential",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	// the API requires all the details of the credential, including the secrets
+	fabricCredential.Adapter_Id = int(plan.AdapterID.ValueInt64())
+	fabricCredential.CredentialDetails, err = plan.credentialDetails()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to generate the Fabric credential details",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = client.Update(ctx, projectID, credentialID, *fabricCredential)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update Fabric credential",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete sets the credential to deleted, the Fabric credentials are not deleted with the DELETE endpoint
+func (r *fabricCredentialResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state FabricCredentialResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	projectID, credentialID, ok := r.SplitID(state.ID.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	client := r.Credentials()
+	fabricCredential, err := client.Get(ctx, projectID, credentialID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue getting Fabric credential",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	fabricCredential.State = dbt_cloud.STATE_DELETED
+
+	// these values don't mean anything for the delete operation but they are required by the API
+	fabricCredential.CredentialDetails, err = dbt_cloud.GenerateFabricCredentialDetails(
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+		"",
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to generate the Fabric credential details",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = client.Update(ctx, projectID, credentialID, *fabricCredential)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete Fabric credential",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *fabricCredentialResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return credential.UpgradeStateV0[FabricCredentialResourceModel](ctx, r, nil)
+}
@@ -1,6 +1,7 @@
-package resources_test
+package fabric_credential_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -23,7 +24,7 @@ func TestAccDbtCloudFabricCredentialResource(t *testing.T) {
 	clientSecret := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckDbtCloudFabricCredentialDestroy,
 		Steps: []resource.TestStep{
@@ -162,7 +163,7 @@ func testAccCheckDbtCloudFabricCredentialExists(resource string) resource.TestCh
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		_, err = apiClient.GetFabricCredential(projectId, credentialId)
+		_, err = apiClient.GetFabricCredential(context.Background(), projectId, credentialId)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -188,7 +189,7 @@ func testAccCheckDbtCloudFabricCredentialDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = apiClient.GetFabricCredential(projectId, credentialId)
+		_, err = apiClient.GetFabricCredential(context.Background(), projectId, credentialId)
 		if err == nil {
 			return fmt.Errorf("Fabric credential still exists")
 		}
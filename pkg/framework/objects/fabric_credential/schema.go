@@ -0,0 +1,106 @@
+package fabric_credential
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/credential"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/samber/lo"
+)
+
+func (r *fabricCredentialResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	userPasswordPaths := []path.Expression{
+		path.MatchRoot("user"),
+		path.MatchRoot("password"),
+	}
+	servicePrincipalPaths := []path.Expression{
+		path.MatchRoot("tenant_id"),
+		path.MatchRoot("client_id"),
+		path.MatchRoot("client_secret"),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manage the credentials of the Fabric environments of a project",
+		// the SDKv2 resource was at version 0, the state is upgraded with UpgradeState
+		Version: 1,
+		Attributes: lo.Assign(
+			credential.CommonAttributes("Fabric"),
+			map[string]schema.Attribute{
+				"adapter_id": schema.Int64Attribute{
+					Required:    true,
+					Description: "Fabric adapter ID for the credential",
+				},
+				"user": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString(""),
+					Description: "The username of the Fabric account to connect to. Only used when connection with AD user/pass",
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(servicePrincipalPaths...),
+					},
+				},
+				"password": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Sensitive:   true,
+					Default:     stringdefault.StaticString(""),
+					Description: "The password for the account to connect to. Only used when connection with AD user/pass",
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(servicePrincipalPaths...),
+					},
+				},
+				"tenant_id": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString(""),
+					Description: "The tenant ID of the Azure Active Directory instance. This is only used when connecting to Azure SQL with a service principal.",
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(userPasswordPaths...),
+					},
+				},
+				"client_id": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString(""),
+					Description: "The client ID of the Azure Active Directory service principal. This is only used when connecting to Azure SQL with an AAD service principal.",
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(userPasswordPaths...),
+					},
+				},
+				"client_secret": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Sensitive:   true,
+					Default:     stringdefault.StaticString(""),
+					Description: "The client secret of the Azure Active Directory service principal. This is only used when connecting to Azure SQL with an AAD service principal.",
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(userPasswordPaths...),
+					},
+				},
+				"schema": schema.StringAttribute{
+					Required:    true,
+					Description: "The schema where to create the dbt models",
+				},
+				"schema_authorization": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString(""),
+					Description: "Optionally set this to the principal who should own the schemas created by dbt",
+				},
+			},
+		),
+		Blocks: map[string]schema.Block{
+			"timeouts": helper.TimeoutsBlock(ctx),
+		},
+	}
+}
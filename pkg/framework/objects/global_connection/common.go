@@ -1,6 +1,8 @@
 package global_connection
 
 import (
+	"context"
+	"errors"
 	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
@@ -9,6 +11,7 @@ import (
 )
 
 func readGeneric(
+	ctx context.Context,
 	client *dbt_cloud.Client,
 	state *GlobalConnectionResourceModel,
 	adapter string,
@@ -25,9 +28,10 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.SnowflakeConfig](client)
 
-		common, snowflakeCfg, err := c.Get(connectionID)
+		common, snowflakeCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			var notFoundErr *dbt_cloud.NotFoundError
+			if errors.As(err, &notFoundErr) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
@@ -80,9 +84,10 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.BigQueryConfig](client)
 
-		common, bigqueryCfg, err := c.Get(connectionID)
+		common, bigqueryCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			var notFoundErr *dbt_cloud.NotFoundError
+			if errors.As(err, &notFoundErr) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
@@ -209,9 +214,10 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.DatabricksConfig](client)
 
-		common, databricksCfg, err := c.Get(connectionID)
+		common, databricksCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			var notFoundErr *dbt_cloud.NotFoundError
+			if errors.As(err, &notFoundErr) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
@@ -257,15 +263,16 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.RedshiftConfig](client)
 
-		common, redshiftCfg, err := c.Get(connectionID)
+		common, redshiftCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			var notFoundErr *dbt_cloud.NotFoundError
+			if errors.As(err, &notFoundErr) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
 		}
 
-		sshTunnel, err := c.GetEncryptionsForConnection(connectionID)
+		sshTunnel, err := c.GetEncryptionsForConnection(ctx, connectionID)
 		if err != nil {
 			return nil, "", err
 		}
@@ -322,15 +329,16 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.PostgresConfig](client)
 
-		common, postgresCfg, err := c.Get(connectionID)
+		common, postgresCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			var notFoundErr *dbt_cloud.NotFoundError
+			if errors.As(err, &notFoundErr) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
 		}
 
-		sshTunnel, err := c.GetEncryptionsForConnection(connectionID)
+		sshTunnel, err := c.GetEncryptionsForConnection(ctx, connectionID)
 		if err != nil {
 			return nil, "", err
 		}
@@ -387,9 +395,10 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.FabricConfig](client)
 
-		common, fabricCfg, err := c.Get(connectionID)
+		common, fabricCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			var notFoundErr *dbt_cloud.NotFoundError
+			if errors.As(err, &notFoundErr) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
@@ -432,9 +441,10 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.SynapseConfig](client)
 
-		common, synapseCfg, err := c.Get(connectionID)
+		common, synapseCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			var notFoundErr *dbt_cloud.NotFoundError
+			if errors.As(err, &notFoundErr) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
@@ -477,9 +487,10 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.StarburstConfig](client)
 
-		common, starburstCfg, err := c.Get(connectionID)
+		common, starburstCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			var notFoundErr *dbt_cloud.NotFoundError
+			if errors.As(err, &notFoundErr) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
@@ -519,9 +530,10 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.AthenaConfig](client)
 
-		common, athenaCfg, err := c.Get(connectionID)
+		common, athenaCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			var notFoundErr *dbt_cloud.NotFoundError
+			if errors.As(err, &notFoundErr) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
@@ -614,9 +626,10 @@ func readGeneric(
 
 		c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.ApacheSparkConfig](client)
 
-		common, sparkCfg, err := c.Get(connectionID)
+		common, sparkCfg, err := c.Get(ctx, connectionID)
 		if err != nil {
-			if strings.HasPrefix(err.Error(), "resource-not-found") {
+			var notFoundErr *dbt_cloud.NotFoundError
+			if errors.As(err, &notFoundErr) {
 				return nil, "removeFromState", nil
 			}
 			return nil, "", err
@@ -33,19 +33,21 @@ func (d *globalConnectionDataSource) Read(
 	req datasource.ReadRequest,
 	resp *datasource.ReadResponse,
 ) {
-	var state GlobalConnectionResourceModel
+	var config GlobalConnectionDataSourceModel
 
-	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
-	connectionID := state.ID.ValueInt64()
+	connectionID := config.ID.ValueInt64()
+	state := GlobalConnectionResourceModel{ID: config.ID}
 
-	globalConnectionResponse, err := d.client.GetGlobalConnectionAdapter(connectionID)
+	globalConnectionResponse, err := d.client.GetGlobalConnectionAdapter(ctx, connectionID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting the connection type", err.Error())
 		return
 	}
 
 	newState, action, err := readGeneric(
+		ctx,
 		d.client,
 		&state,
 		globalConnectionResponse.Data.AdapterVersion,
@@ -64,7 +66,7 @@ func (d *globalConnectionDataSource) Read(
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, newState.dataSourceModel())...)
 }
 
 func (d *globalConnectionDataSource) Configure(
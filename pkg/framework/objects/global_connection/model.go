@@ -1,6 +1,7 @@
 package global_connection
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/samber/lo"
 )
@@ -131,6 +132,50 @@ type GlobalConnectionResourceModel struct {
 	StarburstConfig       *StarburstConfig   `tfsdk:"starburst"`
 	AthenaConfig          *AthenaConfig      `tfsdk:"athena"`
 	ApacheSparkConfig     *ApacheSparkConfig `tfsdk:"apache_spark"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// GlobalConnectionDataSourceModel is the model of the data source, the same as the resource without the timeouts
+type GlobalConnectionDataSourceModel struct {
+	ID                    types.Int64        `tfsdk:"id"`
+	AdapterVersion        types.String       `tfsdk:"adapter_version"`
+	Name                  types.String       `tfsdk:"name"`
+	IsSshTunnelEnabled    types.Bool         `tfsdk:"is_ssh_tunnel_enabled"` //TODO: check if we can deprecate this
+	PrivateLinkEndpointId types.String       `tfsdk:"private_link_endpoint_id"`
+	OauthConfigurationId  types.Int64        `tfsdk:"oauth_configuration_id"`
+	SnowflakeConfig       *SnowflakeConfig   `tfsdk:"snowflake"`
+	BigQueryConfig        *BigQueryConfig    `tfsdk:"bigquery"`
+	DatabricksConfig      *DatabricksConfig  `tfsdk:"databricks"`
+	RedshiftConfig        *RedshiftConfig    `tfsdk:"redshift"`
+	PostgresConfig        *PostgresConfig    `tfsdk:"postgres"`
+	FabricConfig          *FabricConfig      `tfsdk:"fabric"`
+	SynapseConfig         *SynapseConfig     `tfsdk:"synapse"`
+	StarburstConfig       *StarburstConfig   `tfsdk:"starburst"`
+	AthenaConfig          *AthenaConfig      `tfsdk:"athena"`
+	ApacheSparkConfig     *ApacheSparkConfig `tfsdk:"apache_spark"`
+}
+
+// dataSourceModel returns the connection read with readGeneric as a data source model
+func (m GlobalConnectionResourceModel) dataSourceModel() GlobalConnectionDataSourceModel {
+	return GlobalConnectionDataSourceModel{
+		ID:                    m.ID,
+		AdapterVersion:        m.AdapterVersion,
+		Name:                  m.Name,
+		IsSshTunnelEnabled:    m.IsSshTunnelEnabled,
+		PrivateLinkEndpointId: m.PrivateLinkEndpointId,
+		OauthConfigurationId:  m.OauthConfigurationId,
+		SnowflakeConfig:       m.SnowflakeConfig,
+		BigQueryConfig:        m.BigQueryConfig,
+		DatabricksConfig:      m.DatabricksConfig,
+		RedshiftConfig:        m.RedshiftConfig,
+		PostgresConfig:        m.PostgresConfig,
+		FabricConfig:          m.FabricConfig,
+		SynapseConfig:         m.SynapseConfig,
+		StarburstConfig:       m.StarburstConfig,
+		AthenaConfig:          m.AthenaConfig,
+		ApacheSparkConfig:     m.ApacheSparkConfig,
+	}
 }
 
 type SSHTunnelConfig struct {
@@ -102,9 +102,12 @@ func (r *globalConnectionResource) Read(
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
-	newState, action, err := readGeneric(r.client, &state, "")
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	newState, action, err := readGeneric(ctx, r.client, &state, "")
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading the connection", err.Error())
+		helper.AddReadError(&resp.Diagnostics, "Error reading the connection", err)
 		return
 	}
 
@@ -133,6 +136,9 @@ func (r *globalConnectionResource) Create(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	commonCfg := dbt_cloud.GlobalConnectionCommon{
 		Name: plan.Name.ValueStringPointer(),
 	}
@@ -166,7 +172,7 @@ func (r *globalConnectionResource) Create(
 			snowflakeCfg.Role.Set(plan.SnowflakeConfig.Role.ValueString())
 		}
 
-		commonResp, _, err := c.Create(commonCfg, snowflakeCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, snowflakeCfg)
 
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
@@ -245,7 +251,7 @@ func (r *globalConnectionResource) Create(
 			)
 		}
 
-		commonResp, _, err := c.Create(commonCfg, bigqueryCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, bigqueryCfg)
 
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
@@ -277,7 +283,7 @@ func (r *globalConnectionResource) Create(
 			databricksCfg.ClientSecret.Set(plan.DatabricksConfig.ClientSecret.ValueString())
 		}
 
-		commonResp, _, err := c.Create(commonCfg, databricksCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, databricksCfg)
 
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
@@ -303,7 +309,7 @@ func (r *globalConnectionResource) Create(
 			redshiftCfg.DBName.Set(plan.RedshiftConfig.DBName.ValueString())
 		}
 
-		commonResp, _, err := c.Create(commonCfg, redshiftCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, redshiftCfg)
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
 			return
@@ -318,7 +324,7 @@ func (r *globalConnectionResource) Create(
 				Port:         plan.RedshiftConfig.SSHTunnel.Port.ValueInt64(),
 				HostName:     plan.RedshiftConfig.SSHTunnel.HostName.ValueString(),
 			}
-			sshTunnel, err := c.CreateUpdateEncryption(sshTunnelPayload)
+			sshTunnel, err := c.CreateUpdateEncryption(ctx, sshTunnelPayload)
 
 			if err != nil {
 				resp.Diagnostics.AddError("Error creating the SSH Tunnel", err.Error())
@@ -356,7 +362,7 @@ func (r *globalConnectionResource) Create(
 			postgresCfg.DBName.SetNull()
 		}
 
-		commonResp, _, err := c.Create(commonCfg, postgresCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, postgresCfg)
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
 			return
@@ -371,7 +377,7 @@ func (r *globalConnectionResource) Create(
 				Port:         plan.PostgresConfig.SSHTunnel.Port.ValueInt64(),
 				HostName:     plan.PostgresConfig.SSHTunnel.HostName.ValueString(),
 			}
-			sshTunnel, err := c.CreateUpdateEncryption(sshTunnelPayload)
+			sshTunnel, err := c.CreateUpdateEncryption(ctx, sshTunnelPayload)
 
 			if err != nil {
 				resp.Diagnostics.AddError("Error creating the SSH Tunnel", err.Error())
@@ -409,7 +415,7 @@ func (r *globalConnectionResource) Create(
 		// nullable fields
 		// N/A for Fabric
 
-		commonResp, _, err := c.Create(commonCfg, fabricCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, fabricCfg)
 
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
@@ -438,7 +444,7 @@ func (r *globalConnectionResource) Create(
 		// nullable fields
 		// N/A for Synapse
 
-		commonResp, _, err := c.Create(commonCfg, synapseCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, synapseCfg)
 
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
@@ -463,7 +469,7 @@ func (r *globalConnectionResource) Create(
 		// nullable fields
 		// N/A for Starburst
 
-		commonResp, _, err := c.Create(commonCfg, starburstCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, starburstCfg)
 
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
@@ -514,7 +520,7 @@ func (r *globalConnectionResource) Create(
 			athenaCfg.NumIcebergRetries.Set(plan.AthenaConfig.NumIcebergRetries.ValueInt64())
 		}
 
-		commonResp, _, err := c.Create(commonCfg, athenaCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, athenaCfg)
 
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
@@ -557,7 +563,7 @@ func (r *globalConnectionResource) Create(
 			sparkCfg.Auth.SetNull()
 		}
 
-		commonResp, _, err := c.Create(commonCfg, sparkCfg)
+		commonResp, _, err := c.Create(ctx, commonCfg, sparkCfg)
 
 		if err != nil {
 			resp.Diagnostics.AddError("Error creating the connection", err.Error())
@@ -588,9 +594,12 @@ func (r *globalConnectionResource) Delete(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	connectionID := state.ID.ValueInt64()
 
-	_, err := r.client.DeleteGlobalConnection(connectionID)
+	_, err := r.client.DeleteGlobalConnection(ctx, connectionID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting the connection", err.Error())
 		return
@@ -616,7 +625,7 @@ func (r *globalConnectionResource) Delete(
 			// we use Redshift here but it is the same function for all
 			// we could change the function to use a generic client rather than a global connection client
 			c := dbt_cloud.NewGlobalConnectionClient[dbt_cloud.RedshiftConfig](r.client)
-			_, err := c.CreateUpdateEncryption(sshTunnelPayload)
+			_, err := c.CreateUpdateEncryption(ctx, sshTunnelPayload)
 			if err != nil {
 				resp.Diagnostics.AddError("Error deleting the SSH Tunnel", err.Error())
 				return
@@ -639,6 +648,9 @@ func (r *globalConnectionResource) Update(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	globalConfigChanges := dbt_cloud.GlobalConnectionCommon{}
 
 	if plan.Name != state.Name {
@@ -701,6 +713,7 @@ func (r *globalConnectionResource) Update(
 		}
 
 		updateCommon, _, err := c.Update(
+			ctx,
 			state.ID.ValueInt64(),
 			globalConfigChanges,
 			warehouseConfigChanges,
@@ -857,6 +870,7 @@ func (r *globalConnectionResource) Update(
 		}
 
 		updateCommon, _, err := c.Update(
+			ctx,
 			state.ID.ValueInt64(),
 			globalConfigChanges,
 			warehouseConfigChanges,
@@ -909,6 +923,7 @@ func (r *globalConnectionResource) Update(
 		}
 
 		updateCommon, _, err := c.Update(
+			ctx,
 			state.ID.ValueInt64(),
 			globalConfigChanges,
 			warehouseConfigChanges,
@@ -952,6 +967,7 @@ func (r *globalConnectionResource) Update(
 
 		if warehouseConfigChanged {
 			updateCommon, _, err := c.Update(
+				ctx,
 				state.ID.ValueInt64(),
 				globalConfigChanges,
 				warehouseConfigChanges,
@@ -972,6 +988,7 @@ func (r *globalConnectionResource) Update(
 
 		// SSH tunnel settings
 		sshTunnel, err := r.handleSSHTunnelUpdates(
+			ctx,
 			plan.RedshiftConfig.SSHTunnel,
 			state.RedshiftConfig.SSHTunnel,
 			int64(r.client.AccountID),
@@ -1013,6 +1030,7 @@ func (r *globalConnectionResource) Update(
 
 		if warehouseConfigChanged {
 			updateCommon, _, err := c.Update(
+				ctx,
 				state.ID.ValueInt64(),
 				globalConfigChanges,
 				warehouseConfigChanges,
@@ -1033,6 +1051,7 @@ func (r *globalConnectionResource) Update(
 
 		// SSH tunnel settings
 		sshTunnel, err := r.handleSSHTunnelUpdates(
+			ctx,
 			plan.PostgresConfig.SSHTunnel,
 			state.PostgresConfig.SSHTunnel,
 			int64(r.client.AccountID),
@@ -1074,6 +1093,7 @@ func (r *globalConnectionResource) Update(
 		// N/A for Fabric
 
 		updateCommon, _, err := c.Update(
+			ctx,
 			state.ID.ValueInt64(),
 			globalConfigChanges,
 			warehouseConfigChanges,
@@ -1117,6 +1137,7 @@ func (r *globalConnectionResource) Update(
 		// N/A for Synapse
 
 		updateCommon, _, err := c.Update(
+			ctx,
 			state.ID.ValueInt64(),
 			globalConfigChanges,
 			warehouseConfigChanges,
@@ -1151,6 +1172,7 @@ func (r *globalConnectionResource) Update(
 		// N/A for Starburst
 
 		updateCommon, _, err := c.Update(
+			ctx,
 			state.ID.ValueInt64(),
 			globalConfigChanges,
 			warehouseConfigChanges,
@@ -1248,6 +1270,7 @@ func (r *globalConnectionResource) Update(
 		}
 
 		updateCommon, _, err := c.Update(
+			ctx,
 			state.ID.ValueInt64(),
 			globalConfigChanges,
 			warehouseConfigChanges,
@@ -1312,6 +1335,7 @@ func (r *globalConnectionResource) Update(
 		}
 
 		updateCommon, _, err := c.Update(
+			ctx,
 			state.ID.ValueInt64(),
 			globalConfigChanges,
 			warehouseConfigChanges,
@@ -1339,7 +1363,13 @@ func (r *globalConnectionResource) ImportState(
 	req resource.ImportStateRequest,
 	resp *resource.ImportStateResponse,
 ) {
-	connectionID, err := strconv.Atoi(req.ID)
+	importID, err := r.client.ResolveGlobalConnectionImportID(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error finding the connection to import", err.Error())
+		return
+	}
+
+	connectionID, err := strconv.Atoi(importID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing the connection ID",
@@ -1348,7 +1378,7 @@ func (r *globalConnectionResource) ImportState(
 		return
 	}
 
-	globalConnectionResponse, err := r.client.GetGlobalConnectionAdapter(int64(connectionID))
+	globalConnectionResponse, err := r.client.GetGlobalConnectionAdapter(ctx, int64(connectionID))
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting the connection type", err.Error())
 		return
@@ -1390,6 +1420,7 @@ func (r *globalConnectionResource) Configure(
 }
 
 func (r *globalConnectionResource) handleSSHTunnelUpdates(
+	ctx context.Context,
 	sshTunnelPlan *SSHTunnelConfig,
 	sshTunnelState *SSHTunnelConfig,
 	accountID int64,
@@ -1408,7 +1439,7 @@ func (r *globalConnectionResource) handleSSHTunnelUpdates(
 			HostName:     sshTunnelState.HostName.ValueString(),
 			State:        dbt_cloud.STATE_DELETED,
 		}
-		_, err := c.CreateUpdateEncryption(sshTunnelPayload)
+		_, err := c.CreateUpdateEncryption(ctx, sshTunnelPayload)
 		if err != nil {
 			return nil, err
 		}
@@ -1421,7 +1452,7 @@ func (r *globalConnectionResource) handleSSHTunnelUpdates(
 			Port:         sshTunnelPlan.Port.ValueInt64(),
 			HostName:     sshTunnelPlan.HostName.ValueString(),
 		}
-		sshTunnel, err := c.CreateUpdateEncryption(sshPayload)
+		sshTunnel, err := c.CreateUpdateEncryption(ctx, sshPayload)
 		if err != nil {
 			return nil, err
 		}
@@ -1443,7 +1474,7 @@ func (r *globalConnectionResource) handleSSHTunnelUpdates(
 			Port:         sshTunnelPlan.Port.ValueInt64(),
 			HostName:     sshTunnelPlan.HostName.ValueString(),
 		}
-		sshTunnel, err := c.CreateUpdateEncryption(sshPayload)
+		sshTunnel, err := c.CreateUpdateEncryption(ctx, sshPayload)
 		if err != nil {
 			return nil, err
 		}
@@ -21,7 +21,7 @@ import (
 )
 
 func (r *globalConnectionResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -560,6 +560,9 @@ func (r *globalConnectionResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]resource_schema.Block{
+			"timeouts": helper.TimeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -2,9 +2,10 @@ package group
 
 import (
 	"context"
-	"strings"
+	"errors"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -41,10 +42,11 @@ func (d *groupDataSource) Read(
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	groupID := data.GroupID.ValueInt64()
-	retrievedGroup, err := d.client.GetGroup(int(groupID))
+	retrievedGroup, err := d.client.GetGroup(ctx, int(groupID))
 
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			resp.Diagnostics.AddWarning(
 				"Resource not found",
 				"The group was not found and has been removed from the state.",
@@ -52,7 +54,7 @@ func (d *groupDataSource) Read(
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error getting the group", err.Error())
+		helper.AddReadError(&resp.Diagnostics, "Error getting the group", err)
 		return
 	}
 
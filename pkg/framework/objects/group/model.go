@@ -5,6 +5,7 @@ import (
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/samber/lo"
 )
@@ -15,6 +16,8 @@ type GroupResourceModel struct {
 	AssignByDefault  types.Bool        `tfsdk:"assign_by_default"`
 	SSOMappingGroups types.Set         `tfsdk:"sso_mapping_groups"`
 	GroupPermissions []GroupPermission `tfsdk:"group_permissions"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // we need a different one just because historically the data source uses `group_id` instead of `id`
@@ -2,8 +2,8 @@ package group
 
 import (
 	"context"
+	"errors"
 	"strconv"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
@@ -43,11 +43,15 @@ func (r *groupResource) Read(
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	groupID := state.ID.ValueInt64()
-	retrievedGroup, err := r.client.GetGroup(int(groupID))
+	retrievedGroup, err := r.client.GetGroup(ctx, int(groupID))
 
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			resp.Diagnostics.AddWarning(
 				"Resource not found",
 				"The group was not found and has been removed from the state.",
@@ -55,7 +59,7 @@ func (r *groupResource) Read(
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error getting the group", err.Error())
+		helper.AddReadError(&resp.Diagnostics, "Error getting the group", err)
 		return
 	}
 
@@ -95,6 +99,9 @@ func (r *groupResource) Create(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	name := plan.Name.ValueString()
 	assignByDefault := plan.AssignByDefault.ValueBool()
 	var ssoMappingGroups []string
@@ -103,7 +110,7 @@ func (r *groupResource) Create(
 		return
 	}
 
-	createdGroup, err := r.client.CreateGroup(name, assignByDefault, ssoMappingGroups)
+	createdGroup, err := r.client.CreateGroup(ctx, name, assignByDefault, ssoMappingGroups)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create group",
@@ -118,7 +125,7 @@ func (r *groupResource) Create(
 		createdGroup.AccountID,
 	)
 
-	_, err = r.client.UpdateGroupPermissions(*createdGroup.ID, groupPermissions)
+	_, err = r.client.UpdateGroupPermissions(ctx, *createdGroup.ID, groupPermissions)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to assign permissions to the group",
@@ -142,8 +149,11 @@ func (r *groupResource) Delete(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	groupID := int(state.ID.ValueInt64())
-	retrievedGroup, err := r.client.GetGroup(groupID)
+	retrievedGroup, err := r.client.GetGroup(ctx, groupID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Issue getting Group",
@@ -151,7 +161,7 @@ func (r *groupResource) Delete(
 		)
 	}
 	retrievedGroup.State = dbt_cloud.STATE_DELETED
-	_, err = r.client.UpdateGroup(groupID, *retrievedGroup)
+	_, err = r.client.UpdateGroup(ctx, groupID, *retrievedGroup)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to delete group",
@@ -179,8 +189,11 @@ func (r *groupResource) Update(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	groupID := int(state.ID.ValueInt64())
-	retrievedGroup, err := r.client.GetGroup(groupID)
+	retrievedGroup, err := r.client.GetGroup(ctx, groupID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Issue getting Group",
@@ -213,7 +226,7 @@ func (r *groupResource) Update(
 		retrievedGroup.AssignByDefault = planAssignByDefault
 		retrievedGroup.SSOMappingGroups = planSsoMappingGroups
 
-		_, err = r.client.UpdateGroup(groupID, *retrievedGroup)
+		_, err = r.client.UpdateGroup(ctx, groupID, *retrievedGroup)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to update group",
@@ -240,7 +253,7 @@ func (r *groupResource) Update(
 			retrievedGroup.AccountID,
 		)
 
-		_, err = r.client.UpdateGroupPermissions(groupID, groupPermissions)
+		_, err = r.client.UpdateGroupPermissions(ctx, groupID, groupPermissions)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to update group permissions",
@@ -258,8 +271,13 @@ func (r *groupResource) ImportState(
 	resp *resource.ImportStateResponse,
 ) {
 
+	groupIDStr, err := r.client.ResolveGroupImportID(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error finding the group to import", err.Error())
+		return
+	}
+
 	// I think we need this conversion because the ID is a string
-	groupIDStr := req.ID
 	groupID, err := strconv.Atoi(groupIDStr)
 	if err != nil {
 		resp.Diagnostics.AddError("Error parsing group ID for import", err.Error())
@@ -272,6 +290,7 @@ func (r *groupResource) ImportState(
 	state := GroupResourceModel{
 		ID:               types.Int64Value(int64(groupID)),
 		SSOMappingGroups: ssoSetVal,
+		Timeouts:         helper.NullTimeouts(ctx),
 	}
 
 	diags := resp.State.Set(ctx, &state)
@@ -19,7 +19,7 @@ import (
 )
 
 func (r *groupResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -131,6 +131,7 @@ func (r *groupResource) Schema(
 					},
 				},
 			},
+			"timeouts": helper.TimeoutsBlock(ctx),
 		},
 	}
 }
@@ -2,8 +2,8 @@ package group_partial_permissions
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/group"
@@ -44,11 +44,15 @@ func (r *groupPartialPermissionsResource) Read(
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// check if the ID exists
 	groupIDFromState := state.ID.ValueInt64()
-	retrievedGroup, err := r.client.GetGroup(int(groupIDFromState))
+	retrievedGroup, err := r.client.GetGroup(ctx, int(groupIDFromState))
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			resp.Diagnostics.AddWarning(
 				"Resource not found",
 				"The notification resource was not found and has been removed from the state.",
@@ -56,17 +60,21 @@ func (r *groupPartialPermissionsResource) Read(
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(
-			"Issue getting Group",
-			"Error: "+err.Error(),
-		)
+		helper.AddReadError(&resp.Diagnostics, "Issue getting Group", err)
 		return
 	}
 
 	// if the ID exists, make sure that it is the one we are looking for
 	if retrievedGroup.Name != state.Name.ValueString() {
 		// it doesn't match, we need to find the correct one
-		groupIDs := r.client.GetAllGroupIDsByName(state.Name.ValueString())
+		groupIDs, err := r.client.GetAllGroupIDsByName(ctx, state.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Issue getting Groups",
+				"Error: "+err.Error(),
+			)
+			return
+		}
 		if len(groupIDs) > 1 {
 			resp.Diagnostics.AddError(
 				"More than one group with the same name",
@@ -84,7 +92,7 @@ func (r *groupPartialPermissionsResource) Read(
 		}
 
 		groupID := groupIDs[0]
-		retrievedGroup, err = r.client.GetGroup(groupID)
+		retrievedGroup, err = r.client.GetGroup(ctx, groupID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Issue getting Group",
@@ -141,6 +149,9 @@ func (r *groupPartialPermissionsResource) Create(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	name := plan.Name.ValueString()
 	assignByDefault := plan.AssignByDefault.ValueBool()
 	var ssoMappingGroups []string
@@ -150,7 +161,14 @@ func (r *groupPartialPermissionsResource) Create(
 	}
 
 	// check if it exists and if there is only one with the given name
-	groupIDs := r.client.GetAllGroupIDsByName(name)
+	groupIDs, err := r.client.GetAllGroupIDsByName(ctx, name)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue getting Groups",
+			"Error: "+err.Error(),
+		)
+		return
+	}
 	if len(groupIDs) > 1 {
 		resp.Diagnostics.AddError(
 			"More than one group with the same name",
@@ -165,7 +183,7 @@ func (r *groupPartialPermissionsResource) Create(
 		//   B. add the permission needed for the partial field
 		groupID := groupIDs[0]
 
-		retrievedGroup, err := r.client.GetGroup(groupID)
+		retrievedGroup, err := r.client.GetGroup(ctx, groupID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Issue getting Group",
@@ -183,7 +201,7 @@ func (r *groupPartialPermissionsResource) Create(
 			retrievedGroup.AssignByDefault = assignByDefault
 			retrievedGroup.SSOMappingGroups = ssoMappingGroups
 
-			r.client.UpdateGroup(groupID, *retrievedGroup)
+			r.client.UpdateGroup(ctx, groupID, *retrievedGroup)
 		}
 
 		// B. add the permissions that are missing
@@ -209,7 +227,7 @@ func (r *groupPartialPermissionsResource) Create(
 			retrievedGroup.AccountID,
 		)
 
-		_, err = r.client.UpdateGroupPermissions(*retrievedGroup.ID, allPermissionsRequest)
+		_, err = r.client.UpdateGroupPermissions(ctx, *retrievedGroup.ID, allPermissionsRequest)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to assign permissions to the group",
@@ -222,7 +240,7 @@ func (r *groupPartialPermissionsResource) Create(
 
 	} else {
 		// if the group with the name given doesn't exist , create it
-		createdGroup, err := r.client.CreateGroup(name, assignByDefault, ssoMappingGroups)
+		createdGroup, err := r.client.CreateGroup(ctx, name, assignByDefault, ssoMappingGroups)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to create group",
@@ -233,7 +251,7 @@ func (r *groupPartialPermissionsResource) Create(
 
 		groupPermissions := group.ConvertGroupPermissionModelToData(plan.GroupPermissions, *createdGroup.ID, createdGroup.AccountID)
 
-		_, err = r.client.UpdateGroupPermissions(*createdGroup.ID, groupPermissions)
+		_, err = r.client.UpdateGroupPermissions(ctx, *createdGroup.ID, groupPermissions)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to assign permissions to the group",
@@ -258,8 +276,11 @@ func (r *groupPartialPermissionsResource) Delete(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	groupID := int(state.ID.ValueInt64())
-	retrievedGroup, err := r.client.GetGroup(groupID)
+	retrievedGroup, err := r.client.GetGroup(ctx, groupID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Issue getting Group",
@@ -283,7 +304,7 @@ func (r *groupPartialPermissionsResource) Delete(
 			retrievedGroup.AccountID,
 		)
 
-		_, err = r.client.UpdateGroupPermissions(groupID, allPermissionsRequest)
+		_, err = r.client.UpdateGroupPermissions(ctx, groupID, allPermissionsRequest)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to assign permissions to the group",
@@ -295,7 +316,7 @@ func (r *groupPartialPermissionsResource) Delete(
 	} else {
 		// otherwise, we delete the group entirely if there is no permission
 		retrievedGroup.State = dbt_cloud.STATE_DELETED
-		_, err = r.client.UpdateGroup(groupID, *retrievedGroup)
+		_, err = r.client.UpdateGroup(ctx, groupID, *retrievedGroup)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to delete group",
@@ -318,8 +339,11 @@ func (r *groupPartialPermissionsResource) Update(
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	groupID := int(state.ID.ValueInt64())
-	retrievedGroup, err := r.client.GetGroup(groupID)
+	retrievedGroup, err := r.client.GetGroup(ctx, groupID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Issue getting Group",
@@ -352,7 +376,7 @@ func (r *groupPartialPermissionsResource) Update(
 		retrievedGroup.AssignByDefault = planAssignByDefault
 		retrievedGroup.SSOMappingGroups = planSsoMappingGroups
 
-		_, err = r.client.UpdateGroup(groupID, *retrievedGroup)
+		_, err = r.client.UpdateGroup(ctx, groupID, *retrievedGroup)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to update group",
@@ -404,7 +428,7 @@ func (r *groupPartialPermissionsResource) Update(
 			retrievedGroup.AccountID,
 		)
 
-		_, err = r.client.UpdateGroupPermissions(groupID, allPermissionsRequest)
+		_, err = r.client.UpdateGroupPermissions(ctx, groupID, allPermissionsRequest)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to assign permissions to the group",
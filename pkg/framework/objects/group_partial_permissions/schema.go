@@ -17,7 +17,7 @@ import (
 )
 
 func (r *groupPartialPermissionsResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -107,5 +107,8 @@ func (r *groupPartialPermissionsResource) Schema(
 				Optional: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helper.TimeoutsBlock(ctx),
+		},
 	}
 }
@@ -1,6 +1,7 @@
 package ip_restrictions_rule
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/samber/lo"
 )
@@ -12,6 +13,8 @@ type IPRestrictionsRuleResourceModel struct {
 	Description    types.String `tfsdk:"description"`
 	RuleSetEnabled types.Bool   `tfsdk:"rule_set_enabled"`
 	Cidrs          []CidrModel  `tfsdk:"cidrs"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type CidrModel struct {
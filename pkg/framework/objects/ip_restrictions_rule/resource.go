@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -57,7 +58,10 @@ func (r *ipRestrictionsRuleResource) Read(
 		return
 	}
 
-	rule, err := r.client.GetIPRestrictionsRule(state.ID.ValueInt64())
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	rule, err := r.client.GetIPRestrictionsRule(ctx, state.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading IP Restrictions Rule",
@@ -101,6 +105,9 @@ func (r *ipRestrictionsRuleResource) Create(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	ipRestriction := dbt_cloud.IPRestrictionsRule{
 		Name:           plan.Name.ValueString(),
 		Type:           ipRestrictionTypeNameToIDMapping[plan.Type.ValueString()],
@@ -118,7 +125,7 @@ func (r *ipRestrictionsRuleResource) Create(
 		})
 	}
 
-	created, err := r.client.CreateIPRestrictionsRule(ipRestriction)
+	created, err := r.client.CreateIPRestrictionsRule(ctx, ipRestriction)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating IP Restrictions Rule",
@@ -162,6 +169,9 @@ func (r *ipRestrictionsRuleResource) Update(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	ipRestrictionsRule := dbt_cloud.IPRestrictionsRule{
 		ID:             plan.ID.ValueInt64(),
 		Name:           plan.Name.ValueString(),
@@ -198,6 +208,7 @@ func (r *ipRestrictionsRuleResource) Update(
 	}
 
 	created, err := r.client.UpdateIPRestrictionsRule(
+		ctx,
 		strconv.FormatInt(plan.ID.ValueInt64(), 10),
 		ipRestrictionsRule,
 	)
@@ -235,7 +246,10 @@ func (r *ipRestrictionsRuleResource) Delete(
 		return
 	}
 
-	err := r.client.DeleteIPRestrictionsRule(state.ID.ValueInt64())
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	err := r.client.DeleteIPRestrictionsRule(ctx, state.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error deleting IP Restrictions Rule",
@@ -13,7 +13,7 @@ import (
 )
 
 func (r *ipRestrictionsRuleResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -77,5 +77,8 @@ func (r *ipRestrictionsRuleResource) Schema(
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helper.TimeoutsBlock(ctx),
+		},
 	}
 }
@@ -0,0 +1,123 @@
+package job
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource                   = &jobRunsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &jobRunsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &jobRunsDataSource{}
+)
+
+func JobRunsDataSource() datasource.DataSource {
+	return &jobRunsDataSource{}
+}
+
+type jobRunsDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *jobRunsDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_job_runs"
+}
+
+func (d *jobRunsDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config JobRunsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters := dbt_cloud.RunFilters{
+		JobDefinitionID: int(config.JobID.ValueInt64()),
+		EnvironmentID:   int(config.EnvironmentID.ValueInt64()),
+		ProjectID:       int(config.ProjectID.ValueInt64()),
+		Status:          dbt_cloud.RunStatuses[config.Status.ValueString()],
+		Limit:           defaultRunsLimit,
+	}
+	if !config.Limit.IsNull() {
+		filters.Limit = int(config.Limit.ValueInt64())
+	}
+	if !config.CreatedAfter.IsNull() {
+		createdAfter, err := time.Parse(time.RFC3339, config.CreatedAfter.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid created_after date", err.Error())
+			return
+		}
+		filters.CreatedAfter = createdAfter
+	}
+
+	apiRuns, err := d.client.GetAllRuns(ctx, filters)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue when retrieving runs",
+			err.Error(),
+		)
+		return
+	}
+
+	state := config
+	state.Runs = lo.Map(apiRuns, func(run dbt_cloud.Run, _ int) JobRunDataSourceModel {
+		var triggerCause *string
+		if run.Trigger != nil {
+			triggerCause = &run.Trigger.Cause
+		}
+
+		return JobRunDataSourceModel{
+			ID:            types.Int64PointerValue(helper.IntPointerToInt64Pointer(run.ID)),
+			JobID:         types.Int64Value(int64(run.JobDefinitionID)),
+			EnvironmentID: types.Int64Value(int64(run.EnvironmentID)),
+			ProjectID:     types.Int64Value(int64(run.ProjectID)),
+			Status:        types.StringValue(strings.ToLower(run.StatusHumanized)),
+			StatusMessage: types.StringPointerValue(run.StatusMessage),
+			GitBranch:     types.StringPointerValue(run.GitBranch),
+			GitSHA:        types.StringPointerValue(run.GitSHA),
+			TriggerCause:  types.StringPointerValue(triggerCause),
+			CreatedAt:     types.StringValue(run.CreatedAt),
+			StartedAt:     types.StringPointerValue(run.StartedAt),
+			FinishedAt:    types.StringPointerValue(run.FinishedAt),
+			Duration:      types.StringValue(run.Duration),
+			Href:          types.StringValue(run.Href),
+			Steps: lo.Map(run.RunSteps, func(step dbt_cloud.RunStep, _ int) JobRunStepModel {
+				return JobRunStepModel{
+					Index:    types.Int64Value(int64(step.Index)),
+					Name:     types.StringValue(step.Name),
+					Status:   types.StringValue(strings.ToLower(step.StatusHumanized)),
+					Duration: types.StringValue(step.Duration),
+				}
+			}),
+		}
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *jobRunsDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*dbt_cloud.Client)
+}
@@ -0,0 +1,79 @@
+package job_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDbtCloudJobRunsDataSource(t *testing.T) {
+
+	randomJobName := acctest.RandStringFromCharSet(5, acctest.CharSetAlphaNum)
+
+	// the job was just created, it doesn't have any run yet
+	check := resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrSet("data.dbtcloud_job_runs.test", "job_id"),
+		resource.TestCheckResourceAttr("data.dbtcloud_job_runs.test", "runs.#", "0"),
+		resource.TestCheckResourceAttr("data.dbtcloud_job_runs.test_filters", "status", "success"),
+		resource.TestCheckResourceAttr("data.dbtcloud_job_runs.test_filters", "limit", "5"),
+		resource.TestCheckResourceAttr("data.dbtcloud_job_runs.test_filters", "runs.#", "0"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: jobRuns(randomJobName, `created_after = "2024-06-01T00:00:00Z"`),
+				Check:  check,
+			},
+			{
+				Config:      jobRuns(randomJobName, `created_after = "2024-06-01"`),
+				ExpectError: regexp.MustCompile("RFC 3339"),
+			},
+		},
+	})
+}
+
+func jobRuns(jobName string, createdAfter string) string {
+	return fmt.Sprintf(`
+    resource "dbtcloud_project" "test_project" {
+        name = "job_runs_test_project"
+    }
+
+    resource "dbtcloud_environment" "test_environment" {
+        project_id = dbtcloud_project.test_project.id
+        name = "job_runs_test_env"
+        dbt_version = "%s"
+        type = "deployment"
+    }
+
+    resource "dbtcloud_job" "test_job" {
+        name = "%s"
+        project_id = dbtcloud_project.test_project.id
+        environment_id = dbtcloud_environment.test_environment.environment_id
+        execute_steps = [
+            "dbt run"
+        ]
+        triggers = {
+          "github_webhook" : false,
+          "schedule" : false,
+          "git_provider_webhook": false
+        }
+    }
+
+    data "dbtcloud_job_runs" "test" {
+        job_id = dbtcloud_job.test_job.id
+    }
+
+    data "dbtcloud_job_runs" "test_filters" {
+        environment_id = dbtcloud_environment.test_environment.environment_id
+        status = "success"
+        limit = 5
+        %s
+    }
+    `, acctest_helper.DBT_CLOUD_VERSION, jobName, createdAfter)
+}
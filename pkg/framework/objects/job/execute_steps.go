@@ -0,0 +1,405 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/samber/lo"
+)
+
+type flagKind int
+
+const (
+	// flagBool is a flag without value, e.g. --full-refresh, it can be negated with --no-
+	flagBool flagKind = iota
+	// flagValue is a flag taking one value, e.g. --threads 4 or --threads=4
+	flagValue
+	// flagValues is a flag taking one or more values, e.g. --select model_a model_b
+	flagValues
+)
+
+type dbtFlag struct {
+	kind flagKind
+	// validate checks the value of the flag, when set
+	validate func(value string) error
+}
+
+// flagSet are the flags accepted by a command, keyed by their long name without the leading --
+type flagSet map[string]dbtFlag
+
+func mergeFlags(sets ...flagSet) flagSet {
+	merged := flagSet{}
+	for _, set := range sets {
+		for name, flag := range set {
+			merged[name] = flag
+		}
+	}
+	return merged
+}
+
+func positiveNumber(value string) error {
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 1 {
+		return fmt.Errorf("needs to be a positive number, got %q", value)
+	}
+	return nil
+}
+
+func oneOf(values ...string) func(string) error {
+	return func(value string) error {
+		if !lo.Contains(values, value) {
+			return fmt.Errorf("needs to be one of %s, got %q", strings.Join(values, ", "), value)
+		}
+		return nil
+	}
+}
+
+// the flags of the dbt commands supported by dbt Cloud, grouped like in the dbt CLI
+var (
+	globalFlags = flagSet{
+		"debug":                      {kind: flagBool},
+		"quiet":                      {kind: flagBool},
+		"print":                      {kind: flagBool},
+		"use-colors":                 {kind: flagBool},
+		"use-colors-file":            {kind: flagBool},
+		"warn-error":                 {kind: flagBool},
+		"warn-error-options":         {kind: flagValue},
+		"partial-parse":              {kind: flagBool},
+		"static-parser":              {kind: flagBool},
+		"use-experimental-parser":    {kind: flagBool},
+		"version-check":              {kind: flagBool},
+		"write-json":                 {kind: flagBool},
+		"cache-selected-only":        {kind: flagBool},
+		"populate-cache":             {kind: flagBool},
+		"introspect":                 {kind: flagBool},
+		"send-anonymous-usage-stats": {kind: flagBool},
+		"printer-width":              {kind: flagValue, validate: positiveNumber},
+		"record-timing-info":         {kind: flagValue},
+		"log-format":                 {kind: flagValue, validate: oneOf("text", "debug", "json", "default")},
+		"log-format-file":            {kind: flagValue, validate: oneOf("text", "debug", "json", "default")},
+		"log-level":                  {kind: flagValue, validate: oneOf("debug", "info", "warn", "error", "none")},
+		"log-level-file":             {kind: flagValue, validate: oneOf("debug", "info", "warn", "error", "none")},
+		"log-path":                   {kind: flagValue},
+	}
+	projectFlags = flagSet{
+		"project-dir":  {kind: flagValue},
+		"profiles-dir": {kind: flagValue},
+		"profile":      {kind: flagValue},
+		"target":       {kind: flagValue},
+		"target-path":  {kind: flagValue},
+		"vars":         {kind: flagValue},
+	}
+	selectionFlags = flagSet{
+		"select":                {kind: flagValues},
+		"models":                {kind: flagValues},
+		"exclude":               {kind: flagValues},
+		"selector":              {kind: flagValue},
+		"resource-type":         {kind: flagValues},
+		"exclude-resource-type": {kind: flagValues},
+		"indirect-selection":    {kind: flagValue, validate: oneOf("eager", "cautious", "buildable", "empty")},
+	}
+	stateFlags = flagSet{
+		"state":       {kind: flagValue},
+		"defer":       {kind: flagBool},
+		"defer-state": {kind: flagValue},
+		"favor-state": {kind: flagBool},
+	}
+	executionFlags = flagSet{
+		"threads":   {kind: flagValue, validate: positiveNumber},
+		"fail-fast": {kind: flagBool},
+	}
+	fullRefreshFlags = flagSet{
+		"full-refresh": {kind: flagBool},
+	}
+	// eventTimeFlags limit the time range of the microbatch models and of the sample mode
+	eventTimeFlags = flagSet{
+		"event-time-start": {kind: flagValue},
+		"event-time-end":   {kind: flagValue},
+		"sample":           {kind: flagValue},
+	}
+)
+
+// shortFlags are the short versions of the flags
+var shortFlags = map[string]string{
+	"-s": "select",
+	"-m": "models",
+	"-t": "target",
+	"-x": "fail-fast",
+	"-f": "full-refresh",
+	"-d": "debug",
+	"-q": "quiet",
+	"-o": "output",
+	"-r": "record-timing-info",
+}
+
+type dbtCommand struct {
+	flags flagSet
+	// positionalArgs is the number of arguments expected after the command, e.g. the macro of run-operation
+	positionalArgs int
+}
+
+// dbtCommands are the commands that can be used in the steps of the jobs, by their name after dbt
+var dbtCommands = map[string]dbtCommand{
+	"build": {flags: mergeFlags(projectFlags, selectionFlags, stateFlags, executionFlags, fullRefreshFlags, eventTimeFlags, flagSet{
+		"empty":          {kind: flagBool},
+		"store-failures": {kind: flagBool},
+		"show":           {kind: flagBool},
+	})},
+	"run": {flags: mergeFlags(projectFlags, selectionFlags, stateFlags, executionFlags, fullRefreshFlags, eventTimeFlags, flagSet{
+		"empty": {kind: flagBool},
+	})},
+	"test": {flags: mergeFlags(projectFlags, selectionFlags, stateFlags, executionFlags, flagSet{
+		"store-failures": {kind: flagBool},
+	})},
+	"seed": {flags: mergeFlags(projectFlags, selectionFlags, stateFlags, executionFlags, fullRefreshFlags, flagSet{
+		"show": {kind: flagBool},
+	})},
+	"snapshot": {flags: mergeFlags(projectFlags, selectionFlags, stateFlags, executionFlags)},
+	"source freshness": {flags: mergeFlags(projectFlags, stateFlags, executionFlags, flagSet{
+		"select":   {kind: flagValues},
+		"exclude":  {kind: flagValues},
+		"selector": {kind: flagValue},
+		"output":   {kind: flagValue},
+	})},
+	"docs generate": {flags: mergeFlags(projectFlags, selectionFlags, stateFlags, executionFlags, flagSet{
+		"compile":       {kind: flagBool},
+		"empty-catalog": {kind: flagBool},
+		"static":        {kind: flagBool},
+	})},
+	"compile": {flags: mergeFlags(projectFlags, selectionFlags, stateFlags, executionFlags, fullRefreshFlags, flagSet{
+		"inline":             {kind: flagValue},
+		"output":             {kind: flagValue, validate: oneOf("json", "text")},
+		"show-output-format": {kind: flagValue, validate: oneOf("json", "text")},
+		"empty":              {kind: flagBool},
+	})},
+	"ls": {flags: mergeFlags(projectFlags, selectionFlags, stateFlags, flagSet{
+		"output":      {kind: flagValue, validate: oneOf("json", "name", "path", "selector")},
+		"output-keys": {kind: flagValues},
+	})},
+	"retry": {flags: mergeFlags(projectFlags, stateFlags, executionFlags, fullRefreshFlags)},
+	"clone": {flags: mergeFlags(projectFlags, selectionFlags, stateFlags, executionFlags, fullRefreshFlags)},
+	"run-operation": {
+		flags: mergeFlags(projectFlags, flagSet{
+			"args": {kind: flagValue},
+		}),
+		positionalArgs: 1,
+	},
+}
+
+// dbtCommandAliases are the other names of the commands, e.g. dbt list for dbt ls
+var dbtCommandAliases = map[string]string{
+	"list": "ls",
+}
+
+// splitStep splits a step in arguments like a shell, the quotes group the words and are removed
+func splitStep(step string) ([]string, error) {
+	args := []string{}
+	current := strings.Builder{}
+	inArg := false
+	var quote rune
+
+	for _, char := range step {
+		switch {
+		case quote != 0 && char == quote:
+			quote = 0
+		case quote != 0:
+			current.WriteRune(char)
+		case char == '\'' || char == '"':
+			quote = char
+			inArg = true
+		case char == ' ' || char == '\t' || char == '\n':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(char)
+			inArg = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("the quote %c is not closed", quote)
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
+
+// validateExecuteStep checks that the step is a dbt command supported by dbt Cloud with valid flags
+func validateExecuteStep(step string) error {
+	args, err := splitStep(step)
+	if err != nil {
+		return err
+	}
+
+	if len(args) == 0 || args[0] != "dbt" {
+		return fmt.Errorf("the step needs to start with `dbt`")
+	}
+	args = args[1:]
+
+	// the global flags can be set before the command, e.g. dbt --debug run or dbt --log-format json run
+	globalArgs := []string{}
+	for len(args) > 0 && strings.HasPrefix(args[0], "-") {
+		flagName, _, hasValue := strings.Cut(args[0], "=")
+		globalArgs = append(globalArgs, args[0])
+		args = args[1:]
+
+		// the value of the flag is not the command
+		flag, ok := globalFlags[longFlagName(flagName)]
+		if ok && flag.kind == flagValue && !hasValue && len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+			globalArgs = append(globalArgs, args[0])
+			args = args[1:]
+		}
+	}
+	if err := validateFlags(globalArgs, globalFlags, 0, "dbt"); err != nil {
+		return err
+	}
+
+	if len(args) == 0 {
+		return fmt.Errorf("the command is missing, expected one of: %s", strings.Join(dbtCommandNames(), ", "))
+	}
+
+	name := args[0]
+	if alias, ok := dbtCommandAliases[name]; ok {
+		name = alias
+	}
+	command, ok := dbtCommands[name]
+	commandArgs := args[1:]
+	// some commands have 2 words, e.g. dbt source freshness
+	if !ok && len(args) > 1 {
+		name = args[0] + " " + args[1]
+		command, ok = dbtCommands[name]
+		commandArgs = args[2:]
+	}
+	if !ok {
+		return fmt.Errorf(
+			"the command `dbt %s` is not supported, expected one of: %s",
+			args[0],
+			strings.Join(dbtCommandNames(), ", "),
+		)
+	}
+
+	return validateFlags(commandArgs, mergeFlags(globalFlags, command.flags), command.positionalArgs, "dbt "+name)
+}
+
+// longFlagName returns the name of the flag without the leading dashes, e.g. select for -s or --select
+func longFlagName(flagName string) string {
+	if longName, ok := shortFlags[flagName]; ok {
+		return longName
+	}
+	return strings.TrimPrefix(flagName, "--")
+}
+
+// validateFlags checks the arguments of a command against its flags and its number of positional arguments
+func validateFlags(args []string, flags flagSet, positionalArgs int, commandName string) error {
+	positional := []string{}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			positional = append(positional, arg)
+			continue
+		}
+
+		flagName, value, hasValue := strings.Cut(arg, "=")
+		if _, isShort := shortFlags[flagName]; !isShort && !strings.HasPrefix(flagName, "--") {
+			return fmt.Errorf("the flag %s is not valid for `%s`", arg, commandName)
+		}
+		flagName = longFlagName(flagName)
+
+		flag, ok := flags[flagName]
+		if !ok {
+			// the boolean flags can be negated, e.g. --no-partial-parse
+			negated, isNegated := strings.CutPrefix(flagName, "no-")
+			flag, ok = flags[negated]
+			if !isNegated || !ok || flag.kind != flagBool {
+				return fmt.Errorf("the flag --%s is not valid for `%s`", flagName, commandName)
+			}
+		}
+
+		switch flag.kind {
+		case flagBool:
+			if hasValue {
+				return fmt.Errorf("the flag --%s doesn't take a value", flagName)
+			}
+		case flagValue, flagValues:
+			values := []string{}
+			if hasValue {
+				values = append(values, value)
+			}
+			// the values follow the flag until the next flag, flagValue only takes one
+			for i+1 < len(args) && !strings.HasPrefix(args[i+1], "-") &&
+				(flag.kind == flagValues || len(values) == 0) {
+				i++
+				values = append(values, args[i])
+			}
+			if len(values) == 0 || lo.Contains(values, "") {
+				return fmt.Errorf("the flag --%s needs a value", flagName)
+			}
+			if flag.validate != nil {
+				for _, value := range values {
+					if err := flag.validate(value); err != nil {
+						return fmt.Errorf("the flag --%s %w", flagName, err)
+					}
+				}
+			}
+		}
+	}
+
+	if len(positional) != positionalArgs {
+		if positionalArgs == 0 {
+			return fmt.Errorf(
+				"unexpected argument %q for `%s`, the nodes are selected with --select",
+				positional[0],
+				commandName,
+			)
+		}
+		return fmt.Errorf("`%s` expects %d argument(s), got %d", commandName, positionalArgs, len(positional))
+	}
+
+	return nil
+}
+
+func dbtCommandNames() []string {
+	names := lo.Keys(dbtCommands)
+	sort.Strings(names)
+	return names
+}
+
+var _ validator.String = executeStepValidator{}
+
+// executeStepValidator checks at plan time that the steps of the jobs are valid dbt commands
+type executeStepValidator struct{}
+
+func (v executeStepValidator) Description(_ context.Context) string {
+	return "value must be a dbt command supported by dbt Cloud, with flags valid for the command"
+}
+
+func (v executeStepValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v executeStepValidator) ValidateString(
+	_ context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateExecuteStep(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid dbt command",
+			fmt.Sprintf("The step %q is not valid: %s.", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
@@ -0,0 +1,101 @@
+package job
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateExecuteStep(t *testing.T) {
+	t.Parallel()
+
+	validSteps := []string{
+		"dbt build",
+		"dbt build --full-refresh",
+		"dbt build -s state:modified+ --fail-fast",
+		"dbt run --select tag:nightly my_model+ --exclude 'tag:slow' --threads 8",
+		"dbt run --select=+orders --vars '{\"run_date\": \"2024-01-01\"}'",
+		"dbt --debug test -s source:* --store-failures",
+		"dbt seed --full-refresh --show",
+		"dbt snapshot --target prod",
+		"dbt source freshness --select \"source:shop\" --output target/sources.json",
+		"dbt docs generate --no-compile",
+		"dbt compile --no-partial-parse",
+		"dbt ls --resource-type model --output json",
+		"dbt list -s my_model",
+		"dbt retry",
+		"dbt clone --state prod-run-artifacts --full-refresh",
+		"dbt run-operation grant_select --args '{role: reporter}'",
+		"dbt build --defer --state target/prod --favor-state",
+		"dbt --log-format json build",
+		"dbt --log-format=json --debug build",
+		"dbt --warn-error-options '{\"include\": \"all\"}' run",
+		"dbt --log-level warn --printer-width 120 test -s my_model",
+		"dbt run --event-time-start 2024-01-01 --event-time-end \"2024-02-01 00:00:00\"",
+		"dbt build --sample=\"3 days\" --select my_model",
+		"dbt run --sample '{\"start\": \"2024-01-01\", \"end\": \"2024-01-07\"}'",
+	}
+	for _, step := range validSteps {
+		if err := validateExecuteStep(step); err != nil {
+			t.Errorf("unexpected error for %q: %v", step, err)
+		}
+	}
+
+	invalidSteps := []struct {
+		step     string
+		expected string
+	}{
+		{step: "", expected: "needs to start with `dbt`"},
+		{step: "dbtbuild", expected: "needs to start with `dbt`"},
+		{step: "build --select my_model", expected: "needs to start with `dbt`"},
+		{step: "dbt", expected: "the command is missing"},
+		{step: "dbt buidl", expected: "the command `dbt buidl` is not supported"},
+		{step: "dbt docs serve", expected: "the command `dbt docs` is not supported"},
+		{step: "dbt source", expected: "the command `dbt source` is not supported"},
+		{step: "dbt run --selct my_model", expected: "the flag --selct is not valid for `dbt run`"},
+		{step: "dbt test --full-refresh", expected: "the flag --full-refresh is not valid for `dbt test`"},
+		{step: "dbt run -z", expected: "the flag -z is not valid for `dbt run`"},
+		{step: "dbt run --select", expected: "the flag --select needs a value"},
+		{step: "dbt run --select \"tag:nightly", expected: "the quote \" is not closed"},
+		{step: "dbt run --vars '{\"key\": 1}", expected: "the quote ' is not closed"},
+		{step: "dbt run --threads 0", expected: "the flag --threads needs to be a positive number"},
+		{step: "dbt run --threads=4 my_model", expected: "unexpected argument \"my_model\" for `dbt run`"},
+		{step: "dbt build +my_model", expected: "unexpected argument \"+my_model\""},
+		{step: "dbt run --full-refresh=true", expected: "the flag --full-refresh doesn't take a value"},
+		{step: "dbt --select my_model run", expected: "the flag --select is not valid for `dbt`"},
+		{step: "dbt run-operation", expected: "`dbt run-operation` expects 1 argument(s), got 0"},
+		{step: "dbt ls --output yaml", expected: "the flag --output needs to be one of"},
+		{step: "dbt --log-format yaml run", expected: "the flag --log-format needs to be one of"},
+		{step: "dbt --log-format", expected: "the flag --log-format needs a value"},
+		{step: "dbt --log-format json", expected: "the command is missing"},
+		{step: "dbt test --event-time-start 2024-01-01", expected: "the flag --event-time-start is not valid for `dbt test`"},
+	}
+	for _, tc := range invalidSteps {
+		err := validateExecuteStep(tc.step)
+		if err == nil || !strings.Contains(err.Error(), tc.expected) {
+			t.Errorf("expected an error containing %q for %q, got %v", tc.expected, tc.step, err)
+		}
+	}
+}
+
+func TestExecuteStepValidator(t *testing.T) {
+	t.Parallel()
+
+	req := validator.StringRequest{
+		Path:        path.Root("execute_steps").AtListIndex(1),
+		ConfigValue: types.StringValue("dbt buidl"),
+	}
+	resp := validator.StringResponse{}
+	executeStepValidator{}.ValidateString(context.Background(), req, &resp)
+
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("expected 1 error, got %v", resp.Diagnostics)
+	}
+	if detail := resp.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, `The step "dbt buidl" is not valid`) {
+		t.Errorf("unexpected error: %s", detail)
+	}
+}
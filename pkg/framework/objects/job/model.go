@@ -1,6 +1,18 @@
 package job
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/samber/lo"
+)
 
 type JobsDataSourceModel struct {
 	ProjectID     types.Int64          `tfsdk:"project_id"`
@@ -68,3 +80,283 @@ type JobDataSourceModel struct {
 	JobCompletionTriggerCondition *JobCompletionTrigger `tfsdk:"job_completion_trigger_condition"`
 	RunCompareChanges             types.Bool            `tfsdk:"run_compare_changes"`
 }
+
+type JobRunsDataSourceModel struct {
+	JobID         types.Int64             `tfsdk:"job_id"`
+	EnvironmentID types.Int64             `tfsdk:"environment_id"`
+	ProjectID     types.Int64             `tfsdk:"project_id"`
+	Status        types.String            `tfsdk:"status"`
+	CreatedAfter  types.String            `tfsdk:"created_after"`
+	Limit         types.Int64             `tfsdk:"limit"`
+	Runs          []JobRunDataSourceModel `tfsdk:"runs"`
+}
+
+type JobRunDataSourceModel struct {
+	ID            types.Int64       `tfsdk:"id"`
+	JobID         types.Int64       `tfsdk:"job_id"`
+	EnvironmentID types.Int64       `tfsdk:"environment_id"`
+	ProjectID     types.Int64       `tfsdk:"project_id"`
+	Status        types.String      `tfsdk:"status"`
+	StatusMessage types.String      `tfsdk:"status_message"`
+	GitBranch     types.String      `tfsdk:"git_branch"`
+	GitSHA        types.String      `tfsdk:"git_sha"`
+	TriggerCause  types.String      `tfsdk:"trigger_cause"`
+	CreatedAt     types.String      `tfsdk:"created_at"`
+	StartedAt     types.String      `tfsdk:"started_at"`
+	FinishedAt    types.String      `tfsdk:"finished_at"`
+	Duration      types.String      `tfsdk:"duration"`
+	Href          types.String      `tfsdk:"href"`
+	Steps         []JobRunStepModel `tfsdk:"steps"`
+}
+
+type JobRunStepModel struct {
+	Index    types.Int64  `tfsdk:"index"`
+	Name     types.String `tfsdk:"name"`
+	Status   types.String `tfsdk:"status"`
+	Duration types.String `tfsdk:"duration"`
+}
+
+type JobResourceModel struct {
+	ID                            types.Int64    `tfsdk:"id"`
+	ProjectID                     types.Int64    `tfsdk:"project_id"`
+	EnvironmentID                 types.Int64    `tfsdk:"environment_id"`
+	Name                          types.String   `tfsdk:"name"`
+	Description                   types.String   `tfsdk:"description"`
+	ExecuteSteps                  types.List     `tfsdk:"execute_steps"`
+	DbtVersion                    types.String   `tfsdk:"dbt_version"`
+	IsActive                      types.Bool     `tfsdk:"is_active"`
+	Triggers                      types.Object   `tfsdk:"triggers"`
+	NumThreads                    types.Int64    `tfsdk:"num_threads"`
+	TargetName                    types.String   `tfsdk:"target_name"`
+	GenerateDocs                  types.Bool     `tfsdk:"generate_docs"`
+	RunGenerateSources            types.Bool     `tfsdk:"run_generate_sources"`
+	ScheduleType                  types.String   `tfsdk:"schedule_type"`
+	ScheduleInterval              types.Int64    `tfsdk:"schedule_interval"`
+	ScheduleHours                 types.List     `tfsdk:"schedule_hours"`
+	ScheduleDays                  types.List     `tfsdk:"schedule_days"`
+	ScheduleCron                  types.String   `tfsdk:"schedule_cron"`
+	DeferringJobID                types.Int64    `tfsdk:"deferring_job_id"`
+	DeferringEnvironmentID        types.Int64    `tfsdk:"deferring_environment_id"`
+	SelfDeferring                 types.Bool     `tfsdk:"self_deferring"`
+	TimeoutSeconds                types.Int64    `tfsdk:"timeout_seconds"`
+	TriggersOnDraftPR             types.Bool     `tfsdk:"triggers_on_draft_pr"`
+	JobCompletionTriggerCondition types.Object   `tfsdk:"job_completion_trigger_condition"`
+	RunCompareChanges             types.Bool     `tfsdk:"run_compare_changes"`
+	NextRuns                      types.List     `tfsdk:"next_runs"`
+	Timeouts                      timeouts.Value `tfsdk:"timeouts"`
+}
+
+type JobResourceTriggers struct {
+	GithubWebhook      types.Bool `tfsdk:"github_webhook"`
+	GitProviderWebhook types.Bool `tfsdk:"git_provider_webhook"`
+	Schedule           types.Bool `tfsdk:"schedule"`
+	OnMerge            types.Bool `tfsdk:"on_merge"`
+	CustomBranchOnly   types.Bool `tfsdk:"custom_branch_only"`
+}
+
+var JobResourceTriggersAttrTypes = map[string]attr.Type{
+	"github_webhook":       types.BoolType,
+	"git_provider_webhook": types.BoolType,
+	"schedule":             types.BoolType,
+	"on_merge":             types.BoolType,
+	"custom_branch_only":   types.BoolType,
+}
+
+type JobResourceCompletionTriggerCondition struct {
+	JobID     types.Int64 `tfsdk:"job_id"`
+	ProjectID types.Int64 `tfsdk:"project_id"`
+	Statuses  types.Set   `tfsdk:"statuses"`
+}
+
+var JobResourceCompletionTriggerConditionAttrTypes = map[string]attr.Type{
+	"job_id":     types.Int64Type,
+	"project_id": types.Int64Type,
+	"statuses":   types.SetType{ElemType: types.StringType},
+}
+
+// JobResourceModelV0 is the state of the resource when it was implemented with the SDKv2
+// the ID was a string, the triggers a map and the job completion trigger a set with 1 item
+type JobResourceModelV0 struct {
+	ID                            types.String   `tfsdk:"id"`
+	ProjectID                     types.Int64    `tfsdk:"project_id"`
+	EnvironmentID                 types.Int64    `tfsdk:"environment_id"`
+	Name                          types.String   `tfsdk:"name"`
+	Description                   types.String   `tfsdk:"description"`
+	ExecuteSteps                  types.List     `tfsdk:"execute_steps"`
+	DbtVersion                    types.String   `tfsdk:"dbt_version"`
+	IsActive                      types.Bool     `tfsdk:"is_active"`
+	Triggers                      types.Map      `tfsdk:"triggers"`
+	NumThreads                    types.Int64    `tfsdk:"num_threads"`
+	TargetName                    types.String   `tfsdk:"target_name"`
+	GenerateDocs                  types.Bool     `tfsdk:"generate_docs"`
+	RunGenerateSources            types.Bool     `tfsdk:"run_generate_sources"`
+	ScheduleType                  types.String   `tfsdk:"schedule_type"`
+	ScheduleInterval              types.Int64    `tfsdk:"schedule_interval"`
+	ScheduleHours                 types.List     `tfsdk:"schedule_hours"`
+	ScheduleDays                  types.List     `tfsdk:"schedule_days"`
+	ScheduleCron                  types.String   `tfsdk:"schedule_cron"`
+	DeferringJobID                types.Int64    `tfsdk:"deferring_job_id"`
+	DeferringEnvironmentID        types.Int64    `tfsdk:"deferring_environment_id"`
+	SelfDeferring                 types.Bool     `tfsdk:"self_deferring"`
+	TimeoutSeconds                types.Int64    `tfsdk:"timeout_seconds"`
+	TriggersOnDraftPR             types.Bool     `tfsdk:"triggers_on_draft_pr"`
+	JobCompletionTriggerCondition types.Set      `tfsdk:"job_completion_trigger_condition"`
+	RunCompareChanges             types.Bool     `tfsdk:"run_compare_changes"`
+	Timeouts                      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// jobType returns the type of job defined by the triggers, dbt Cloud doesn't allow changing it
+func (t JobResourceTriggers) jobType() string {
+	if t.GithubWebhook.ValueBool() {
+		return "ci"
+	}
+	if t.OnMerge.ValueBool() {
+		return "merge"
+	}
+	return ""
+}
+
+// triggersMap returns the triggers in the format expected by the client to create a job
+func (t JobResourceTriggers) triggersMap() map[string]any {
+	return map[string]any{
+		"github_webhook":       t.GithubWebhook.ValueBool(),
+		"git_provider_webhook": t.GitProviderWebhook.ValueBool(),
+		"schedule":             t.Schedule.ValueBool(),
+		"on_merge":             t.OnMerge.ValueBool(),
+	}
+}
+
+func (m JobResourceModel) triggers(ctx context.Context) (JobResourceTriggers, diag.Diagnostics) {
+	var triggers JobResourceTriggers
+	diags := m.Triggers.As(ctx, &triggers, basetypes.ObjectAsOptions{})
+	return triggers, diags
+}
+
+// completionTrigger returns the job completion trigger of the config, or nil if none is configured
+func (m JobResourceModel) completionTrigger(
+	ctx context.Context,
+) (*dbt_cloud.JobCompletionTrigger, diag.Diagnostics) {
+	if m.JobCompletionTriggerCondition.IsNull() {
+		return nil, nil
+	}
+
+	var condition JobResourceCompletionTriggerCondition
+	diags := m.JobCompletionTriggerCondition.As(ctx, &condition, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var statuses []string
+	diags.Append(condition.Statuses.ElementsAs(ctx, &statuses, false)...)
+
+	return &dbt_cloud.JobCompletionTrigger{
+		Condition: dbt_cloud.JobCompletionTriggerCondition{
+			JobID:     int(condition.JobID.ValueInt64()),
+			ProjectID: int(condition.ProjectID.ValueInt64()),
+			Statuses: lo.Map(statuses, func(status string, _ int) int {
+				return utils.JobCompletionTriggerConditionsMappingHumanCode[status]
+			}),
+		},
+	}, diags
+}
+
+// setFromJob updates the model with the values of the job returned by the API
+// triggers.custom_branch_only is deprecated in the API, we keep the value currently in the model
+func (m *JobResourceModel) setFromJob(ctx context.Context, job *dbt_cloud.Job) diag.Diagnostics {
+	var diags, newDiags diag.Diagnostics
+
+	m.ID = types.Int64Value(int64(*job.ID))
+	m.ProjectID = types.Int64Value(int64(job.Project_Id))
+	m.EnvironmentID = types.Int64Value(int64(job.Environment_Id))
+	m.Name = types.StringValue(job.Name)
+	m.Description = types.StringValue(job.Description)
+	m.ExecuteSteps, newDiags = types.ListValueFrom(ctx, types.StringType, job.Execute_Steps)
+	diags.Append(newDiags...)
+	m.DbtVersion = types.StringPointerValue(job.Dbt_Version)
+	m.IsActive = types.BoolValue(job.State == dbt_cloud.STATE_ACTIVE)
+	m.NumThreads = types.Int64Value(int64(job.Settings.Threads))
+	m.TargetName = types.StringValue(job.Settings.Target_Name)
+	m.GenerateDocs = types.BoolValue(job.Generate_Docs)
+	m.RunGenerateSources = types.BoolValue(job.Run_Generate_Sources)
+
+	m.ScheduleType = types.StringValue(job.Schedule.Date.Type)
+	scheduleInterval := 1
+	if job.Schedule.Time.Interval > 0 {
+		scheduleInterval = job.Schedule.Time.Interval
+	}
+	m.ScheduleInterval = types.Int64Value(int64(scheduleInterval))
+	m.ScheduleHours, newDiags = intListValue(ctx, job.Schedule.Time.Hours)
+	diags.Append(newDiags...)
+	m.ScheduleDays, newDiags = intListValue(ctx, job.Schedule.Date.Days)
+	diags.Append(newDiags...)
+	m.ScheduleCron = types.StringNull()
+	if job.Schedule.Date.Cron != nil && *job.Schedule.Date.Cron != "" {
+		m.ScheduleCron = types.StringValue(*job.Schedule.Date.Cron)
+	}
+	m.NextRuns, newDiags = jobNextRuns(job)
+	diags.Append(newDiags...)
+
+	// a job deferring to itself is configured with self_deferring and not with deferring_job_id
+	selfDeferring := job.Deferring_Job_Id != nil && *job.Deferring_Job_Id == *job.ID
+	m.DeferringJobID = types.Int64Null()
+	if !selfDeferring {
+		m.DeferringJobID = types.Int64PointerValue(helper.IntPointerToInt64Pointer(job.Deferring_Job_Id))
+	}
+	m.DeferringEnvironmentID = types.Int64PointerValue(
+		helper.IntPointerToInt64Pointer(job.DeferringEnvironmentId),
+	)
+	m.SelfDeferring = types.BoolValue(selfDeferring)
+
+	m.TimeoutSeconds = types.Int64Value(int64(job.Execution.Timeout_Seconds))
+	m.TriggersOnDraftPR = types.BoolValue(job.TriggersOnDraftPR)
+	m.RunCompareChanges = types.BoolValue(job.RunCompareChanges)
+
+	customBranchOnly := types.BoolNull()
+	if !m.Triggers.IsNull() && !m.Triggers.IsUnknown() {
+		currentTriggers, newDiags := m.triggers(ctx)
+		diags.Append(newDiags...)
+		customBranchOnly = currentTriggers.CustomBranchOnly
+	}
+	m.Triggers, newDiags = types.ObjectValueFrom(ctx, JobResourceTriggersAttrTypes, JobResourceTriggers{
+		GithubWebhook:      types.BoolValue(job.Triggers.Github_Webhook),
+		GitProviderWebhook: types.BoolValue(job.Triggers.GitProviderWebhook),
+		Schedule:           types.BoolValue(job.Triggers.Schedule),
+		OnMerge:            types.BoolValue(job.Triggers.OnMerge),
+		CustomBranchOnly:   customBranchOnly,
+	})
+	diags.Append(newDiags...)
+
+	m.JobCompletionTriggerCondition = types.ObjectNull(JobResourceCompletionTriggerConditionAttrTypes)
+	if job.JobCompletionTrigger != nil {
+		condition := job.JobCompletionTrigger.Condition
+		statuses := []string{}
+		for _, status := range condition.Statuses {
+			if statusName, ok := utils.JobCompletionTriggerConditionsMappingCodeHuman[status].(string); ok {
+				statuses = append(statuses, statusName)
+			}
+		}
+		statusesSet, newDiags := types.SetValueFrom(ctx, types.StringType, statuses)
+		diags.Append(newDiags...)
+
+		m.JobCompletionTriggerCondition, newDiags = types.ObjectValueFrom(
+			ctx,
+			JobResourceCompletionTriggerConditionAttrTypes,
+			JobResourceCompletionTriggerCondition{
+				JobID:     types.Int64Value(int64(condition.JobID)),
+				ProjectID: types.Int64Value(int64(condition.ProjectID)),
+				Statuses:  statusesSet,
+			},
+		)
+		diags.Append(newDiags...)
+	}
+
+	return diags
+}
+
+// intListValue returns a null list when the API doesn't return any value, the same as when the attribute is not configured
+func intListValue(ctx context.Context, values *[]int) (types.List, diag.Diagnostics) {
+	if values == nil || len(*values) == 0 {
+		return types.ListNull(types.Int64Type), nil
+	}
+	return types.ListValueFrom(ctx, types.Int64Type, *values)
+}
@@ -0,0 +1,576 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = &jobResource{}
+	_ resource.ResourceWithConfigure      = &jobResource{}
+	_ resource.ResourceWithImportState    = &jobResource{}
+	_ resource.ResourceWithModifyPlan     = &jobResource{}
+	_ resource.ResourceWithUpgradeState   = &jobResource{}
+	_ resource.ResourceWithValidateConfig = &jobResource{}
+)
+
+func JobResource() resource.Resource {
+	return &jobResource{}
+}
+
+type jobResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *jobResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_job"
+}
+
+func (r *jobResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var data JobResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RunCompareChanges.ValueBool() && data.DeferringEnvironmentID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("run_compare_changes"),
+			"Invalid Attribute Configuration",
+			"deferring_environment_id must be configured when run_compare_changes is true.",
+		)
+	}
+}
+
+// ModifyPlan recreates the job when its type (CI, merge or "empty") changes as dbt Cloud doesn't allow updating it
+// the job type is determined by the triggers
+// it also previews the next runs of the job when its schedule changes
+func (r *jobResource) ModifyPlan(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.planNextRuns(ctx, req, resp)
+	if req.State.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var plan, state JobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Triggers.IsUnknown() {
+		return
+	}
+
+	planTriggers, diags := plan.triggers(ctx)
+	resp.Diagnostics.Append(diags...)
+	stateTriggers, diags := state.triggers(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if planTriggers.GithubWebhook.IsUnknown() || planTriggers.OnMerge.IsUnknown() {
+		return
+	}
+
+	if planTriggers.jobType() != stateTriggers.jobType() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("triggers"))
+	}
+}
+
+func (r *jobResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state JobResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	jobID := strconv.FormatInt(state.ID.ValueInt64(), 10)
+	job, err := r.client.GetJob(ctx, jobID)
+	if err != nil {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			resp.Diagnostics.AddWarning(
+				"Resource not found",
+				"The job was not found and has been removed from the state.",
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		helper.AddReadError(&resp.Diagnostics, "Error getting the job", err)
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromJob(ctx, job)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jobResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan JobResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	triggers, diags := plan.triggers(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	var executeSteps []string
+	resp.Diagnostics.Append(plan.ExecuteSteps.ElementsAs(ctx, &executeSteps, false)...)
+	scheduleHours := []int{}
+	resp.Diagnostics.Append(plan.ScheduleHours.ElementsAs(ctx, &scheduleHours, false)...)
+	scheduleDays := []int{}
+	resp.Diagnostics.Append(plan.ScheduleDays.ElementsAs(ctx, &scheduleDays, false)...)
+
+	completionTrigger, diags := plan.completionTrigger(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var completionTriggerCondition map[string]any
+	if completionTrigger != nil {
+		completionTriggerCondition = map[string]any{
+			"job_id":     completionTrigger.Condition.JobID,
+			"project_id": completionTrigger.Condition.ProjectID,
+			"statuses":   completionTrigger.Condition.Statuses,
+		}
+	}
+
+	createdJob, err := r.client.CreateJob(
+		ctx,
+		int(plan.ProjectID.ValueInt64()),
+		int(plan.EnvironmentID.ValueInt64()),
+		plan.Name.ValueString(),
+		plan.Description.ValueString(),
+		executeSteps,
+		plan.DbtVersion.ValueString(),
+		plan.IsActive.ValueBool(),
+		triggers.triggersMap(),
+		int(plan.NumThreads.ValueInt64()),
+		plan.TargetName.ValueString(),
+		plan.GenerateDocs.ValueBool(),
+		plan.RunGenerateSources.ValueBool(),
+		plan.ScheduleType.ValueString(),
+		int(plan.ScheduleInterval.ValueInt64()),
+		scheduleHours,
+		scheduleDays,
+		plan.ScheduleCron.ValueString(),
+		int(plan.DeferringJobID.ValueInt64()),
+		int(plan.DeferringEnvironmentID.ValueInt64()),
+		plan.SelfDeferring.ValueBool(),
+		int(plan.TimeoutSeconds.ValueInt64()),
+		plan.TriggersOnDraftPR.ValueBool(),
+		completionTriggerCondition,
+		plan.RunCompareChanges.ValueBool(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create job",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.Int64Value(int64(*createdJob.ID))
+	if plan.NextRuns.IsUnknown() {
+		plan.NextRuns, diags = jobNextRuns(createdJob)
+		resp.Diagnostics.Append(diags...)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jobResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state JobResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	jobID := strconv.FormatInt(state.ID.ValueInt64(), 10)
+	job, err := r.client.GetJob(ctx, jobID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue getting Job",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	// only the fields changed in the config are updated, the others keep the value returned by the API
+	if !plan.EnvironmentID.Equal(state.EnvironmentID) {
+		job.Environment_Id = int(plan.EnvironmentID.ValueInt64())
+	}
+	if !plan.Name.Equal(state.Name) {
+		job.Name = plan.Name.ValueString()
+	}
+	if !plan.Description.Equal(state.Description) {
+		job.Description = plan.Description.ValueString()
+	}
+	if !plan.DbtVersion.Equal(state.DbtVersion) {
+		job.Dbt_Version = plan.DbtVersion.ValueStringPointer()
+	}
+	if !plan.NumThreads.Equal(state.NumThreads) {
+		job.Settings.Threads = int(plan.NumThreads.ValueInt64())
+	}
+	if !plan.TargetName.Equal(state.TargetName) {
+		job.Settings.Target_Name = plan.TargetName.ValueString()
+	}
+	if !plan.RunGenerateSources.Equal(state.RunGenerateSources) {
+		job.Run_Generate_Sources = plan.RunGenerateSources.ValueBool()
+	}
+	if !plan.GenerateDocs.Equal(state.GenerateDocs) {
+		job.Generate_Docs = plan.GenerateDocs.ValueBool()
+	}
+	if !plan.ExecuteSteps.Equal(state.ExecuteSteps) {
+		var executeSteps []string
+		resp.Diagnostics.Append(plan.ExecuteSteps.ElementsAs(ctx, &executeSteps, false)...)
+		job.Execute_Steps = executeSteps
+	}
+	if !plan.Triggers.Equal(state.Triggers) {
+		triggers, diags := plan.triggers(ctx)
+		resp.Diagnostics.Append(diags...)
+		job.Triggers.Github_Webhook = triggers.GithubWebhook.ValueBool()
+		job.Triggers.GitProviderWebhook = triggers.GitProviderWebhook.ValueBool()
+		job.Triggers.Schedule = triggers.Schedule.ValueBool()
+		job.Triggers.OnMerge = triggers.OnMerge.ValueBool()
+	}
+
+	if !plan.ScheduleInterval.Equal(state.ScheduleInterval) {
+		job.Schedule.Time.Interval = int(plan.ScheduleInterval.ValueInt64())
+	}
+	if !plan.ScheduleHours.Equal(state.ScheduleHours) {
+		scheduleHours := []int{}
+		resp.Diagnostics.Append(plan.ScheduleHours.ElementsAs(ctx, &scheduleHours, false)...)
+		if len(scheduleHours) > 0 {
+			job.Schedule.Time.Hours = &scheduleHours
+			job.Schedule.Time.Type = "at_exact_hours"
+			job.Schedule.Time.Interval = 0
+		} else {
+			job.Schedule.Time.Hours = nil
+			job.Schedule.Time.Interval = int(plan.ScheduleInterval.ValueInt64())
+			job.Schedule.Time.Type = "every_hour"
+		}
+	}
+	if !plan.ScheduleDays.Equal(state.ScheduleDays) {
+		scheduleDays := []int{}
+		resp.Diagnostics.Append(plan.ScheduleDays.ElementsAs(ctx, &scheduleDays, false)...)
+		if len(scheduleDays) > 0 {
+			job.Schedule.Date.Days = &scheduleDays
+		}
+	}
+	if !plan.ScheduleCron.Equal(state.ScheduleCron) {
+		scheduleCron := plan.ScheduleCron.ValueString()
+		job.Schedule.Date.Cron = &scheduleCron
+	}
+
+	// we set this after the subfields to remove the fields not matching the schedule type
+	// if it was before, some of those fields would be set again
+	if !plan.ScheduleType.Equal(state.ScheduleType) {
+		scheduleType := plan.ScheduleType.ValueString()
+		job.Schedule.Date.Type = scheduleType
+
+		if scheduleType == "days_of_week" || scheduleType == "every_day" {
+			job.Schedule.Date.Cron = nil
+		}
+		if scheduleType == "custom_cron" || scheduleType == "every_day" {
+			job.Schedule.Date.Days = nil
+		}
+	}
+
+	if !plan.DeferringJobID.Equal(state.DeferringJobID) {
+		job.Deferring_Job_Id = deferringID(plan.DeferringJobID)
+	}
+	if !plan.DeferringEnvironmentID.Equal(state.DeferringEnvironmentID) {
+		job.DeferringEnvironmentId = deferringID(plan.DeferringEnvironmentID)
+	}
+	// If self_deferring has been toggled to true, set deferring_job_id as own ID
+	// Otherwise, set it back to what deferring_job_id specifies it to be
+	if !plan.SelfDeferring.Equal(state.SelfDeferring) {
+		if plan.SelfDeferring.ValueBool() {
+			selfID := *job.ID
+			job.Deferring_Job_Id = &selfID
+		} else {
+			job.Deferring_Job_Id = deferringID(plan.DeferringJobID)
+		}
+	}
+	if !plan.TimeoutSeconds.Equal(state.TimeoutSeconds) {
+		job.Execution.Timeout_Seconds = int(plan.TimeoutSeconds.ValueInt64())
+	}
+	if !plan.TriggersOnDraftPR.Equal(state.TriggersOnDraftPR) {
+		job.TriggersOnDraftPR = plan.TriggersOnDraftPR.ValueBool()
+	}
+	if !plan.JobCompletionTriggerCondition.Equal(state.JobCompletionTriggerCondition) {
+		completionTrigger, diags := plan.completionTrigger(ctx)
+		resp.Diagnostics.Append(diags...)
+		job.JobCompletionTrigger = completionTrigger
+	}
+	if !plan.RunCompareChanges.Equal(state.RunCompareChanges) {
+		job.RunCompareChanges = plan.RunCompareChanges.ValueBool()
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updatedJob, err := r.client.UpdateJob(ctx, jobID, *job)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update job",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	if plan.NextRuns.IsUnknown() {
+		nextRuns, diags := jobNextRuns(updatedJob)
+		resp.Diagnostics.Append(diags...)
+		plan.NextRuns = nextRuns
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jobResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state JobResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	jobID := strconv.FormatInt(state.ID.ValueInt64(), 10)
+	job, err := r.client.GetJob(ctx, jobID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue getting Job",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	job.State = dbt_cloud.STATE_DELETED
+	_, err = r.client.UpdateJob(ctx, jobID, *job)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete job",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *jobResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	jobIDStr, err := r.client.ResolveJobImportID(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error finding the job to import", err.Error())
+		return
+	}
+
+	jobID, err := strconv.ParseInt(jobIDStr, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing job ID for import", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), jobID)...)
+}
+
+func (r *jobResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := jobResourceSchemaV0(ctx)
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradeJobStateV0,
+		},
+	}
+}
+
+// upgradeJobStateV0 converts the state of the SDKv2 resource
+// the SDKv2 saved the attributes not set as zero values, they are saved as null to match the configs not setting them
+func upgradeJobStateV0(
+	ctx context.Context,
+	req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse,
+) {
+	var priorState JobResourceModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	jobID, err := strconv.ParseInt(priorState.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing the job ID of the state", err.Error())
+		return
+	}
+
+	priorTriggers := map[string]types.Bool{}
+	resp.Diagnostics.Append(priorState.Triggers.ElementsAs(ctx, &priorTriggers, false)...)
+	customBranchOnly, ok := priorTriggers["custom_branch_only"]
+	if !ok {
+		customBranchOnly = types.BoolNull()
+	}
+	triggers, diags := types.ObjectValueFrom(ctx, JobResourceTriggersAttrTypes, JobResourceTriggers{
+		GithubWebhook:      types.BoolValue(priorTriggers["github_webhook"].ValueBool()),
+		GitProviderWebhook: types.BoolValue(priorTriggers["git_provider_webhook"].ValueBool()),
+		Schedule:           types.BoolValue(priorTriggers["schedule"].ValueBool()),
+		OnMerge:            types.BoolValue(priorTriggers["on_merge"].ValueBool()),
+		CustomBranchOnly:   customBranchOnly,
+	})
+	resp.Diagnostics.Append(diags...)
+
+	priorConditions := []JobResourceCompletionTriggerCondition{}
+	resp.Diagnostics.Append(
+		priorState.JobCompletionTriggerCondition.ElementsAs(ctx, &priorConditions, false)...,
+	)
+	completionTriggerCondition := types.ObjectNull(JobResourceCompletionTriggerConditionAttrTypes)
+	if len(priorConditions) > 0 {
+		completionTriggerCondition, diags = types.ObjectValueFrom(
+			ctx,
+			JobResourceCompletionTriggerConditionAttrTypes,
+			priorConditions[0],
+		)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upgradedState := JobResourceModel{
+		ID:                            types.Int64Value(jobID),
+		ProjectID:                     priorState.ProjectID,
+		EnvironmentID:                 priorState.EnvironmentID,
+		Name:                          priorState.Name,
+		Description:                   priorState.Description,
+		ExecuteSteps:                  priorState.ExecuteSteps,
+		DbtVersion:                    nullIfEmptyString(priorState.DbtVersion),
+		IsActive:                      priorState.IsActive,
+		Triggers:                      triggers,
+		NumThreads:                    priorState.NumThreads,
+		TargetName:                    priorState.TargetName,
+		GenerateDocs:                  priorState.GenerateDocs,
+		RunGenerateSources:            priorState.RunGenerateSources,
+		ScheduleType:                  priorState.ScheduleType,
+		ScheduleInterval:              priorState.ScheduleInterval,
+		ScheduleHours:                 nullIfEmptyList(ctx, priorState.ScheduleHours),
+		ScheduleDays:                  nullIfEmptyList(ctx, priorState.ScheduleDays),
+		ScheduleCron:                  nullIfEmptyString(priorState.ScheduleCron),
+		DeferringJobID:                nullIfZero(priorState.DeferringJobID),
+		DeferringEnvironmentID:        nullIfZero(priorState.DeferringEnvironmentID),
+		SelfDeferring:                 types.BoolValue(priorState.SelfDeferring.ValueBool()),
+		TimeoutSeconds:                priorState.TimeoutSeconds,
+		TriggersOnDraftPR:             priorState.TriggersOnDraftPR,
+		JobCompletionTriggerCondition: completionTriggerCondition,
+		RunCompareChanges:             priorState.RunCompareChanges,
+		NextRuns:                      types.ListNull(types.StringType),
+		Timeouts:                      priorState.Timeouts,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &upgradedState)...)
+}
+
+func (r *jobResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
+
+// deferringID returns nil when the ID is not set, for the API to remove the deferral
+func deferringID(id types.Int64) *int {
+	if id.ValueInt64() == 0 {
+		return nil
+	}
+	deferringID := int(id.ValueInt64())
+	return &deferringID
+}
+
+func nullIfEmptyString(value types.String) types.String {
+	if value.ValueString() == "" {
+		return types.StringNull()
+	}
+	return value
+}
+
+func nullIfZero(value types.Int64) types.Int64 {
+	if value.ValueInt64() == 0 {
+		return types.Int64Null()
+	}
+	return value
+}
+
+func nullIfEmptyList(ctx context.Context, value types.List) types.List {
+	if !value.IsUnknown() && len(value.Elements()) == 0 {
+		return types.ListNull(value.ElementType(ctx))
+	}
+	return value
+}
@@ -1,6 +1,7 @@
-package resources_test
+package job_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -42,7 +44,7 @@ func TestAccDbtCloudJobResource(t *testing.T) {
 	)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckDbtCloudJobDestroy,
 		Steps: []resource.TestStep{
@@ -82,7 +84,7 @@ func TestAccDbtCloudJobResource(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"dbtcloud_job.test_job",
 						"dbt_version",
-						DBT_CLOUD_VERSION,
+						acctest_helper.DBT_CLOUD_VERSION,
 					),
 					resource.TestCheckResourceAttr("dbtcloud_job.test_job", "target_name", "test"),
 					resource.TestCheckResourceAttr(
@@ -112,27 +114,22 @@ func TestAccDbtCloudJobResource(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckDbtCloudJobExists("dbtcloud_job.test_job"),
 					testAccCheckDbtCloudJobExists("dbtcloud_job.test_job_4"),
-					resource.TestCheckResourceAttr(
-						"dbtcloud_job.test_job_4",
-						"job_completion_trigger_condition.#",
-						"1",
-					),
 					resource.TestCheckResourceAttrSet(
 						"dbtcloud_job.test_job_4",
-						"job_completion_trigger_condition.0.job_id",
+						"job_completion_trigger_condition.job_id",
 					),
 					resource.TestCheckResourceAttrSet(
 						"dbtcloud_job.test_job_4",
-						"job_completion_trigger_condition.0.project_id",
+						"job_completion_trigger_condition.project_id",
 					),
 					resource.TestCheckTypeSetElemAttr(
 						"dbtcloud_job.test_job_4",
-						"job_completion_trigger_condition.0.statuses.*",
+						"job_completion_trigger_condition.statuses.*",
 						"error",
 					),
 					resource.TestCheckTypeSetElemAttr(
 						"dbtcloud_job.test_job_4",
-						"job_completion_trigger_condition.0.statuses.*",
+						"job_completion_trigger_condition.statuses.*",
 						"success",
 					),
 				),
@@ -155,7 +152,7 @@ func TestAccDbtCloudJobResource(t *testing.T) {
 					resource.TestCheckResourceAttr(
 						"dbtcloud_job.test_job",
 						"dbt_version",
-						DBT_CLOUD_VERSION,
+						acctest_helper.DBT_CLOUD_VERSION,
 					),
 					resource.TestCheckResourceAttr("dbtcloud_job.test_job", "target_name", "test"),
 					resource.TestCheckResourceAttr(
@@ -181,7 +178,6 @@ func TestAccDbtCloudJobResource(t *testing.T) {
 				ImportStateVerify: true,
 				// we don't check triggers.custom_branch_only as we currently allow people to keep triggers.custom_branch_only in their config to not break peopple's Terraform project
 				ImportStateVerifyIgnore: []string{
-					"triggers.%",
 					"triggers.custom_branch_only",
 				},
 			},
@@ -196,7 +192,7 @@ func TestAccDbtCloudJobResourceTriggers(t *testing.T) {
 	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckDbtCloudJobDestroy,
 		Steps: []resource.TestStep{
@@ -236,6 +232,58 @@ func TestAccDbtCloudJobResourceTriggers(t *testing.T) {
 	})
 }
 
+// the jobs created with the SDKv2 version of the resource need to be upgraded without any change planned
+func TestAccDbtCloudJobResourceUpgradeFromSDKv2(t *testing.T) {
+
+	jobName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	jobName4 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	config := testAccDbtCloudJobResourceJobChaining(
+		jobName,
+		projectName,
+		environmentName,
+		jobName4,
+	)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest_helper.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckDbtCloudJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"dbtcloud": {
+						Source:            "dbt-labs/dbtcloud",
+						VersionConstraint: "0.3.22",
+					},
+				},
+				Config: config,
+			},
+			{
+				ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+				Config:                   config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"dbtcloud_job.test_job_4",
+						"job_completion_trigger_condition.job_id",
+					),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_job.test_job",
+						"triggers.schedule",
+						"true",
+					),
+				),
+			},
+		},
+	})
+}
+
 func testAccDbtCloudJobResourceBasicConfig(jobName, projectName, environmentName string) string {
 	return fmt.Sprintf(`
 resource "dbtcloud_project" "test_job_project" {
@@ -262,7 +310,7 @@ resource "dbtcloud_job" "test_job" {
     "schedule": false,
   }
 }
-`, projectName, environmentName, DBT_CLOUD_VERSION, jobName)
+`, projectName, environmentName, acctest_helper.DBT_CLOUD_VERSION, jobName)
 }
 
 func testAccDbtCloudJobResourceFullConfig(jobName, projectName, environmentName string) string {
@@ -308,7 +356,7 @@ resource "dbtcloud_job" "test_job" {
   schedule_hours = [9, 17]
   timeout_seconds = 180
 }
-`, projectName, environmentName, DBT_CLOUD_VERSION, environmentName, DBT_CLOUD_VERSION, jobName, DBT_CLOUD_VERSION)
+`, projectName, environmentName, acctest_helper.DBT_CLOUD_VERSION, environmentName, acctest_helper.DBT_CLOUD_VERSION, jobName, acctest_helper.DBT_CLOUD_VERSION)
 }
 
 func testAccDbtCloudJobResourceJobChaining(
@@ -362,7 +410,7 @@ resource "dbtcloud_job" "test_job_4" {
 	project_id = dbtcloud_project.test_job_project.id
 	environment_id = dbtcloud_environment.test_job_environment.environment_id
 	execute_steps = [
-	  "dbt build +my_model"
+	  "dbt build -s +my_model"
 	]
 	triggers = {
 	  "github_webhook": false,
@@ -375,7 +423,7 @@ resource "dbtcloud_job" "test_job_4" {
 		statuses = ["error", "success"]
 	}
   }
-`, projectName, environmentName, DBT_CLOUD_VERSION, environmentName, DBT_CLOUD_VERSION, jobName, DBT_CLOUD_VERSION, jobName4)
+`, projectName, environmentName, acctest_helper.DBT_CLOUD_VERSION, environmentName, acctest_helper.DBT_CLOUD_VERSION, jobName, acctest_helper.DBT_CLOUD_VERSION, jobName4)
 }
 
 func testAccDbtCloudJobResourceDeferringConfig(
@@ -454,7 +502,7 @@ resource "dbtcloud_job" "test_job_3" {
 	}
 	%s
   }
-`, projectName, environmentName, DBT_CLOUD_VERSION, jobName, DBT_CLOUD_VERSION, jobName2, deferParam, jobName3, selfDefer)
+`, projectName, environmentName, acctest_helper.DBT_CLOUD_VERSION, jobName, acctest_helper.DBT_CLOUD_VERSION, jobName2, deferParam, jobName3, selfDefer)
 }
 
 func TestAccDbtCloudJobResourceSchedules(t *testing.T) {
@@ -464,7 +512,7 @@ func TestAccDbtCloudJobResourceSchedules(t *testing.T) {
 	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckDbtCloudJobDestroy,
 		Steps: []resource.TestStep{
@@ -514,7 +562,6 @@ func TestAccDbtCloudJobResourceSchedules(t *testing.T) {
 				ImportStateVerify: true,
 				// we don't check triggers.custom_branch_only as we currently allow people to keep triggers.custom_branch_only in their config to not break peopple's Terraform project
 				ImportStateVerifyIgnore: []string{
-					"triggers.%",
 					"triggers.custom_branch_only",
 				},
 			},
@@ -522,6 +569,177 @@ func TestAccDbtCloudJobResourceSchedules(t *testing.T) {
 	})
 }
 
+func TestAccDbtCloudJobResourceScheduleCron(t *testing.T) {
+
+	jobName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckDbtCloudJobDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudJobResourceScheduleCronConfig(
+					jobName,
+					projectName,
+					environmentName,
+					"0 25 * * *",
+				),
+				ExpectError: regexp.MustCompile(`invalid hour "25"`),
+			},
+			{
+				Config: testAccDbtCloudJobResourceScheduleCronConfig(
+					jobName,
+					projectName,
+					environmentName,
+					"0 0 30 FEB *",
+				),
+				ExpectError: regexp.MustCompile("never runs"),
+			},
+			{
+				Config: testAccDbtCloudJobResourceScheduleCronConfig(
+					jobName,
+					projectName,
+					environmentName,
+					"0 21 * * *",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudJobExists("dbtcloud_job.test_job"),
+					resource.TestCheckResourceAttr("dbtcloud_job.test_job", "next_runs.#", "5"),
+					resource.TestMatchResourceAttr(
+						"dbtcloud_job.test_job",
+						"next_runs.0",
+						regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T21:00:00Z$`),
+					),
+				),
+			},
+			// the next runs are not shown as changing while the schedule stays the same
+			{
+				Config: testAccDbtCloudJobResourceScheduleCronConfig(
+					jobName,
+					projectName,
+					environmentName,
+					"0 21 * * *",
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccDbtCloudJobResourceScheduleCronConfig(
+	jobName, projectName, environmentName, cron string,
+) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_job_project" {
+    name = "%s"
+}
+
+resource "dbtcloud_environment" "test_job_environment" {
+    project_id = dbtcloud_project.test_job_project.id
+    name = "%s"
+    dbt_version = "%s"
+    type = "deployment"
+}
+
+resource "dbtcloud_job" "test_job" {
+  name        = "%s"
+  project_id = dbtcloud_project.test_job_project.id
+  environment_id = dbtcloud_environment.test_job_environment.environment_id
+  execute_steps = [
+    "dbt build"
+  ]
+  triggers = {
+    "github_webhook": false,
+    "git_provider_webhook": false,
+    "schedule": true,
+  }
+  schedule_type = "custom_cron"
+  schedule_cron = "%s"
+}
+`, projectName, environmentName, acctest_helper.DBT_CLOUD_VERSION, jobName, cron)
+}
+
+func TestAccDbtCloudJobResourceExecuteSteps(t *testing.T) {
+
+	jobName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	// the steps are validated at plan time, before any object is created
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudJobResourceExecuteStepsConfig(
+					jobName,
+					projectName,
+					environmentName,
+					`"dbt buidl"`,
+				),
+				ExpectError: regexp.MustCompile("the command `dbt buidl` is not supported"),
+			},
+			{
+				Config: testAccDbtCloudJobResourceExecuteStepsConfig(
+					jobName,
+					projectName,
+					environmentName,
+					`"dbt test --full-refresh"`,
+				),
+				ExpectError: regexp.MustCompile("the flag --full-refresh is not valid for `dbt test`"),
+			},
+			{
+				Config: testAccDbtCloudJobResourceExecuteStepsConfig(
+					jobName,
+					projectName,
+					environmentName,
+					`"dbt run --select 'tag:nightly"`,
+				),
+				ExpectError: regexp.MustCompile("the quote ' is not closed"),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudJobResourceExecuteStepsConfig(
+	jobName, projectName, environmentName, step string,
+) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_job_project" {
+    name = "%s"
+}
+
+resource "dbtcloud_environment" "test_job_environment" {
+    project_id = dbtcloud_project.test_job_project.id
+    name = "%s"
+    dbt_version = "%s"
+    type = "deployment"
+}
+
+resource "dbtcloud_job" "test_job" {
+  name        = "%s"
+  project_id = dbtcloud_project.test_job_project.id
+  environment_id = dbtcloud_environment.test_job_environment.environment_id
+  execute_steps = [
+    "dbt build",
+    %s
+  ]
+  triggers = {
+    "github_webhook": false,
+    "git_provider_webhook": false,
+    "schedule": false,
+  }
+}
+`, projectName, environmentName, acctest_helper.DBT_CLOUD_VERSION, jobName, step)
+}
+
 func testAccDbtCloudJobResourceScheduleConfig(
 	jobName, projectName, environmentName, scheduleType string,
 ) string {
@@ -570,7 +788,7 @@ resource "dbtcloud_job" "test_job" {
   }
   %s
 }
-`, projectName, environmentName, DBT_CLOUD_VERSION, jobName, scheduleConfig)
+`, projectName, environmentName, acctest_helper.DBT_CLOUD_VERSION, jobName, scheduleConfig)
 }
 
 func testAccDbtCloudJobResourceBasicConfigTriggers(
@@ -586,7 +804,7 @@ func testAccDbtCloudJobResourceBasicConfigTriggers(
 	if trigger == "git" {
 		git_trigger = "true"
 		deferringConfig = "deferring_environment_id = dbtcloud_environment.test_job_environment.environment_id"
-		if !isDbtCloudPR() {
+		if !acctest_helper.IsDbtCloudPR() {
 			// we don't want to activate it in Cloud PRs as the setting need to be ON
 			// TODO: When TF supports account settings, activate the setting in this test and remove this logic
 			run_compare_changes = "true"
@@ -627,7 +845,7 @@ resource "dbtcloud_job" "test_job" {
   run_compare_changes = %s
   %s
 }
-`, projectName, environmentName, DBT_CLOUD_VERSION, jobName, git_trigger, git_trigger, schedule_trigger, on_merge_trigger, run_compare_changes, deferringConfig)
+`, projectName, environmentName, acctest_helper.DBT_CLOUD_VERSION, jobName, git_trigger, git_trigger, schedule_trigger, on_merge_trigger, run_compare_changes, deferringConfig)
 }
 
 func testAccCheckDbtCloudJobExists(resource string) resource.TestCheckFunc {
@@ -643,7 +861,7 @@ func testAccCheckDbtCloudJobExists(resource string) resource.TestCheckFunc {
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		_, err = apiClient.GetJob(rs.Primary.ID)
+		_, err = apiClient.GetJob(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -661,7 +879,7 @@ func testAccCheckDbtCloudJobDestroy(s *terraform.State) error {
 		if rs.Type != "dbtcloud_job" {
 			continue
 		}
-		_, err := apiClient.GetJob(rs.Primary.ID)
+		_, err := apiClient.GetJob(context.Background(), rs.Primary.ID)
 		if err == nil {
 			return fmt.Errorf("Job still exists")
 		}
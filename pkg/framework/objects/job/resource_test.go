@@ -0,0 +1,82 @@
+package job
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/testutil"
+)
+
+// a state saved by the SDKv2 version of the resource, with the zero values of the attributes not configured
+const jobStateV0 = `{
+	"id": "123",
+	"project_id": 10,
+	"environment_id": 20,
+	"name": "Daily run",
+	"description": "",
+	"execute_steps": ["dbt build"],
+	"dbt_version": "",
+	"is_active": true,
+	"triggers": {"github_webhook": false, "git_provider_webhook": false, "schedule": true, "custom_branch_only": false},
+	"num_threads": 4,
+	"target_name": "default",
+	"generate_docs": false,
+	"run_generate_sources": false,
+	"schedule_type": "days_of_week",
+	"schedule_interval": 1,
+	"schedule_hours": [],
+	"schedule_days": [1, 2],
+	"schedule_cron": "",
+	"deferring_job_id": 0,
+	"deferring_environment_id": 30,
+	"self_deferring": false,
+	"timeout_seconds": 0,
+	"triggers_on_draft_pr": false,
+	"job_completion_trigger_condition": [{"job_id": 456, "project_id": 10, "statuses": ["success", "error"]}],
+	"run_compare_changes": false,
+	"timeouts": null
+}`
+
+func TestJobResourceUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+	state := testutil.UpgradeState[JobResourceModel](t, &jobResource{}, 0, jobStateV0)
+
+	if state.ID.ValueInt64() != 123 {
+		t.Errorf("expected the ID 123, got %s", state.ID)
+	}
+	if !state.DbtVersion.IsNull() || !state.ScheduleCron.IsNull() || !state.DeferringJobID.IsNull() {
+		t.Errorf(
+			"expected the empty values to be null, got dbt_version %s, schedule_cron %s and deferring_job_id %s",
+			state.DbtVersion,
+			state.ScheduleCron,
+			state.DeferringJobID,
+		)
+	}
+	if !state.ScheduleHours.IsNull() || len(state.ScheduleDays.Elements()) != 2 {
+		t.Errorf("expected null schedule_hours and 2 schedule_days, got %s and %s", state.ScheduleHours, state.ScheduleDays)
+	}
+	if state.DeferringEnvironmentID.ValueInt64() != 30 {
+		t.Errorf("expected the deferring environment 30, got %s", state.DeferringEnvironmentID)
+	}
+
+	triggers, diags := state.triggers(ctx)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if !triggers.Schedule.ValueBool() || triggers.OnMerge.IsNull() || triggers.OnMerge.ValueBool() {
+		t.Errorf("expected schedule to be true and on_merge to be false, got %+v", triggers)
+	}
+	if triggers.CustomBranchOnly.IsNull() || triggers.CustomBranchOnly.ValueBool() {
+		t.Errorf("expected custom_branch_only to be kept as false, got %s", triggers.CustomBranchOnly)
+	}
+
+	completionTrigger, diags := state.completionTrigger(ctx)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if completionTrigger == nil ||
+		completionTrigger.Condition.JobID != 456 ||
+		len(completionTrigger.Condition.Statuses) != 2 {
+		t.Errorf("expected the completion trigger on the job 456 with 2 statuses, got %+v", completionTrigger)
+	}
+}
@@ -0,0 +1,147 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+// NEXT_RUNS_COUNT is the number of fire times of the schedule listed in next_runs
+const NEXT_RUNS_COUNT = 5
+
+var _ validator.String = cronExpressionValidator{}
+
+// cronExpressionValidator checks at plan time that schedule_cron is a valid cron expression that runs at least once
+type cronExpressionValidator struct{}
+
+func (v cronExpressionValidator) Description(_ context.Context) string {
+	return "value must be a cron expression with 5 fields: minute, hour, day of month, month and day of week"
+}
+
+func (v cronExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronExpressionValidator) ValidateString(
+	_ context.Context,
+	req validator.StringRequest,
+	resp *validator.StringResponse,
+) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	schedule, err := helper.ParseCron(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid cron expression", err.Error())
+		return
+	}
+	if _, ok := schedule.Next(time.Now()); !ok {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid cron expression",
+			fmt.Sprintf(
+				"The cron expression %q never runs, e.g. it is set on a day that doesn't exist in the months selected.",
+				req.ConfigValue.ValueString(),
+			),
+		)
+	}
+}
+
+// nextRunsValue returns the next NEXT_RUNS_COUNT fire times of the cron expression, in UTC
+func nextRunsValue(cron string) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	schedule, err := helper.ParseCron(cron)
+	if err != nil {
+		diags.AddWarning("Unable to compute the next runs of the job", err.Error())
+		return types.ListNull(types.StringType), diags
+	}
+
+	runs := lo.Map(schedule.NextRuns(time.Now(), NEXT_RUNS_COUNT), func(run time.Time, _ int) attr.Value {
+		return types.StringValue(run.Format(time.RFC3339))
+	})
+	return types.ListValueMust(types.StringType, runs), diags
+}
+
+// jobNextRuns returns the next runs of the job returned by the API, the list is empty when the job isn't scheduled
+// the API returns the cron expression equivalent to the schedule for all the schedule types
+func jobNextRuns(job *dbt_cloud.Job) (types.List, diag.Diagnostics) {
+	if !job.Triggers.Schedule {
+		return types.ListValueMust(types.StringType, []attr.Value{}), nil
+	}
+
+	cron := job.Schedule.Cron
+	if cron == "" && job.Schedule.Date.Cron != nil {
+		cron = *job.Schedule.Date.Cron
+	}
+	if cron == "" {
+		return types.ListNull(types.StringType), nil
+	}
+	return nextRunsValue(cron)
+}
+
+// planNextRuns sets next_runs in the plan
+// the value of the state is kept while the schedule doesn't change, so that the time passing doesn't show changes
+// otherwise it is computed for the custom cron schedules and left unknown for the other types, until the API returns
+// their cron expression
+func (r *jobResource) planNextRuns(
+	ctx context.Context,
+	req resource.ModifyPlanRequest,
+	resp *resource.ModifyPlanResponse,
+) {
+	var plan JobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state JobResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if plan.Triggers.Equal(state.Triggers) &&
+			plan.ScheduleType.Equal(state.ScheduleType) &&
+			plan.ScheduleInterval.Equal(state.ScheduleInterval) &&
+			plan.ScheduleHours.Equal(state.ScheduleHours) &&
+			plan.ScheduleDays.Equal(state.ScheduleDays) &&
+			plan.ScheduleCron.Equal(state.ScheduleCron) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("next_runs"), state.NextRuns)...)
+			return
+		}
+	}
+
+	if plan.Triggers.IsUnknown() {
+		return
+	}
+	triggers, diags := plan.triggers(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || triggers.Schedule.IsUnknown() {
+		return
+	}
+
+	nextRuns := types.ListUnknown(types.StringType)
+	switch {
+	case !triggers.Schedule.ValueBool():
+		nextRuns = types.ListValueMust(types.StringType, []attr.Value{})
+	case plan.ScheduleType.ValueString() == "custom_cron" &&
+		!plan.ScheduleCron.IsNull() && !plan.ScheduleCron.IsUnknown():
+		nextRuns, diags = nextRunsValue(plan.ScheduleCron.ValueString())
+		resp.Diagnostics.Append(diags...)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("next_runs"), nextRuns)...)
+}
@@ -0,0 +1,82 @@
+package job
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCronExpressionValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		cron     types.String
+		expected string
+	}{
+		{cron: types.StringValue("0 6,18 * * MON-FRI")},
+		{cron: types.StringNull()},
+		{cron: types.StringUnknown()},
+		{cron: types.StringValue("0 25 * * *"), expected: `invalid hour "25"`},
+		{cron: types.StringValue("0 6 * *"), expected: "needs 5 fields"},
+		{cron: types.StringValue("0 0 31 4 *"), expected: "never runs"},
+	}
+
+	for _, tc := range testCases {
+		req := validator.StringRequest{Path: path.Root("schedule_cron"), ConfigValue: tc.cron}
+		resp := validator.StringResponse{}
+		cronExpressionValidator{}.ValidateString(context.Background(), req, &resp)
+
+		if tc.expected == "" {
+			if resp.Diagnostics.HasError() {
+				t.Errorf("unexpected errors for %s: %v", tc.cron, resp.Diagnostics)
+			}
+			continue
+		}
+		if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tc.expected) {
+			t.Errorf("expected an error containing %q for %s, got %v", tc.expected, tc.cron, resp.Diagnostics)
+		}
+	}
+}
+
+func TestJobNextRuns(t *testing.T) {
+	t.Parallel()
+
+	job := &dbt_cloud.Job{}
+	job.Triggers.Schedule = true
+	job.Schedule.Cron = "0 * * * *"
+
+	nextRuns, diags := jobNextRuns(job)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	runs := []string{}
+	if diags := nextRuns.ElementsAs(context.Background(), &runs, false); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if len(runs) != NEXT_RUNS_COUNT {
+		t.Fatalf("expected %d runs, got %v", NEXT_RUNS_COUNT, runs)
+	}
+	for i, run := range runs {
+		runTime, err := time.Parse(time.RFC3339, run)
+		if err != nil || runTime.Minute() != 0 || runTime.Location() != time.UTC {
+			t.Errorf("expected the run %d to be at the start of an hour in UTC, got %s", i, run)
+		}
+		if i > 0 && run <= runs[i-1] {
+			t.Errorf("expected the runs to be sorted, got %v", runs)
+		}
+	}
+
+	// the cron of the job is ignored when it isn't scheduled
+	job.Triggers.Schedule = false
+	nextRuns, _ = jobNextRuns(job)
+	if nextRuns.IsNull() || len(nextRuns.Elements()) != 0 {
+		t.Errorf("expected an empty list for a job not scheduled, got %s", nextRuns)
+	}
+}
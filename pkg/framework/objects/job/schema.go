@@ -2,10 +2,26 @@ package job
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -44,170 +60,170 @@ func (d *jobsDataSource) Schema(
 	req datasource.SchemaRequest,
 	resp *datasource.SchemaResponse,
 ) {
-	resp.Schema = schema.Schema{
+	resp.Schema = datasource_schema.Schema{
 		Description: "Retrieve all the jobs for a given dbt Cloud project or environment along with the environment details for the jobs. This will return both the jobs created from Terraform but also the jobs created in the dbt Cloud UI.",
-		Attributes: map[string]schema.Attribute{
-			"project_id": schema.Int64Attribute{
+		Attributes: map[string]datasource_schema.Attribute{
+			"project_id": datasource_schema.Int64Attribute{
 				Optional:    true,
 				Description: "The ID of the project for which we want to retrieve the jobs (one of `project_id` or `environment_id` must be set)",
 			},
-			"environment_id": schema.Int64Attribute{
+			"environment_id": datasource_schema.Int64Attribute{
 				Optional:    true,
 				Description: "The ID of the environment for which we want to retrieve the jobs (one of `project_id` or `environment_id` must be set)",
 			},
-			"jobs": schema.SetNestedAttribute{
+			"jobs": datasource_schema.SetNestedAttribute{
 				Computed:    true,
 				Description: "Set of jobs with their details",
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"execution": schema.SingleNestedAttribute{
+				NestedObject: datasource_schema.NestedAttributeObject{
+					Attributes: map[string]datasource_schema.Attribute{
+						"execution": datasource_schema.SingleNestedAttribute{
 							Computed: true,
-							Attributes: map[string]schema.Attribute{
-								"timeout_seconds": schema.Int64Attribute{
+							Attributes: map[string]datasource_schema.Attribute{
+								"timeout_seconds": datasource_schema.Int64Attribute{
 									Computed:    true,
 									Description: "The number of seconds before the job times out",
 								},
 							},
 						},
-						"generate_docs": schema.BoolAttribute{
+						"generate_docs": datasource_schema.BoolAttribute{
 							Computed:    true,
 							Description: "Whether the job generate docs",
 						},
-						"run_generate_sources": schema.BoolAttribute{
+						"run_generate_sources": datasource_schema.BoolAttribute{
 							Computed:    true,
 							Description: "Whether the job test source freshness",
 						},
-						"run_compare_changes": schema.BoolAttribute{
+						"run_compare_changes": datasource_schema.BoolAttribute{
 							Computed:    true,
 							Description: "Whether the job should compare data changes introduced by the code change in the PR",
 						},
-						"id": schema.Int64Attribute{
+						"id": datasource_schema.Int64Attribute{
 							Computed:    true,
 							Description: "The ID of the job",
 						},
-						"project_id": schema.Int64Attribute{
+						"project_id": datasource_schema.Int64Attribute{
 							Computed:    true,
 							Description: "The ID of the project",
 						},
-						"environment_id": schema.Int64Attribute{
+						"environment_id": datasource_schema.Int64Attribute{
 							Computed:    true,
 							Description: "The ID of environment",
 						},
-						"name": schema.StringAttribute{
+						"name": datasource_schema.StringAttribute{
 							Computed:    true,
 							Description: "The name of the job",
 						},
-						"description": schema.StringAttribute{
+						"description": datasource_schema.StringAttribute{
 							Computed:    true,
 							Description: "The description of the job",
 						},
-						"dbt_version": schema.StringAttribute{
+						"dbt_version": datasource_schema.StringAttribute{
 							Computed:    true,
 							Description: "The version of dbt used for the job. If not set, the environment version will be used.",
 						},
-						"execute_steps": schema.ListAttribute{
+						"execute_steps": datasource_schema.ListAttribute{
 							Computed:    true,
 							ElementType: types.StringType,
 							Description: "The list of steps to run in the job",
 						},
-						"deferring_job_definition_id": schema.Int64Attribute{
+						"deferring_job_definition_id": datasource_schema.Int64Attribute{
 							Computed:    true,
 							Description: "[Deprecated - deferral is now set at the environment level] The ID of the job definition this job defers to",
 						},
-						"deferring_environment_id": schema.Int64Attribute{
+						"deferring_environment_id": datasource_schema.Int64Attribute{
 							Computed:    true,
 							Description: "The ID of the environment this job defers to",
 						},
-						"triggers": schema.SingleNestedAttribute{
+						"triggers": datasource_schema.SingleNestedAttribute{
 							Computed: true,
-							Attributes: map[string]schema.Attribute{
-								"github_webhook": schema.BoolAttribute{
+							Attributes: map[string]datasource_schema.Attribute{
+								"github_webhook": datasource_schema.BoolAttribute{
 									Computed:    true,
 									Description: "Whether the job runs automatically on PR creation",
 								},
-								"git_provider_webhook": schema.BoolAttribute{
+								"git_provider_webhook": datasource_schema.BoolAttribute{
 									Computed:    true,
 									Description: "Whether the job runs automatically on PR creation",
 								},
-								"schedule": schema.BoolAttribute{
+								"schedule": datasource_schema.BoolAttribute{
 									Computed:    true,
 									Description: "Whether the job runs on a schedule",
 								},
-								"on_merge": schema.BoolAttribute{
+								"on_merge": datasource_schema.BoolAttribute{
 									Computed:    true,
 									Description: "Whether the job runs automatically once a PR is merged",
 								},
 							},
 						},
-						"settings": schema.SingleNestedAttribute{
+						"settings": datasource_schema.SingleNestedAttribute{
 							Computed: true,
-							Attributes: map[string]schema.Attribute{
-								"threads": schema.Int64Attribute{
+							Attributes: map[string]datasource_schema.Attribute{
+								"threads": datasource_schema.Int64Attribute{
 									Computed:    true,
 									Description: "Number of threads to run dbt with",
 								},
-								"target_name": schema.StringAttribute{
+								"target_name": datasource_schema.StringAttribute{
 									Computed:    true,
 									Description: "Value for `target.name` in the Jinja context",
 								},
 							},
 						},
-						"schedule": schema.SingleNestedAttribute{
+						"schedule": datasource_schema.SingleNestedAttribute{
 							Computed: true,
-							Attributes: map[string]schema.Attribute{
-								"cron": schema.StringAttribute{
+							Attributes: map[string]datasource_schema.Attribute{
+								"cron": datasource_schema.StringAttribute{
 									Computed:    true,
 									Description: "The cron schedule for the job. Only used if triggers.schedule is true",
 								},
 							},
 						},
-						"job_type": schema.StringAttribute{
+						"job_type": datasource_schema.StringAttribute{
 							Computed:    true,
 							Description: "The type of job (e.g. CI, scheduled)",
 						},
-						"triggers_on_draft_pr": schema.BoolAttribute{
+						"triggers_on_draft_pr": datasource_schema.BoolAttribute{
 							Computed:    true,
 							Description: "Whether the CI job should be automatically triggered on draft PRs",
 						},
-						"environment": schema.SingleNestedAttribute{
+						"environment": datasource_schema.SingleNestedAttribute{
 							Computed:    true,
 							Description: "Details of the environment the job is running in",
-							Attributes: map[string]schema.Attribute{
-								"project_id": schema.Int64Attribute{
+							Attributes: map[string]datasource_schema.Attribute{
+								"project_id": datasource_schema.Int64Attribute{
 									Computed: true,
 								},
-								"id": schema.Int64Attribute{
+								"id": datasource_schema.Int64Attribute{
 									Computed:    true,
 									Description: "ID of the environment",
 								},
-								"name": schema.StringAttribute{
+								"name": datasource_schema.StringAttribute{
 									Computed:    true,
 									Description: "Name of the environment",
 								},
-								"deployment_type": schema.StringAttribute{
+								"deployment_type": datasource_schema.StringAttribute{
 									Computed:    true,
 									Description: "Type of deployment environment: staging, production",
 								},
-								"type": schema.StringAttribute{
+								"type": datasource_schema.StringAttribute{
 									Computed:    true,
 									Description: "Environment type: development or deployment",
 								},
 							},
 						},
-						"job_completion_trigger_condition": schema.SingleNestedAttribute{
+						"job_completion_trigger_condition": datasource_schema.SingleNestedAttribute{
 							Computed:    true,
 							Description: "Whether the job is triggered by the completion of another job",
-							Attributes: map[string]schema.Attribute{
-								"condition": schema.SingleNestedAttribute{
+							Attributes: map[string]datasource_schema.Attribute{
+								"condition": datasource_schema.SingleNestedAttribute{
 									Computed: true,
-									Attributes: map[string]schema.Attribute{
-										"job_id": schema.Int64Attribute{
+									Attributes: map[string]datasource_schema.Attribute{
+										"job_id": datasource_schema.Int64Attribute{
 											Computed: true,
 										},
-										"project_id": schema.Int64Attribute{
+										"project_id": datasource_schema.Int64Attribute{
 											Computed: true,
 										},
-										"statuses": schema.SetAttribute{
+										"statuses": datasource_schema.SetAttribute{
 											Computed:    true,
 											ElementType: types.StringType,
 										},
@@ -221,3 +237,491 @@ func (d *jobsDataSource) Schema(
 		},
 	}
 }
+
+func (d jobRunsDataSource) ValidateConfig(
+	ctx context.Context,
+	req datasource.ValidateConfigRequest,
+	resp *datasource.ValidateConfigResponse,
+) {
+	var data JobRunsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.CreatedAfter.IsNull() || data.CreatedAfter.IsUnknown() {
+		return
+	}
+	if _, err := time.Parse(time.RFC3339, data.CreatedAfter.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("created_after"),
+			"Invalid Attribute Configuration",
+			fmt.Sprintf(
+				"created_after needs to be a date in the RFC 3339 format, e.g. 2024-06-01T00:00:00Z, got %q",
+				data.CreatedAfter.ValueString(),
+			),
+		)
+	}
+}
+
+func (d *jobRunsDataSource) Schema(
+	ctx context.Context,
+	req datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = datasource_schema.Schema{
+		Description: "Retrieve the most recent runs of the account, optionally filtered by job, environment, project, status or creation date. The runs are returned from the most recent.",
+		Attributes: map[string]datasource_schema.Attribute{
+			"job_id": datasource_schema.Int64Attribute{
+				Optional:    true,
+				Description: "The ID of the job for which we want to retrieve the runs",
+			},
+			"environment_id": datasource_schema.Int64Attribute{
+				Optional:    true,
+				Description: "The ID of the environment for which we want to retrieve the runs",
+			},
+			"project_id": datasource_schema.Int64Attribute{
+				Optional:    true,
+				Description: "The ID of the project for which we want to retrieve the runs",
+			},
+			"status": datasource_schema.StringAttribute{
+				Optional:    true,
+				Description: "Only retrieve the runs with this status. Possible values are `queued`, `starting`, `running`, `success`, `error` and `cancelled`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(runStatuses...),
+				},
+			},
+			"created_after": datasource_schema.StringAttribute{
+				Optional:    true,
+				Description: "Only retrieve the runs created after this date, in the RFC 3339 format, e.g. `2024-06-01T00:00:00Z`",
+			},
+			"limit": datasource_schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The maximum number of runs to retrieve - Defaults to %d", defaultRunsLimit),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"runs": datasource_schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "List of runs with their details, from the most recent",
+				NestedObject: datasource_schema.NestedAttributeObject{
+					Attributes: map[string]datasource_schema.Attribute{
+						"id": datasource_schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the run",
+						},
+						"job_id": datasource_schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the job of the run",
+						},
+						"environment_id": datasource_schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the environment of the run",
+						},
+						"project_id": datasource_schema.Int64Attribute{
+							Computed:    true,
+							Description: "The ID of the project of the run",
+						},
+						"status": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of the run, one of `queued`, `starting`, `running`, `success`, `error` or `cancelled`",
+						},
+						"status_message": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The message explaining the status of the run, e.g. the error when it failed",
+						},
+						"git_branch": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The git branch the run used",
+						},
+						"git_sha": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The git SHA of the commit the run used",
+						},
+						"trigger_cause": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The cause of the run, e.g. `Kicked off from UI by ...` or `Triggered by Terraform`",
+						},
+						"created_at": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The date when the run was created",
+						},
+						"started_at": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The date when the run started, null while it is queued",
+						},
+						"finished_at": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The date when the run finished, null until it completes",
+						},
+						"duration": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The duration of the run, in the format `HH:MM:SS`",
+						},
+						"href": datasource_schema.StringAttribute{
+							Computed:    true,
+							Description: "The URL of the run in dbt Cloud",
+						},
+						"steps": datasource_schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "Summary of the steps of the run, in their order of execution",
+							NestedObject: datasource_schema.NestedAttributeObject{
+								Attributes: map[string]datasource_schema.Attribute{
+									"index": datasource_schema.Int64Attribute{
+										Computed:    true,
+										Description: "The position of the step in the run",
+									},
+									"name": datasource_schema.StringAttribute{
+										Computed:    true,
+										Description: "The name of the step, e.g. `Clone git repository`",
+									},
+									"status": datasource_schema.StringAttribute{
+										Computed:    true,
+										Description: "The status of the step, one of `queued`, `starting`, `running`, `success`, `error` or `cancelled`",
+									},
+									"duration": datasource_schema.StringAttribute{
+										Computed:    true,
+										Description: "The duration of the step, in the format `HH:MM:SS`",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var scheduleTypes = []string{
+	"every_day",
+	"days_of_week",
+	"custom_cron",
+}
+
+// runStatuses are the keys of dbt_cloud.RunStatuses, in the order of the lifecycle of a run
+var runStatuses = []string{
+	"queued",
+	"starting",
+	"running",
+	"success",
+	"error",
+	"cancelled",
+}
+
+const defaultRunsLimit = 10
+
+var completionTriggerStatuses = []string{
+	"success",
+	"error",
+	"canceled",
+}
+
+func (r *jobResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resource_schema.Schema{
+		// the SDKv2 resource was at version 0, the state is upgraded with UpgradeState
+		Version: 1,
+		Attributes: map[string]resource_schema.Attribute{
+			"id": resource_schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the job",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": resource_schema.Int64Attribute{
+				Required:    true,
+				Description: "Project ID to create the job in",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"environment_id": resource_schema.Int64Attribute{
+				Required:    true,
+				Description: "Environment ID to create the job in",
+			},
+			"name": resource_schema.StringAttribute{
+				Required:    true,
+				Description: "Job name",
+			},
+			"description": resource_schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Description for the job",
+			},
+			"execute_steps": resource_schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(executeStepValidator{}),
+				},
+				Description: "List of commands to execute for the job. Each step is validated at plan time: it needs to start with `dbt`, use a command supported by dbt Cloud (`build`, `run`, `test`, `seed`, `snapshot`, `source freshness`, `docs generate`, `compile`, `ls`, `retry`, `clone` or `run-operation`) with flags valid for this command, and close its quotes",
+			},
+			"dbt_version": resource_schema.StringAttribute{
+				Optional:    true,
+				Description: "Version number of dbt to use in this job, usually in the format 1.2.0-latest rather than core versions",
+			},
+			"is_active": resource_schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Should always be set to true as setting it to false is the same as creating a job in a deleted state. To create/keep a job in a 'deactivated' state, check  the `triggers` config.",
+			},
+			"triggers": resource_schema.SingleNestedAttribute{
+				Required:    true,
+				Description: "Flags for which types of triggers to use, the values are `github_webhook`, `git_provider_webhook`, `schedule` and `on_merge`. All flags should be listed and set with `true` or `false`. When `on_merge` is `true`, all the other values must be false.<br>`custom_branch_only` used to be allowed but has been deprecated from the API. The jobs will use the custom branch of the environment. Please remove the `custom_branch_only` from your config. <br>To create a job in a 'deactivated' state, set all to `false`.",
+				Attributes: map[string]resource_schema.Attribute{
+					"github_webhook": resource_schema.BoolAttribute{
+						Required:    true,
+						Description: "Whether the job runs automatically on PR creation",
+					},
+					"git_provider_webhook": resource_schema.BoolAttribute{
+						Required:    true,
+						Description: "Whether the job runs automatically on PR creation",
+					},
+					"schedule": resource_schema.BoolAttribute{
+						Required:    true,
+						Description: "Whether the job runs on a schedule",
+					},
+					"on_merge": resource_schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						Description: "Whether the job runs automatically once a PR is merged",
+					},
+					"custom_branch_only": resource_schema.BoolAttribute{
+						Optional:           true,
+						Description:        "Deprecated, the jobs use the custom branch of the environment",
+						DeprecationMessage: "`custom_branch_only` has been deprecated from the API and is not used anymore, please remove it from your config",
+					},
+				},
+			},
+			"num_threads": resource_schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Description: "Number of threads to use in the job",
+			},
+			"target_name": resource_schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("default"),
+				Description: "Target name for the dbt profile",
+			},
+			"generate_docs": resource_schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Flag for whether the job should generate documentation",
+			},
+			"run_generate_sources": resource_schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Flag for whether the job should add a `dbt source freshness` step to the job. The difference between manually adding a step with `dbt source freshness` in the job steps or using this flag is that with this flag, a failed freshness will still allow the following steps to run.",
+			},
+			"schedule_type": resource_schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("every_day"),
+				Description: "Type of schedule to use, one of every_day/ days_of_week/ custom_cron",
+				Validators: []validator.String{
+					stringvalidator.OneOf(scheduleTypes...),
+				},
+			},
+			"schedule_interval": resource_schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(1),
+				Description: "Number of hours between job executions if running on a schedule",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 23),
+					int64validator.ConflictsWith(
+						path.MatchRoot("schedule_hours"),
+						path.MatchRoot("schedule_cron"),
+					),
+				},
+			},
+			"schedule_hours": resource_schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "List of hours to execute the job at if running on a schedule",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(
+						path.MatchRoot("schedule_interval"),
+						path.MatchRoot("schedule_cron"),
+					),
+				},
+			},
+			"schedule_days": resource_schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Description: "List of days of week as numbers (0 = Sunday, 7 = Saturday) to execute the job at if running on a schedule",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"schedule_cron": resource_schema.StringAttribute{
+				Optional:    true,
+				Description: "Custom cron expression for schedule, with 5 fields (minute, hour, day of month, month and day of week) in UTC, e.g. `0 6,18 * * MON-FRI`. It is validated at plan time",
+				Validators: []validator.String{
+					cronExpressionValidator{},
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("schedule_interval"),
+						path.MatchRoot("schedule_hours"),
+					),
+				},
+			},
+			"deferring_job_id": resource_schema.Int64Attribute{
+				Optional:    true,
+				Description: "Job identifier that this job defers to (legacy deferring approach)",
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(
+						path.MatchRoot("self_deferring"),
+						path.MatchRoot("deferring_environment_id"),
+					),
+				},
+			},
+			"deferring_environment_id": resource_schema.Int64Attribute{
+				Optional:    true,
+				Description: "Environment identifier that this job defers to (new deferring approach)",
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(
+						path.MatchRoot("self_deferring"),
+						path.MatchRoot("deferring_job_id"),
+					),
+				},
+			},
+			"self_deferring": resource_schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether this job defers on a previous run of itself",
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(
+						path.MatchRoot("deferring_job_id"),
+					),
+				},
+			},
+			"timeout_seconds": resource_schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Description: "Number of seconds to allow the job to run before timing out",
+			},
+			"triggers_on_draft_pr": resource_schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the CI job should be automatically triggered on draft PRs",
+			},
+			"run_compare_changes": resource_schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "Whether the CI job should compare data changes introduced by the code changes. Requires `deferring_environment_id` to be set. (Advanced CI needs to be activated in the dbt Cloud Account Settings first as well)",
+			},
+			"next_runs": resource_schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The next 5 times the job will run on its schedule, in UTC and in the RFC 3339 format. Empty when the job doesn't run on a schedule. The list is refreshed when the job is read and is shown in the plan when the `custom_cron` schedule changes",
+			},
+		},
+		Blocks: map[string]resource_schema.Block{
+			// this stays a block so that the configs written for the SDKv2 resource keep working
+			"job_completion_trigger_condition": resource_schema.SingleNestedBlock{
+				Description: "Which other job should trigger this job when it finishes, and on which conditions (sometimes referred as 'job chaining').",
+				Attributes: map[string]resource_schema.Attribute{
+					"job_id": resource_schema.Int64Attribute{
+						Required:    true,
+						Description: "The ID of the job that would trigger this job after completion.",
+					},
+					"project_id": resource_schema.Int64Attribute{
+						Required:    true,
+						Description: "The ID of the project where the trigger job is running in.",
+					},
+					"statuses": resource_schema.SetAttribute{
+						Required:    true,
+						ElementType: types.StringType,
+						Description: "List of statuses to trigger the job on. Possible values are `success`, `error` and `canceled`.",
+						Validators: []validator.Set{
+							setvalidator.ValueStringsAre(
+								stringvalidator.OneOf(completionTriggerStatuses...),
+							),
+						},
+					},
+				},
+			},
+			"timeouts": helper.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+// jobResourceSchemaV0 is the schema of the SDKv2 resource, it is only used to read the state to upgrade
+func jobResourceSchemaV0(ctx context.Context) resource_schema.Schema {
+	return resource_schema.Schema{
+		Attributes: map[string]resource_schema.Attribute{
+			"id":             resource_schema.StringAttribute{Computed: true},
+			"project_id":     resource_schema.Int64Attribute{Required: true},
+			"environment_id": resource_schema.Int64Attribute{Required: true},
+			"name":           resource_schema.StringAttribute{Required: true},
+			"description":    resource_schema.StringAttribute{Optional: true},
+			"execute_steps": resource_schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"dbt_version": resource_schema.StringAttribute{Optional: true},
+			"is_active":   resource_schema.BoolAttribute{Optional: true},
+			"triggers": resource_schema.MapAttribute{
+				Required:    true,
+				ElementType: types.BoolType,
+			},
+			"num_threads":          resource_schema.Int64Attribute{Optional: true},
+			"target_name":          resource_schema.StringAttribute{Optional: true},
+			"generate_docs":        resource_schema.BoolAttribute{Optional: true},
+			"run_generate_sources": resource_schema.BoolAttribute{Optional: true},
+			"schedule_type":        resource_schema.StringAttribute{Optional: true},
+			"schedule_interval":    resource_schema.Int64Attribute{Optional: true},
+			"schedule_hours": resource_schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+			},
+			"schedule_days": resource_schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.Int64Type,
+			},
+			"schedule_cron":            resource_schema.StringAttribute{Optional: true},
+			"deferring_job_id":         resource_schema.Int64Attribute{Optional: true},
+			"deferring_environment_id": resource_schema.Int64Attribute{Optional: true},
+			"self_deferring":           resource_schema.BoolAttribute{Optional: true},
+			"timeout_seconds":          resource_schema.Int64Attribute{Optional: true},
+			"triggers_on_draft_pr":     resource_schema.BoolAttribute{Optional: true},
+			"run_compare_changes":      resource_schema.BoolAttribute{Optional: true},
+		},
+		Blocks: map[string]resource_schema.Block{
+			"job_completion_trigger_condition": resource_schema.SetNestedBlock{
+				NestedObject: resource_schema.NestedBlockObject{
+					Attributes: map[string]resource_schema.Attribute{
+						"job_id":     resource_schema.Int64Attribute{Required: true},
+						"project_id": resource_schema.Int64Attribute{Required: true},
+						"statuses": resource_schema.SetAttribute{
+							Required:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"timeouts": helper.TimeoutsBlock(ctx),
+		},
+	}
+}
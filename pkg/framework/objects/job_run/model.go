@@ -0,0 +1,42 @@
+package job_run
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type JobRunResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	JobID             types.Int64    `tfsdk:"job_id"`
+	Cause             types.String   `tfsdk:"cause"`
+	GitBranch         types.String   `tfsdk:"git_branch"`
+	StepsOverride     types.List     `tfsdk:"steps_override"`
+	Triggers          types.Map      `tfsdk:"triggers"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	FailOnError       types.Bool     `tfsdk:"fail_on_error"`
+	RunID             types.Int64    `tfsdk:"run_id"`
+	Status            types.String   `tfsdk:"status"`
+	Href              types.String   `tfsdk:"href"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m *JobRunResourceModel) triggerRunRequest(ctx context.Context) (dbt_cloud.TriggerRunRequest, diag.Diagnostics) {
+	request := dbt_cloud.TriggerRunRequest{
+		Cause:     m.Cause.ValueString(),
+		GitBranch: m.GitBranch.ValueString(),
+	}
+	diags := m.StepsOverride.ElementsAs(ctx, &request.StepsOverride, false)
+	return request, diags
+}
+
+func (m *JobRunResourceModel) setFromRun(run dbt_cloud.Run) {
+	m.ID = types.StringValue(strconv.Itoa(*run.ID))
+	m.RunID = types.Int64Value(int64(*run.ID))
+	m.Status = types.StringValue(run.StatusHumanized)
+	m.Href = types.StringValue(run.Href)
+}
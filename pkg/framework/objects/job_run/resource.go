@@ -0,0 +1,214 @@
+package job_run
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var (
+	_ resource.Resource              = &jobRunResource{}
+	_ resource.ResourceWithConfigure = &jobRunResource{}
+)
+
+func JobRunResource() resource.Resource {
+	return &jobRunResource{}
+}
+
+type jobRunResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *jobRunResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_job_run"
+}
+
+func (r *jobRunResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
+
+// runError returns an error when the run completed without success
+func runError(run dbt_cloud.Run) error {
+	if !run.IsComplete || run.IsSuccess {
+		return nil
+	}
+
+	message := fmt.Sprintf("the run %d finished with the status %s", *run.ID, run.StatusHumanized)
+	if run.StatusMessage != nil && *run.StatusMessage != "" {
+		message += ": " + *run.StatusMessage
+	}
+	if run.Href != "" {
+		message += "\nSee " + run.Href
+	}
+	return errors.New(message)
+}
+
+func (r *jobRunResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan JobRunResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	request, diags := plan.triggerRunRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	run, err := r.client.TriggerRun(ctx, int(plan.JobID.ValueInt64()), request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to trigger the job run",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+	plan.setFromRun(*run)
+
+	if !plan.WaitForCompletion.ValueBool() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	// the run is saved in the state even if waiting fails, the resource is then tainted and triggers a new run
+	completedRun, err := r.client.WaitForRun(ctx, *run.ID, dbt_cloud.RUN_POLL_INTERVAL)
+	if completedRun != nil {
+		plan.setFromRun(*completedRun)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to wait for the job run to complete",
+			"Error: "+err.Error()+"\nIncrease the create timeout or set wait_for_completion to false for long runs",
+		)
+		return
+	}
+
+	if err := runError(*completedRun); err != nil && plan.FailOnError.ValueBool() {
+		resp.Diagnostics.AddError("The job run failed", err.Error())
+	}
+}
+
+func (r *jobRunResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state JobRunResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	run, err := r.client.GetRun(ctx, int(state.RunID.ValueInt64()))
+	if err != nil {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			resp.Diagnostics.AddWarning(
+				"Resource not found",
+				"The job run was not found and has been removed from the state.",
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		helper.AddReadError(&resp.Diagnostics, "Error getting the job run", err)
+		return
+	}
+
+	state.setFromRun(*run)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only saves the options of the resource, the changes to the parameters of the run trigger a new run
+func (r *jobRunResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state JobRunResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = state.ID
+	plan.RunID = state.RunID
+	plan.Status = state.Status
+	plan.Href = state.Href
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete cancels the run if it didn't complete yet, the completed runs are only removed from the state
+func (r *jobRunResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state JobRunResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	runID := int(state.RunID.ValueInt64())
+	run, err := r.client.GetRun(ctx, runID)
+	if err != nil {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			return
+		}
+		helper.AddReadError(&resp.Diagnostics, "Error getting the job run", err)
+		return
+	}
+	if run.IsComplete {
+		return
+	}
+
+	_, err = r.client.CancelRun(ctx, runID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to cancel the job run",
+			"Error: "+err.Error()+"\nThe run "+strconv.Itoa(runID)+" might still be running",
+		)
+		return
+	}
+}
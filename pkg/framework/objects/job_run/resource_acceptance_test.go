@@ -0,0 +1,126 @@
+package job_run_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccDbtCloudJobRunResource(t *testing.T) {
+
+	jobName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	environmentName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	// the environment doesn't have a connection, the runs are not waited for as they would fail
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDbtCloudJobRunResourceConfig(
+					jobName,
+					projectName,
+					environmentName,
+					"v1",
+					"false",
+				),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dbtcloud_job_run.test_run", "run_id"),
+					resource.TestCheckResourceAttrSet("dbtcloud_job_run.test_run", "status"),
+					resource.TestCheckResourceAttrSet("dbtcloud_job_run.test_run", "href"),
+					resource.TestCheckResourceAttr(
+						"dbtcloud_job_run.test_run",
+						"cause",
+						"Triggered by Terraform",
+					),
+				),
+			},
+			// the options of the resource are updated without a new run
+			{
+				Config: testAccDbtCloudJobRunResourceConfig(
+					jobName,
+					projectName,
+					environmentName,
+					"v1",
+					"true",
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"dbtcloud_job_run.test_run",
+							plancheck.ResourceActionUpdate,
+						),
+					},
+				},
+			},
+			// changing the triggers runs the job again
+			{
+				Config: testAccDbtCloudJobRunResourceConfig(
+					jobName,
+					projectName,
+					environmentName,
+					"v2",
+					"true",
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(
+							"dbtcloud_job_run.test_run",
+							plancheck.ResourceActionReplace,
+						),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dbtcloud_job_run.test_run", "run_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudJobRunResourceConfig(
+	jobName, projectName, environmentName, version, failOnError string,
+) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_job_project" {
+    name = "%s"
+}
+
+resource "dbtcloud_environment" "test_job_environment" {
+    project_id = dbtcloud_project.test_job_project.id
+    name = "%s"
+    dbt_version = "%s"
+    type = "deployment"
+}
+
+resource "dbtcloud_job" "test_job" {
+  name        = "%s"
+  project_id = dbtcloud_project.test_job_project.id
+  environment_id = dbtcloud_environment.test_job_environment.environment_id
+  execute_steps = [
+    "dbt build"
+  ]
+  triggers = {
+    "github_webhook": false,
+    "git_provider_webhook": false,
+    "schedule": false,
+  }
+}
+
+resource "dbtcloud_job_run" "test_run" {
+  job_id = dbtcloud_job.test_job.id
+  steps_override = ["dbt build --full-refresh"]
+  triggers = {
+    version = "%s"
+  }
+  wait_for_completion = false
+  fail_on_error = %s
+}
+`, projectName, environmentName, acctest_helper.DBT_CLOUD_VERSION, jobName, version, failOnError)
+}
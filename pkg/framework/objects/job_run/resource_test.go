@@ -0,0 +1,60 @@
+package job_run
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+)
+
+func TestRunError(t *testing.T) {
+	runID := 7
+	statusMessage := "Database Error in model orders"
+
+	testCases := []struct {
+		name          string
+		run           dbt_cloud.Run
+		expectedError string
+	}{
+		{
+			name: "running",
+			run:  dbt_cloud.Run{ID: &runID, StatusHumanized: "Running"},
+		},
+		{
+			name: "success",
+			run:  dbt_cloud.Run{ID: &runID, StatusHumanized: "Success", IsComplete: true, IsSuccess: true},
+		},
+		{
+			name: "error",
+			run: dbt_cloud.Run{
+				ID:              &runID,
+				StatusHumanized: "Error",
+				StatusMessage:   &statusMessage,
+				Href:            "https://cloud.getdbt.com/deploy/1/projects/2/runs/7/",
+				IsComplete:      true,
+				IsError:         true,
+			},
+			expectedError: "the run 7 finished with the status Error: Database Error in model orders\nSee https://cloud.getdbt.com/deploy/1/projects/2/runs/7/",
+		},
+		{
+			name:          "cancelled",
+			run:           dbt_cloud.Run{ID: &runID, StatusHumanized: "Cancelled", IsComplete: true, IsCancelled: true},
+			expectedError: "the run 7 finished with the status Cancelled",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := runError(tc.run)
+			if tc.expectedError == "" {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+				t.Errorf("expected the error %q, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
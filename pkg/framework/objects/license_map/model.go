@@ -1,6 +1,7 @@
 package license_map
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -8,4 +9,6 @@ type LicenseMapResourceModel struct {
 	ID                      types.Int64  `tfsdk:"id"`
 	LicenseType             types.String `tfsdk:"license_type"`
 	SSOLicenseMappingGroups types.Set    `tfsdk:"sso_license_mapping_groups"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
@@ -2,13 +2,13 @@ package license_map
 
 import (
 	"context"
+	"errors"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/samber/lo"
 	"strconv"
-	"strings"
 )
 
 var (
@@ -47,10 +47,14 @@ func (r *licenseMapResource) Read(
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	licenseMapID := state.ID.ValueInt64()
-	licenseMap, err := r.client.GetLicenseMap(int(licenseMapID))
+	licenseMap, err := r.client.GetLicenseMap(ctx, int(licenseMapID))
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			resp.Diagnostics.AddWarning(
 				"Resource not found",
 				"The license map resource was not found and has been removed from the state.",
@@ -58,7 +62,7 @@ func (r *licenseMapResource) Read(
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error getting the license map", err.Error())
+		helper.AddReadError(&resp.Diagnostics, "Error getting the license map", err)
 		return
 	}
 
@@ -85,6 +89,9 @@ func (r *licenseMapResource) Create(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	var configSsoMapping []string
 	diags := plan.SSOLicenseMappingGroups.ElementsAs(
 		context.Background(),
@@ -97,6 +104,7 @@ func (r *licenseMapResource) Create(
 	}
 
 	licenseMap, err := r.client.CreateLicenseMap(
+		ctx,
 		plan.LicenseType.ValueString(),
 		configSsoMapping,
 	)
@@ -125,9 +133,12 @@ func (r *licenseMapResource) Delete(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	licenseMapID := int(state.ID.ValueInt64())
 
-	err := r.client.DestroyLicenseMap(licenseMapID)
+	err := r.client.DestroyLicenseMap(ctx, licenseMapID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting the license map", err.Error())
 		return
@@ -147,8 +158,11 @@ func (r *licenseMapResource) Update(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	licenseMapID := int(state.ID.ValueInt64())
-	licenseMap, err := r.client.GetLicenseMap(licenseMapID)
+	licenseMap, err := r.client.GetLicenseMap(ctx, licenseMapID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting the license map",
@@ -191,7 +205,7 @@ func (r *licenseMapResource) Update(
 			licenseMap.SSOLicenseMappingGroups = planSsoMapping
 		}
 
-		_, err = r.client.UpdateLicenseMap(licenseMapID, *licenseMap)
+		_, err = r.client.UpdateLicenseMap(ctx, licenseMapID, *licenseMap)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to update the existing license map",
@@ -218,6 +232,7 @@ func (r *licenseMapResource) ImportState(
 	state := LicenseMapResourceModel{
 		ID:                      types.Int64Value(int64(licenseMapID)),
 		SSOLicenseMappingGroups: ssoLicenseMappingGroups,
+		Timeouts:                helper.NullTimeouts(ctx),
 	}
 
 	diags := resp.State.Set(ctx, &state)
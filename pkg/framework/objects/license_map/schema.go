@@ -13,7 +13,7 @@ import (
 )
 
 func (r *licenseMapResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -42,5 +42,8 @@ func (r *licenseMapResource) Schema(
 				ElementType: types.StringType,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helper.TimeoutsBlock(ctx),
+		},
 	}
 }
@@ -1,6 +1,9 @@
 package lineage_integration
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 type LineageIntegrationResourceModel struct {
 	ID                   types.String `tfsdk:"id"`
@@ -11,4 +14,6 @@ type LineageIntegrationResourceModel struct {
 	SiteID               types.String `tfsdk:"site_id"`
 	TokenName            types.String `tfsdk:"token_name"`
 	Token                types.String `tfsdk:"token"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
@@ -2,8 +2,8 @@ package lineage_integration
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
@@ -43,11 +43,15 @@ func (r *lineageIntegrationResource) Read(
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	ctx, cancel := helper.WithTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	projectID := data.ProjectID.ValueInt64()
 	lineageIntegrationID := data.LineageIntegrationID.ValueInt64()
-	lineageIntegration, err := r.client.GetLineageIntegration(projectID, lineageIntegrationID)
+	lineageIntegration, err := r.client.GetLineageIntegration(ctx, projectID, lineageIntegrationID)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			resp.Diagnostics.AddWarning(
 				"Resource not found",
 				"The lineage_integration resource was not found and has been removed from the state.",
@@ -55,7 +59,7 @@ func (r *lineageIntegrationResource) Read(
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error getting the lineage", err.Error())
+		helper.AddReadError(&resp.Diagnostics, "Error getting the lineage", err)
 		return
 	}
 
@@ -97,7 +101,11 @@ func (r *lineageIntegrationResource) Create(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	lineageIntegration, err := r.client.CreateLineageIntegration(
+		ctx,
 		data.ProjectID.ValueInt64(),
 		data.Name.ValueString(),
 		data.Host.ValueString(),
@@ -139,10 +147,13 @@ func (r *lineageIntegrationResource) Delete(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	lineageID := data.LineageIntegrationID.ValueInt64()
 	projectID := data.ProjectID.ValueInt64()
 
-	err := r.client.DeleteLineageIntegration(projectID, lineageID)
+	err := r.client.DeleteLineageIntegration(ctx, projectID, lineageID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting lineage", err.Error())
 		return
@@ -164,6 +175,9 @@ func (r *lineageIntegrationResource) Update(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	patchPayload := dbt_cloud.LineageIntegration{}
 
 	if plan.Host != state.Host {
@@ -183,7 +197,7 @@ func (r *lineageIntegrationResource) Update(
 	lineageID := state.LineageIntegrationID.ValueInt64()
 
 	// Update the lineage
-	_, err := r.client.UpdateLineageIntegration(projectID, lineageID, patchPayload)
+	_, err := r.client.UpdateLineageIntegration(ctx, projectID, lineageID, patchPayload)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating lineage", err.Error())
 		return
@@ -13,7 +13,7 @@ import (
 )
 
 func (r *lineageIntegrationResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -71,5 +71,8 @@ func (r *lineageIntegrationResource) Schema(
 				Description: "The secret token value to use to authenticate to the BI server",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helper.TimeoutsBlock(ctx),
+		},
 	}
 }
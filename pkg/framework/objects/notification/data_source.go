@@ -2,10 +2,11 @@ package notification
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -103,9 +104,10 @@ func (d *notificationDataSource) Read(
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	notificationID := data.NotificationID.ValueInt64()
-	notification, err := d.client.GetNotification(fmt.Sprintf("%d", notificationID))
+	notification, err := d.client.GetNotification(ctx, fmt.Sprintf("%d", notificationID))
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			resp.Diagnostics.AddWarning(
 				"Resource not found",
 				"The notification resource was not found and has been removed from the state.",
@@ -113,7 +115,7 @@ func (d *notificationDataSource) Read(
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error getting the notification", err.Error())
+		helper.AddReadError(&resp.Diagnostics, "Error getting the notification", err)
 		return
 	}
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -20,6 +21,8 @@ type NotificationResourceModel struct {
 	ExternalEmail    types.String `tfsdk:"external_email"`
 	SlackChannelID   types.String `tfsdk:"slack_channel_id"`
 	SlackChannelName types.String `tfsdk:"slack_channel_name"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type NotificationDataSourceModel struct {
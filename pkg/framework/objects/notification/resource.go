@@ -2,10 +2,11 @@ package notification
 
 import (
 	"context"
+	"errors"
 	"strconv"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -84,10 +85,14 @@ func (r *notificationResource) Read(
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	ctx, cancel := helper.WithTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	notificationID := data.ID.ValueString()
-	notification, err := r.client.GetNotification(notificationID)
+	notification, err := r.client.GetNotification(ctx, notificationID)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			resp.Diagnostics.AddWarning(
 				"Resource not found",
 				"The notification resource was not found and has been removed from the state.",
@@ -95,7 +100,7 @@ func (r *notificationResource) Read(
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error getting the notification", err.Error())
+		helper.AddReadError(&resp.Diagnostics, "Error getting the notification", err)
 		return
 	}
 
@@ -143,6 +148,9 @@ func (r *notificationResource) Create(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	var intOnCancel, intOnFailure, intOnWarning, intOnSuccess []int
 
 	diags := data.OnCancel.ElementsAs(context.Background(), &intOnCancel, false)
@@ -166,6 +174,7 @@ func (r *notificationResource) Create(
 	}
 
 	notif, err := r.client.CreateNotification(
+		ctx,
 		int(data.UserID.ValueInt64()),
 		intOnCancel,
 		intOnFailure,
@@ -204,15 +213,18 @@ func (r *notificationResource) Delete(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	notificationID := data.ID.ValueString()
-	notification, err := r.client.GetNotification(notificationID)
+	notification, err := r.client.GetNotification(ctx, notificationID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting the notification", err.Error())
 		return
 	}
 
 	notification.State = dbt_cloud.STATE_DELETED
-	_, err = r.client.UpdateNotification(notificationID, *notification)
+	_, err = r.client.UpdateNotification(ctx, notificationID, *notification)
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting the notification", err.Error())
 		return
@@ -234,6 +246,9 @@ func (r *notificationResource) Update(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	if plan.UserID != state.UserID {
 		state.UserID = plan.UserID
 	}
@@ -278,7 +293,7 @@ func (r *notificationResource) Update(
 	notification.AccountId = r.client.AccountID
 
 	// Update the notification
-	_, err := r.client.UpdateNotification(state.ID.ValueString(), notification)
+	_, err := r.client.UpdateNotification(ctx, state.ID.ValueString(), notification)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating notification", err.Error())
 		return
@@ -1,6 +1,7 @@
 package notification_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -248,7 +249,7 @@ func testAccCheckDbtCloudNotificationExists(resource string) resource.TestCheckF
 			return fmt.Errorf("Issue getting the client")
 		}
 
-		_, err = apiClient.GetNotification(rs.Primary.ID)
+		_, err = apiClient.GetNotification(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -267,7 +268,7 @@ func testAccCheckDbtCloudNotificationDestroy(s *terraform.State) error {
 		if rs.Type != "dbtcloud_notification" {
 			continue
 		}
-		_, err := apiClient.GetNotification(rs.Primary.ID)
+		_, err := apiClient.GetNotification(context.Background(), rs.Primary.ID)
 		if err == nil {
 			return fmt.Errorf("Notification still exists")
 		}
@@ -1,6 +1,7 @@
 package oauth_configuration
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -14,4 +15,6 @@ type OAuthConfigurationResourceModel struct {
 	TokenUrl         types.String `tfsdk:"token_url"`
 	RedirectUri      types.String `tfsdk:"redirect_uri"`
 	ApplicationIdUri types.String `tfsdk:"application_id_uri"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
@@ -2,10 +2,11 @@ package oauth_configuration
 
 import (
 	"context"
+	"errors"
 	"strconv"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -73,11 +74,15 @@ func (r *oAuthConfigurationResource) Read(
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	oAuthConfigurationID := state.ID.ValueInt64()
-	retrievedOAuthConfiguration, err := r.client.GetOAuthConfiguration(oAuthConfigurationID)
+	retrievedOAuthConfiguration, err := r.client.GetOAuthConfiguration(ctx, oAuthConfigurationID)
 
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			resp.Diagnostics.AddWarning(
 				"Resource not found",
 				"The OAuth configuration was not found and has been removed from the state.",
@@ -85,7 +90,7 @@ func (r *oAuthConfigurationResource) Read(
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error getting the OAuth configuration", err.Error())
+		helper.AddReadError(&resp.Diagnostics, "Error getting the OAuth configuration", err)
 		return
 	}
 
@@ -124,6 +129,9 @@ func (r *oAuthConfigurationResource) Create(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	oAuthType := plan.Type.ValueString()
 	name := plan.Name.ValueString()
 	clientID := plan.ClientId.ValueString()
@@ -136,6 +144,7 @@ func (r *oAuthConfigurationResource) Create(
 	applicationURI := plan.ApplicationIdUri.ValueString()
 
 	createdOAuthConfiguration, err := r.client.CreateOAuthConfiguration(
+		ctx,
 		oAuthType,
 		name,
 		clientID,
@@ -169,9 +178,12 @@ func (r *oAuthConfigurationResource) Delete(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	oAuthConfigurationID := state.ID.ValueInt64()
 
-	err := r.client.DeleteOAuthConfiguration(oAuthConfigurationID)
+	err := r.client.DeleteOAuthConfiguration(ctx, oAuthConfigurationID)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -200,9 +212,12 @@ func (r *oAuthConfigurationResource) Update(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	oAuthConfigurationID := state.ID.ValueInt64()
 
-	retrievedOAuthConfiguration, err := r.client.GetOAuthConfiguration(oAuthConfigurationID)
+	retrievedOAuthConfiguration, err := r.client.GetOAuthConfiguration(ctx, oAuthConfigurationID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Issue getting OAuth configuration",
@@ -242,6 +257,7 @@ func (r *oAuthConfigurationResource) Update(
 	}
 
 	_, err = r.client.UpdateOAuthConfiguration(
+		ctx,
 		oAuthConfigurationID,
 		*retrievedOAuthConfiguration,
 	)
@@ -1,6 +1,7 @@
 package oauth_configuration_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -221,7 +222,7 @@ func testAccCheckDbtCloudOAuthConfigurationDestroy(s *terraform.State) error {
 		if err != nil {
 			return fmt.Errorf("Can't get oAuthConfigurationID")
 		}
-		_, err = apiClient.GetOAuthConfiguration(int64(oAuthConfigurationID))
+		_, err = apiClient.GetOAuthConfiguration(context.Background(), int64(oAuthConfigurationID))
 		if err == nil {
 			return fmt.Errorf("OAuthConfiguration still exists")
 		}
@@ -15,7 +15,7 @@ import (
 )
 
 func (r *oAuthConfigurationResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -76,5 +76,8 @@ func (r *oAuthConfigurationResource) Schema(
 				Default:     stringdefault.StaticString(""),
 			},
 		},
+		Blocks: map[string]resource_schema.Block{
+			"timeouts": helper.TimeoutsBlock(ctx),
+		},
 	}
 }
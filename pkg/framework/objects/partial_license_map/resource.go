@@ -2,7 +2,7 @@ package partial_license_map
 
 import (
 	"context"
-	"strings"
+	"errors"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/license_map"
@@ -42,11 +42,15 @@ func (r *partialLicenseMapResource) Read(
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// check if the ID exists
 	licenseMapID := int(state.ID.ValueInt64())
-	licenseMap, err := r.client.GetLicenseMap(licenseMapID)
+	licenseMap, err := r.client.GetLicenseMap(ctx, licenseMapID)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			resp.Diagnostics.AddWarning(
 				"Resource not found",
 				"The license map resource was not found and has been removed from the state.",
@@ -54,14 +58,14 @@ func (r *partialLicenseMapResource) Read(
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error getting the license map", err.Error())
+		helper.AddReadError(&resp.Diagnostics, "Error getting the license map", err)
 		return
 	}
 
 	// if the ID exists, make sure that it is the one we are looking for
 	if !matchPartial(state, *licenseMap) {
 		// read all the objects and check if one exists
-		allLicenseMaps, err := r.client.GetAllLicenseMaps()
+		allLicenseMaps, err := r.client.GetAllLicenseMaps(ctx)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to get all license maps",
@@ -132,6 +136,9 @@ func (r *partialLicenseMapResource) Create(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// we read the values from the config
 	var configSsoMapping []string
 	diags := plan.SSOLicenseMappingGroups.ElementsAs(
@@ -146,7 +153,7 @@ func (r *partialLicenseMapResource) Create(
 
 	// check if it exists
 	// we don't need to check uniqueness and can just return the first as the API only allows one license type
-	allLicenseMaps, err := r.client.GetAllLicenseMaps()
+	allLicenseMaps, err := r.client.GetAllLicenseMaps(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to get all license maps",
@@ -185,7 +192,7 @@ func (r *partialLicenseMapResource) Create(
 			allSsoMapping := append(remoteSsoMapping, missingSsoMapping...)
 			fullLicenseMap.SSOLicenseMappingGroups = allSsoMapping
 
-			_, err := r.client.UpdateLicenseMap(*licenseMapID, *fullLicenseMap)
+			_, err := r.client.UpdateLicenseMap(ctx, *licenseMapID, *fullLicenseMap)
 			if err != nil {
 				resp.Diagnostics.AddError(
 					"Unable to update the existing license map",
@@ -199,6 +206,7 @@ func (r *partialLicenseMapResource) Create(
 	} else {
 		// it doesn't exist so we create it
 		licenseMap, err := r.client.CreateLicenseMap(
+			ctx,
 			plan.LicenseType.ValueString(),
 			configSsoMapping,
 		)
@@ -228,8 +236,11 @@ func (r *partialLicenseMapResource) Delete(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	licenseMapID := int(state.ID.ValueInt64())
-	licenseMap, err := r.client.GetLicenseMap(licenseMapID)
+	licenseMap, err := r.client.GetLicenseMap(ctx, licenseMapID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting the license map", err.Error())
 		return
@@ -254,7 +265,7 @@ func (r *partialLicenseMapResource) Delete(
 		// we update the object if there are some partial values left
 		// but we leave the object existing, without deleting it entirely
 		licenseMap.SSOLicenseMappingGroups = requiredSsoMapping
-		_, err = r.client.UpdateLicenseMap(licenseMapID, *licenseMap)
+		_, err = r.client.UpdateLicenseMap(ctx, licenseMapID, *licenseMap)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to update the existing license map",
@@ -264,7 +275,7 @@ func (r *partialLicenseMapResource) Delete(
 		}
 	} else {
 		// we delete the object if there is no config left at all
-		err = r.client.DestroyLicenseMap(licenseMapID)
+		err = r.client.DestroyLicenseMap(ctx, licenseMapID)
 		if err != nil {
 			resp.Diagnostics.AddError("Error deleting the license map", err.Error())
 			return
@@ -285,8 +296,11 @@ func (r *partialLicenseMapResource) Update(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	licenseMapID := int(state.ID.ValueInt64())
-	licenseMap, err := r.client.GetLicenseMap(licenseMapID)
+	licenseMap, err := r.client.GetLicenseMap(ctx, licenseMapID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting the license map",
@@ -331,7 +345,7 @@ func (r *partialLicenseMapResource) Update(
 		// we update the values to be the plan ones for global
 		// and the calculated ones for the local ones
 		licenseMap.SSOLicenseMappingGroups = requiredSsoMapping
-		_, err = r.client.UpdateLicenseMap(licenseMapID, *licenseMap)
+		_, err = r.client.UpdateLicenseMap(ctx, licenseMapID, *licenseMap)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to update the existing license map",
@@ -13,7 +13,7 @@ import (
 )
 
 func (r *partialLicenseMapResource) Schema(
-	_ context.Context,
+	ctx context.Context,
 	_ resource.SchemaRequest,
 	resp *resource.SchemaResponse,
 ) {
@@ -51,5 +51,8 @@ func (r *partialLicenseMapResource) Schema(
 				Description: "List of SSO groups to map to the license type.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": helper.TimeoutsBlock(ctx),
+		},
 	}
 }
@@ -2,11 +2,12 @@ package partial_notification
 
 import (
 	"context"
+	"errors"
 	"strconv"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/notification"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -84,11 +85,15 @@ func (r *partialNotificationResource) Read(
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// check if the ID exists
 	notificationID := state.ID.ValueString()
-	notification, err := r.client.GetNotification(notificationID)
+	notification, err := r.client.GetNotification(ctx, notificationID)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			resp.Diagnostics.AddWarning(
 				"Resource not found",
 				"The notification resource was not found and has been removed from the state.",
@@ -96,14 +101,14 @@ func (r *partialNotificationResource) Read(
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error getting the notification", err.Error())
+		helper.AddReadError(&resp.Diagnostics, "Error getting the notification", err)
 		return
 	}
 
 	// if the ID exists, make sure that it is the one we are looking for
 	if !matchPartial(state, *notification) {
 		// read all the notifications and check if one exists
-		allNotifications, err := r.client.GetAllNotifications()
+		allNotifications, err := r.client.GetAllNotifications(ctx)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Unable to get all notifications",
@@ -192,6 +197,9 @@ func (r *partialNotificationResource) Create(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	// we read the values from the config
 	intOnCancel, intOnFailure, intOnWarning, intOnSuccess, ok := extractModelJobLists(plan)
 	if !ok {
@@ -201,7 +209,7 @@ func (r *partialNotificationResource) Create(
 
 	// check if it exists
 	// we don't need to check uniqueness and can just return the first as the API only allows one notification per user
-	allNotifications, err := r.client.GetAllNotifications()
+	allNotifications, err := r.client.GetAllNotifications(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to get all notifications",
@@ -261,6 +269,7 @@ func (r *partialNotificationResource) Create(
 			allOnSuccess := append(remoteOnSuccess, missingOnSuccess...)
 
 			_, err := r.client.UpdateNotification(
+				ctx,
 				notificationID,
 				dbt_cloud.Notification{
 					AccountId:        r.client.AccountID,
@@ -290,6 +299,7 @@ func (r *partialNotificationResource) Create(
 	} else {
 		// it doesn't exist so we create it
 		notif, err := r.client.CreateNotification(
+			ctx,
 			int(plan.UserID.ValueInt64()),
 			intOnCancel,
 			intOnFailure,
@@ -327,8 +337,11 @@ func (r *partialNotificationResource) Delete(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	notificationID := state.ID.ValueString()
-	notification, err := r.client.GetNotification(notificationID)
+	notification, err := r.client.GetNotification(ctx, notificationID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting the notification", err.Error())
 		return
@@ -361,6 +374,7 @@ func (r *partialNotificationResource) Delete(
 		// we update the notification if there are some jobs left
 		// but we leave the notification existing, without deleting it entirely
 		_, err = r.client.UpdateNotification(
+			ctx,
 			notificationID,
 			dbt_cloud.Notification{
 				AccountId:        r.client.AccountID,
@@ -387,7 +401,7 @@ func (r *partialNotificationResource) Delete(
 	} else {
 		// we delete the notification if there are no jobs left
 		notification.State = dbt_cloud.STATE_DELETED
-		_, err = r.client.UpdateNotification(notificationID, *notification)
+		_, err = r.client.UpdateNotification(ctx, notificationID, *notification)
 		if err != nil {
 			resp.Diagnostics.AddError("Error deleting the notification", err.Error())
 			return
@@ -408,8 +422,11 @@ func (r *partialNotificationResource) Update(
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	notificationID := state.ID.ValueString()
-	notification, err := r.client.GetNotification(notificationID)
+	notification, err := r.client.GetNotification(ctx, notificationID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error getting the notification",
@@ -463,6 +480,7 @@ func (r *partialNotificationResource) Update(
 		// we update the values to be the plan ones for global
 		// and the calculated ones for the local ones
 		_, err = r.client.UpdateNotification(
+			ctx,
 			notificationID,
 			dbt_cloud.Notification{
 				AccountId:        r.client.AccountID,
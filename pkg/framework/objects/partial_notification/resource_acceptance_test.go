@@ -1,6 +1,7 @@
 package partial_notification_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -255,7 +256,7 @@ func testAccCheckDbtCloudPartialNotificationExists(resource string) resource.Tes
 			return fmt.Errorf("Issue getting the client")
 		}
 
-		_, err = apiClient.GetNotification(rs.Primary.ID)
+		_, err = apiClient.GetNotification(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -274,7 +275,7 @@ func testAccCheckDbtCloudPartialNotificationDestroy(s *terraform.State) error {
 		if rs.Type != "dbtcloud_partial_notification" {
 			continue
 		}
-		_, err := apiClient.GetNotification(rs.Primary.ID)
+		_, err := apiClient.GetNotification(context.Background(), rs.Primary.ID)
 		if err == nil {
 			return fmt.Errorf("PartialNotification still exists")
 		}
@@ -0,0 +1,41 @@
+package postgres_credential
+
+import (
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/credential"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type PostgresCredentialResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	ProjectID     types.Int64    `tfsdk:"project_id"`
+	CredentialID  types.Int64    `tfsdk:"credential_id"`
+	IsActive      types.Bool     `tfsdk:"is_active"`
+	Type          types.String   `tfsdk:"type"`
+	DefaultSchema types.String   `tfsdk:"default_schema"`
+	TargetName    types.String   `tfsdk:"target_name"`
+	Username      types.String   `tfsdk:"username"`
+	Password      types.String   `tfsdk:"password"`
+	NumThreads    types.Int64    `tfsdk:"num_threads"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// setFromCredential updates the model with the credential returned by the API
+// the password is not returned and keeps the value of the model
+func (m *PostgresCredentialResourceModel) setFromCredential(postgresCredential dbt_cloud.PostgresCredential) {
+	m.ID = credential.ID(postgresCredential.Project_Id, *postgresCredential.ID)
+	m.ProjectID = types.Int64Value(int64(postgresCredential.Project_Id))
+	m.CredentialID = types.Int64Value(int64(*postgresCredential.ID))
+	m.IsActive = types.BoolValue(postgresCredential.State == dbt_cloud.STATE_ACTIVE)
+	m.Type = types.StringValue(postgresCredential.Type)
+	m.DefaultSchema = types.StringValue(postgresCredential.Default_Schema)
+	m.TargetName = types.StringValue(postgresCredential.Target_Name)
+	m.Username = types.StringValue(postgresCredential.Username)
+	m.NumThreads = types.Int64Value(int64(postgresCredential.Threads))
+}
+
+// normalizeStateV0 converts the empty password saved by the SDKv2 resource to null
+func normalizeStateV0(state *PostgresCredentialResourceModel) {
+	state.Password = credential.NullIfEmpty(state.Password)
+}
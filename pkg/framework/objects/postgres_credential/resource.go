@@ -0,0 +1,164 @@
+package postgres_credential
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/credential"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                 = &postgresCredentialResource{}
+	_ resource.ResourceWithConfigure    = &postgresCredentialResource{}
+	_ resource.ResourceWithImportState  = &postgresCredentialResource{}
+	_ resource.ResourceWithUpgradeState = &postgresCredentialResource{}
+)
+
+func PostgresCredentialResource() resource.Resource {
+	return &postgresCredentialResource{
+		Base: credential.Base[dbt_cloud.PostgresCredential]{TypeName: "postgres_credential"},
+	}
+}
+
+type postgresCredentialResource struct {
+	credential.Base[dbt_cloud.PostgresCredential]
+}
+
+func (r *postgresCredentialResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state PostgresCredentialResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	postgresCredential := r.ReadCredential(ctx, state.ID, resp)
+	if postgresCredential == nil {
+		return
+	}
+
+	state.setFromCredential(*postgresCredential)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *postgresCredentialResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan PostgresCredentialResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	postgresCredential, err := r.Client.CreatePostgresCredential(
+		ctx,
+		int(plan.ProjectID.ValueInt64()),
+		plan.IsActive.ValueBool(),
+		plan.Type.ValueString(),
+		plan.DefaultSchema.ValueString(),
+		plan.TargetName.ValueString(),
+		plan.Username.ValueString(),
+		plan.Password.ValueString(),
+		int(plan.NumThreads.ValueInt64()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create Postgres credential",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = credential.ID(postgresCredential.Project_Id, *postgresCredential.ID)
+	plan.CredentialID = types.Int64Value(int64(*postgresCredential.ID))
+	if plan.NumThreads.IsUnknown() {
+		plan.NumThreads = types.Int64Value(int64(postgresCredential.Threads))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *postgresCredentialResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state PostgresCredentialResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	projectID, credentialID, ok := r.SplitID(state.ID.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	client := r.Credentials()
+	postgresCredential, err := client.Get(ctx, projectID, credentialID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue getting Postgres credential",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	if !plan.Type.Equal(state.Type) {
+		postgresCredential.Type = plan.Type.ValueString()
+	}
+	if !plan.DefaultSchema.Equal(state.DefaultSchema) {
+		postgresCredential.Default_Schema = plan.DefaultSchema.ValueString()
+	}
+	if !plan.TargetName.Equal(state.TargetName) {
+		postgresCredential.Target_Name = plan.TargetName.ValueString()
+	}
+	if !plan.Username.Equal(state.Username) {
+		postgresCredential.Username = plan.Username.ValueString()
+	}
+	if !plan.Password.Equal(state.Password) {
+		postgresCredential.Password = plan.Password.ValueString()
+	}
+	if !plan.NumThreads.IsUnknown() && !plan.NumThreads.Equal(state.NumThreads) {
+		postgresCredential.Threads = int(plan.NumThreads.ValueInt64())
+	}
+
+	updatedCredential, err := client.Update(ctx, projectID, credentialID, *postgresCredential)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update Postgres credential",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	if plan.NumThreads.IsUnknown() {
+		plan.NumThreads = types.Int64Value(int64(updatedCredential.Threads))
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *postgresCredentialResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return credential.UpgradeStateV0(ctx, r, normalizeStateV0)
+}
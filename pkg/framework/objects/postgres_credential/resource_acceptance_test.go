@@ -1,6 +1,7 @@
-package resources_test
+package postgres_credential_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -21,7 +22,7 @@ func TestAccDbtCloudPostgresCredentialResource(t *testing.T) {
 	password := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckDbtCloudPostgresCredentialDestroy,
 		Steps: []resource.TestStep{
@@ -111,7 +112,7 @@ func testAccCheckDbtCloudPostgresCredentialExists(resource string) resource.Test
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		_, err = apiClient.GetPostgresCredential(projectId, credentialId)
+		_, err = apiClient.GetPostgresCredential(context.Background(), projectId, credentialId)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -137,7 +138,7 @@ func testAccCheckDbtCloudPostgresCredentialDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = apiClient.GetPostgresCredential(projectId, credentialId)
+		_, err = apiClient.GetPostgresCredential(context.Background(), projectId, credentialId)
 		if err == nil {
 			return fmt.Errorf("Postgres credential still exists")
 		}
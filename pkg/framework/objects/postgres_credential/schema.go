@@ -0,0 +1,82 @@
+package postgres_credential
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/credential"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/samber/lo"
+)
+
+var warehouseTypes = []string{
+	"postgres",
+	"redshift",
+}
+
+func (r *postgresCredentialResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the credentials of the Postgres, Redshift and AlloyDB environments of a project",
+		// the SDKv2 resource was at version 0, the state is upgraded with UpgradeState
+		Version: 1,
+		Attributes: lo.Assign(
+			credential.CommonAttributes("Postgres/Redshift/AlloyDB"),
+			map[string]schema.Attribute{
+				"is_active": schema.BoolAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(true),
+					Description: "Whether the Postgres/Redshift/AlloyDB credential is active",
+				},
+				"type": schema.StringAttribute{
+					Required:    true,
+					Description: "Type of connection. One of (postgres/redshift). Use postgres for alloydb connections",
+					Validators: []validator.String{
+						stringvalidator.OneOf(warehouseTypes...),
+					},
+				},
+				"default_schema": schema.StringAttribute{
+					Required:    true,
+					Description: "Default schema name",
+				},
+				"target_name": schema.StringAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString("default"),
+					Description: "Default schema name",
+				},
+				"username": schema.StringAttribute{
+					Required:    true,
+					Description: "Username for Postgres/Redshift/AlloyDB",
+				},
+				"password": schema.StringAttribute{
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Password for Postgres/Redshift/AlloyDB",
+				},
+				"num_threads": schema.Int64Attribute{
+					Optional:    true,
+					Computed:    true,
+					Description: "Number of threads to use",
+					PlanModifiers: []planmodifier.Int64{
+						int64planmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+		),
+		Blocks: map[string]schema.Block{
+			"timeouts": helper.TimeoutsBlock(ctx),
+		},
+	}
+}
@@ -1,6 +1,10 @@
 package project
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 type ProjectsDataSourceModel struct {
 	NameContains types.String                  `tfsdk:"name_contains"`
@@ -30,3 +34,28 @@ type ProjectConnection struct {
 	Name           types.String `tfsdk:"name"`
 	AdapterVersion types.String `tfsdk:"adapter_version"`
 }
+
+// ProjectResourceModel has the attributes of ProjectConnectionRepository that can be configured
+type ProjectResourceModel struct {
+	ID                     types.Int64    `tfsdk:"id"`
+	Name                   types.String   `tfsdk:"name"`
+	Description            types.String   `tfsdk:"description"`
+	DbtProjectSubdirectory types.String   `tfsdk:"dbt_project_subdirectory"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+}
+
+// ProjectResourceModelV0 is the state of the SDKv2 resource, with the ID saved as a string
+type ProjectResourceModelV0 struct {
+	ID                     types.String   `tfsdk:"id"`
+	Name                   types.String   `tfsdk:"name"`
+	Description            types.String   `tfsdk:"description"`
+	DbtProjectSubdirectory types.String   `tfsdk:"dbt_project_subdirectory"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (m *ProjectResourceModel) setFromProject(project dbt_cloud.Project) {
+	m.ID = types.Int64Value(int64(*project.ID))
+	m.Name = types.StringValue(project.Name)
+	m.Description = types.StringValue(project.Description)
+	m.DbtProjectSubdirectory = types.StringPointerValue(project.DbtProjectSubdirectory)
+}
@@ -0,0 +1,269 @@
+package project
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                 = &projectResource{}
+	_ resource.ResourceWithConfigure    = &projectResource{}
+	_ resource.ResourceWithImportState  = &projectResource{}
+	_ resource.ResourceWithUpgradeState = &projectResource{}
+)
+
+func ProjectResource() resource.Resource {
+	return &projectResource{}
+}
+
+type projectResource struct {
+	client *dbt_cloud.Client
+}
+
+func (r *projectResource) Metadata(
+	_ context.Context,
+	req resource.MetadataRequest,
+	resp *resource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (r *projectResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state ProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	projectID := strconv.FormatInt(state.ID.ValueInt64(), 10)
+	project, err := r.client.GetProject(ctx, projectID)
+	if err != nil {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
+			resp.Diagnostics.AddWarning(
+				"Resource not found",
+				"The project was not found and has been removed from the state.",
+			)
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		helper.AddReadError(&resp.Diagnostics, "Error getting the project", err)
+		return
+	}
+
+	state.setFromProject(*project)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *projectResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan ProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	project, err := r.client.CreateProject(
+		ctx,
+		plan.Name.ValueString(),
+		plan.Description.ValueString(),
+		plan.DbtProjectSubdirectory.ValueString(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create project",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.Int64Value(int64(*project.ID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *projectResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state ProjectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	projectID := strconv.FormatInt(state.ID.ValueInt64(), 10)
+	project, err := r.client.GetProject(ctx, projectID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue getting Project",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		project.Name = plan.Name.ValueString()
+	}
+	if !plan.Description.Equal(state.Description) {
+		project.Description = plan.Description.ValueString()
+	}
+	if !plan.DbtProjectSubdirectory.Equal(state.DbtProjectSubdirectory) {
+		dbtProjectSubdirectory := plan.DbtProjectSubdirectory.ValueString()
+		project.DbtProjectSubdirectory = &dbtProjectSubdirectory
+	}
+
+	_, err = r.client.UpdateProject(ctx, projectID, *project)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update project",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *projectResource) Delete(
+	ctx context.Context,
+	req resource.DeleteRequest,
+	resp *resource.DeleteResponse,
+) {
+	var state ProjectResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	projectID := strconv.FormatInt(state.ID.ValueInt64(), 10)
+	project, err := r.client.GetProject(ctx, projectID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue getting Project",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	project.State = dbt_cloud.STATE_DELETED
+	_, err = r.client.UpdateProject(ctx, projectID, *project)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to delete project",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *projectResource) ImportState(
+	ctx context.Context,
+	req resource.ImportStateRequest,
+	resp *resource.ImportStateResponse,
+) {
+	projectIDStr, err := r.client.ResolveProjectImportID(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error finding the project to import", err.Error())
+		return
+	}
+
+	projectID, err := strconv.ParseInt(projectIDStr, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing project ID for import", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), projectID)...)
+}
+
+func (r *projectResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	schemaV0 := projectResourceSchemaV0(ctx)
+
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &schemaV0,
+			StateUpgrader: upgradeProjectStateV0,
+		},
+	}
+}
+
+// upgradeProjectStateV0 converts the state of the SDKv2 resource
+// the ID is now a number and the subdirectory not set is saved as null instead of an empty string
+func upgradeProjectStateV0(
+	ctx context.Context,
+	req resource.UpgradeStateRequest,
+	resp *resource.UpgradeStateResponse,
+) {
+	var priorState ProjectResourceModelV0
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID, err := strconv.ParseInt(priorState.ID.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing the project ID of the state", err.Error())
+		return
+	}
+
+	dbtProjectSubdirectory := priorState.DbtProjectSubdirectory
+	if dbtProjectSubdirectory.ValueString() == "" {
+		dbtProjectSubdirectory = types.StringNull()
+	}
+
+	upgradedState := ProjectResourceModel{
+		ID:                     types.Int64Value(projectID),
+		Name:                   priorState.Name,
+		Description:            types.StringValue(priorState.Description.ValueString()),
+		DbtProjectSubdirectory: dbtProjectSubdirectory,
+		Timeouts:               priorState.Timeouts,
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &upgradedState)...)
+}
+
+func (r *projectResource) Configure(
+	_ context.Context,
+	req resource.ConfigureRequest,
+	_ *resource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*dbt_cloud.Client)
+}
@@ -1,6 +1,7 @@
-package resources_test
+package project_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -19,7 +21,7 @@ func TestAccDbtCloudProjectResource(t *testing.T) {
 	projectName2 := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckDbtCloudProjectDestroy,
 		Steps: []resource.TestStep{
@@ -74,6 +76,44 @@ func TestAccDbtCloudProjectResource(t *testing.T) {
 	})
 }
 
+func TestAccDbtCloudProjectResourceUpgradeFromSDKv2(t *testing.T) {
+
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	config := testAccDbtCloudProjectResourceBasicConfig(projectName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest_helper.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckDbtCloudProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"dbtcloud": {
+						Source:            "dbt-labs/dbtcloud",
+						VersionConstraint: "0.3.22",
+					},
+				},
+				Config: config,
+			},
+			{
+				ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+				Config:                   config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudProjectExists("dbtcloud_project.test_project"),
+					resource.TestCheckNoResourceAttr(
+						"dbtcloud_project.test_project",
+						"dbt_project_subdirectory",
+					),
+				),
+			},
+		},
+	})
+}
+
 func testAccDbtCloudProjectResourceBasicConfig(projectName string) string {
 	return fmt.Sprintf(`
 resource "dbtcloud_project" "test_project" {
@@ -108,7 +148,7 @@ func testAccCheckDbtCloudProjectExists(resource string) resource.TestCheckFunc {
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		_, err = apiClient.GetProject(rs.Primary.ID)
+		_, err = apiClient.GetProject(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -126,7 +166,7 @@ func testAccCheckDbtCloudProjectDestroy(s *terraform.State) error {
 		if rs.Type != "dbtcloud_project" {
 			continue
 		}
-		_, err := apiClient.GetProject(rs.Primary.ID)
+		_, err := apiClient.GetProject(context.Background(), rs.Primary.ID)
 		if err == nil {
 			return fmt.Errorf("Project still exists")
 		}
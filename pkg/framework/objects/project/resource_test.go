@@ -0,0 +1,30 @@
+package project
+
+import (
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/testutil"
+)
+
+// a state saved by the SDKv2 version of the resource, without a subdirectory configured
+const projectStateV0 = `{
+	"id": "123",
+	"name": "Analytics",
+	"description": "",
+	"dbt_project_subdirectory": "",
+	"timeouts": null
+}`
+
+func TestProjectResourceUpgradeStateV0(t *testing.T) {
+	state := testutil.UpgradeState[ProjectResourceModel](t, &projectResource{}, 0, projectStateV0)
+
+	if state.ID.ValueInt64() != 123 {
+		t.Errorf("expected the ID 123, got %s", state.ID)
+	}
+	if state.Description.IsNull() || state.Description.ValueString() != "" {
+		t.Errorf("expected the description to be kept as an empty string, got %s", state.Description)
+	}
+	if !state.DbtProjectSubdirectory.IsNull() {
+		t.Errorf("expected a null dbt_project_subdirectory, got %s", state.DbtProjectSubdirectory)
+	}
+}
@@ -3,8 +3,14 @@ package project
 import (
 	"context"
 
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	datasource_schema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resource_schema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 )
 
 func (d *projectsDataSource) Schema(
@@ -12,78 +18,78 @@ func (d *projectsDataSource) Schema(
 	req datasource.SchemaRequest,
 	resp *datasource.SchemaResponse,
 ) {
-	resp.Schema = schema.Schema{
+	resp.Schema = datasource_schema.Schema{
 		Description: "Retrieve all the projects created in dbt Cloud with an optional filter on parts of the project name.",
-		Attributes: map[string]schema.Attribute{
-			"name_contains": schema.StringAttribute{
+		Attributes: map[string]datasource_schema.Attribute{
+			"name_contains": datasource_schema.StringAttribute{
 				Optional:    true,
 				Computed:    true,
 				Description: "Used to filter projects by name, Optional",
 			},
-			"projects": schema.SetNestedAttribute{
+			"projects": datasource_schema.SetNestedAttribute{
 				Computed:    true,
 				Description: "Set of projects with their details",
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"id": schema.Int64Attribute{
+				NestedObject: datasource_schema.NestedAttributeObject{
+					Attributes: map[string]datasource_schema.Attribute{
+						"id": datasource_schema.Int64Attribute{
 							Computed:    true,
 							Description: "Project ID",
 						},
-						"name": schema.StringAttribute{
+						"name": datasource_schema.StringAttribute{
 							Computed:    true,
 							Description: "Project name",
 						},
-						"description": schema.StringAttribute{
+						"description": datasource_schema.StringAttribute{
 							Computed:    true,
 							Description: "Project description",
 						},
-						"semantic_layer_config_id": schema.Int64Attribute{
+						"semantic_layer_config_id": datasource_schema.Int64Attribute{
 							Computed:    true,
 							Description: "Semantic layer config ID",
 						},
-						"dbt_project_subdirectory": schema.StringAttribute{
+						"dbt_project_subdirectory": datasource_schema.StringAttribute{
 							Computed:    true,
 							Description: "Subdirectory for the dbt project inside the git repo",
 						},
-						"created_at": schema.StringAttribute{
+						"created_at": datasource_schema.StringAttribute{
 							Computed:    true,
 							Description: "When the project was created",
 						},
-						"updated_at": schema.StringAttribute{
+						"updated_at": datasource_schema.StringAttribute{
 							Computed:    true,
 							Description: "When the project was last updated",
 						},
-						"repository": schema.SingleNestedAttribute{
+						"repository": datasource_schema.SingleNestedAttribute{
 							Computed:    true,
 							Description: "Details for the repository linked to the project",
-							Attributes: map[string]schema.Attribute{
-								"id": schema.Int64Attribute{
+							Attributes: map[string]datasource_schema.Attribute{
+								"id": datasource_schema.Int64Attribute{
 									Computed:    true,
 									Description: "Repository ID",
 								},
-								"remote_url": schema.StringAttribute{
+								"remote_url": datasource_schema.StringAttribute{
 									Computed:    true,
 									Description: "URL of the git repo remote",
 								},
-								"pull_request_url_template": schema.StringAttribute{
+								"pull_request_url_template": datasource_schema.StringAttribute{
 									Computed:    true,
 									Description: "URL template for PRs",
 								},
 							},
 						},
-						"connection": schema.SingleNestedAttribute{
+						"connection": datasource_schema.SingleNestedAttribute{
 							Computed:    true,
 							Description: "Details for the connection linked to the project",
-							Attributes: map[string]schema.Attribute{
-								"id": schema.Int64Attribute{
+							Attributes: map[string]datasource_schema.Attribute{
+								"id": datasource_schema.Int64Attribute{
 									Computed:    true,
 									Description: "Connection ID",
 								},
-								"name": schema.StringAttribute{
+								"name": datasource_schema.StringAttribute{
 									Computed:    true,
 									Description: "Connection name",
 								},
-								"adapter_version": schema.StringAttribute{
+								"adapter_version": datasource_schema.StringAttribute{
 									Computed:    true,
 									Description: "Version of the adapter for the connection. Will tell what connection type it is",
 								},
@@ -95,3 +101,64 @@ func (d *projectsDataSource) Schema(
 		},
 	}
 }
+
+func (r *projectResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = resource_schema.Schema{
+		Description: "Manage dbt Cloud projects",
+		// the SDKv2 resource was at version 0, the state is upgraded with UpgradeState
+		Version: 1,
+		Attributes: map[string]resource_schema.Attribute{
+			"id": resource_schema.Int64Attribute{
+				Computed:    true,
+				Description: "The ID of the project",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": resource_schema.StringAttribute{
+				Required:    true,
+				Description: "Project name",
+			},
+			"description": resource_schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+				Description: "Description for the project. Will show in dbt Explorer.",
+			},
+			"dbt_project_subdirectory": resource_schema.StringAttribute{
+				Optional:    true,
+				Description: "dbt project subdirectory path",
+			},
+		},
+		Blocks: map[string]resource_schema.Block{
+			"timeouts": helper.TimeoutsBlock(ctx),
+		},
+	}
+}
+
+// projectResourceSchemaV0 is the schema of the SDKv2 resource
+func projectResourceSchemaV0(ctx context.Context) resource_schema.Schema {
+	return resource_schema.Schema{
+		Attributes: map[string]resource_schema.Attribute{
+			"id": resource_schema.StringAttribute{
+				Computed: true,
+			},
+			"name": resource_schema.StringAttribute{
+				Required: true,
+			},
+			"description": resource_schema.StringAttribute{
+				Optional: true,
+			},
+			"dbt_project_subdirectory": resource_schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		Blocks: map[string]resource_schema.Block{
+			"timeouts": helper.TimeoutsBlock(ctx),
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package project_artefacts
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -9,4 +10,6 @@ type ProjectArtefactsResourceModel struct {
 	ProjectID      types.Int64  `tfsdk:"project_id"`
 	DocsJobID      types.Int64  `tfsdk:"docs_job_id"`
 	FreshnessJobID types.Int64  `tfsdk:"freshness_job_id"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
@@ -2,8 +2,8 @@ package project_artefacts
 
 import (
 	"context"
+	"errors"
 	"strconv"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
@@ -54,15 +54,14 @@ func (p *projectArtefactsResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	projectIDString := strconv.FormatInt(plan.ProjectID.ValueInt64(), 10)
 
-	project, err := p.client.GetProject(projectIDString)
+	project, err := p.client.GetProject(ctx, projectIDString)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to get project",
-			"Error: "+err.Error(),
-		)
-
+		helper.AddReadError(&resp.Diagnostics, "Unable to get project", err)
 		return
 	}
 
@@ -80,7 +79,7 @@ func (p *projectArtefactsResource) Create(ctx context.Context, req resource.Crea
 		project.FreshnessJobId = nil
 	}
 
-	if _, err := p.client.UpdateProject(projectIDString, *project); err != nil {
+	if _, err := p.client.UpdateProject(ctx, projectIDString, *project); err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to update project",
 			"Error: "+err.Error(),
@@ -103,9 +102,12 @@ func (p *projectArtefactsResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	projectIDString := strconv.FormatInt(state.ProjectID.ValueInt64(), 10)
 
-	project, err := p.client.GetProject(projectIDString)
+	project, err := p.client.GetProject(ctx, projectIDString)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to get project",
@@ -118,7 +120,7 @@ func (p *projectArtefactsResource) Delete(ctx context.Context, req resource.Dele
 	project.FreshnessJobId = nil
 	project.DocsJobId = nil
 
-	_, err = p.client.UpdateProject(projectIDString, *project)
+	_, err = p.client.UpdateProject(ctx, projectIDString, *project)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to update project",
@@ -143,11 +145,15 @@ func (p *projectArtefactsResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	projectIDString := strconv.FormatInt(state.ProjectID.ValueInt64(), 10)
 
-	project, err := p.client.GetProject(projectIDString)
+	project, err := p.client.GetProject(ctx, projectIDString)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			resp.Diagnostics.AddError(
 				"Project not found",
 				"The project artefacts resource was not found and has been removed from the state.",
@@ -186,9 +192,12 @@ func (p *projectArtefactsResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	projectIDString := strconv.FormatInt(plan.ProjectID.ValueInt64(), 10)
 
-	project, err := p.client.GetProject(projectIDString)
+	project, err := p.client.GetProject(ctx, projectIDString)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to get project",
@@ -216,7 +225,7 @@ func (p *projectArtefactsResource) Update(ctx context.Context, req resource.Upda
 		}
 	}
 
-	project, err = p.client.UpdateProject(projectIDString, *project)
+	project, err = p.client.UpdateProject(ctx, projectIDString, *project)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -0,0 +1,161 @@
+package run_artifact
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+var (
+	_ datasource.DataSource              = &runArtifactDataSource{}
+	_ datasource.DataSourceWithConfigure = &runArtifactDataSource{}
+)
+
+func RunArtifactDataSource() datasource.DataSource {
+	return &runArtifactDataSource{}
+}
+
+type runArtifactDataSource struct {
+	client *dbt_cloud.Client
+}
+
+func (d *runArtifactDataSource) Metadata(
+	_ context.Context,
+	req datasource.MetadataRequest,
+	resp *datasource.MetadataResponse,
+) {
+	resp.TypeName = req.ProviderTypeName + "_run_artifact"
+}
+
+func (d *runArtifactDataSource) Schema(
+	_ context.Context,
+	_ datasource.SchemaRequest,
+	resp *datasource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieve an artifact of a run, e.g. `manifest.json` or `run_results.json`, either for a given run or for the latest successful run of a job. The JSON artifacts are parsed to list their nodes with their tags and meta.",
+		Attributes: map[string]schema.Attribute{
+			"run_id": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the run to get the artifact from (one of `run_id` or `job_id` must be set). When `job_id` is set, this is the ID of its latest successful run",
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("job_id")),
+				},
+			},
+			"job_id": schema.Int64Attribute{
+				Optional:    true,
+				Description: "The ID of the job whose latest successful run we get the artifact from (one of `run_id` or `job_id` must be set)",
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "The path of the artifact in the target folder of the run, e.g. `manifest.json`, `run_results.json`, `catalog.json` or `sources.json`",
+			},
+			"content": schema.StringAttribute{
+				Computed:    true,
+				Description: "The raw content of the artifact",
+			},
+			"nodes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The nodes of the JSON artifacts sorted by `unique_id`: the nodes, sources and exposures of `manifest.json` or the results of `run_results.json`. Empty for the other artifacts",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"unique_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The unique ID of the node, e.g. `model.my_project.orders`",
+						},
+						"resource_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of the node, e.g. `model`, `seed`, `test` or `source`",
+						},
+						"tags": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The tags of the node",
+						},
+						"meta": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The meta of the node, the values that are not strings are encoded in JSON and can be read with `jsondecode()`",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *runArtifactDataSource) Read(
+	ctx context.Context,
+	req datasource.ReadRequest,
+	resp *datasource.ReadResponse,
+) {
+	var config RunArtifactDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := config
+
+	runID := int(config.RunID.ValueInt64())
+	if !config.JobID.IsNull() {
+		run, err := d.client.GetLatestSuccessfulRun(ctx, int(config.JobID.ValueInt64()))
+		if err != nil {
+			resp.Diagnostics.AddError("Issue when retrieving the latest successful run of the job", err.Error())
+			return
+		}
+		runID = *run.ID
+	}
+	state.RunID = types.Int64Value(int64(runID))
+
+	artifactPath := config.Path.ValueString()
+	content, err := d.client.GetRunArtifact(ctx, runID, artifactPath)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue when retrieving the artifact",
+			fmt.Sprintf("Error getting the artifact %s of the run %d: %s", artifactPath, runID, err),
+		)
+		return
+	}
+	state.Content = types.StringValue(string(content))
+
+	state.Nodes = []RunArtifactNodeModel{}
+	if strings.HasSuffix(artifactPath, ".json") {
+		nodes, err := dbt_cloud.ParseArtifactNodes(content)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Issue when parsing the artifact",
+				fmt.Sprintf("Error parsing the artifact %s of the run %d: %s", artifactPath, runID, err),
+			)
+			return
+		}
+		state.Nodes = lo.Map(nodes, func(node dbt_cloud.ArtifactNode, _ int) RunArtifactNodeModel {
+			return nodeModel(node)
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *runArtifactDataSource) Configure(
+	_ context.Context,
+	req datasource.ConfigureRequest,
+	_ *datasource.ConfigureResponse,
+) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*dbt_cloud.Client)
+}
@@ -0,0 +1,67 @@
+package run_artifact_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/acctest_helper"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDbtCloudRunArtifactDataSource(t *testing.T) {
+
+	jobName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	// the environment doesn't have a connection so the job can't have a successful run
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDbtCloudRunArtifactDataSourceConfig(jobName, projectName, ""),
+				ExpectError: regexp.MustCompile("doesn't have any successful run"),
+			},
+			{
+				Config:      testAccDbtCloudRunArtifactDataSourceConfig(jobName, projectName, "run_id = 1"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func testAccDbtCloudRunArtifactDataSourceConfig(jobName string, projectName string, runID string) string {
+	return fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name = "%s"
+}
+
+resource "dbtcloud_environment" "test_environment" {
+  project_id  = dbtcloud_project.test_project.id
+  name        = "artifact_test_env"
+  dbt_version = "%s"
+  type        = "deployment"
+}
+
+resource "dbtcloud_job" "test_job" {
+  name           = "%s"
+  project_id     = dbtcloud_project.test_project.id
+  environment_id = dbtcloud_environment.test_environment.environment_id
+  execute_steps  = ["dbt build"]
+  triggers = {
+    "github_webhook" : false,
+    "git_provider_webhook" : false,
+    "schedule" : false,
+  }
+}
+
+data "dbtcloud_run_artifact" "manifest" {
+  job_id = dbtcloud_job.test_job.id
+  path   = "manifest.json"
+  %s
+}
+`, projectName, acctest_helper.DBT_CLOUD_VERSION, jobName, runID)
+}
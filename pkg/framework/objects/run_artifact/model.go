@@ -0,0 +1,51 @@
+package run_artifact
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+)
+
+type RunArtifactDataSourceModel struct {
+	RunID   types.Int64            `tfsdk:"run_id"`
+	JobID   types.Int64            `tfsdk:"job_id"`
+	Path    types.String           `tfsdk:"path"`
+	Content types.String           `tfsdk:"content"`
+	Nodes   []RunArtifactNodeModel `tfsdk:"nodes"`
+}
+
+type RunArtifactNodeModel struct {
+	UniqueID     types.String            `tfsdk:"unique_id"`
+	ResourceType types.String            `tfsdk:"resource_type"`
+	Tags         []types.String          `tfsdk:"tags"`
+	Meta         map[string]types.String `tfsdk:"meta"`
+}
+
+func nodeModel(node dbt_cloud.ArtifactNode) RunArtifactNodeModel {
+	return RunArtifactNodeModel{
+		UniqueID:     types.StringValue(node.UniqueID),
+		ResourceType: types.StringValue(node.ResourceType),
+		Tags: lo.Map(node.Tags, func(tag string, _ int) types.String {
+			return types.StringValue(tag)
+		}),
+		Meta: lo.MapValues(node.Meta, func(value any, _ string) types.String {
+			return types.StringValue(metaString(value))
+		}),
+	}
+}
+
+// metaString returns the string values of the meta as is and the other values encoded in JSON
+// so that they can be read with jsondecode()
+func metaString(value any) string {
+	if stringValue, ok := value.(string); ok {
+		return stringValue
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(encoded)
+}
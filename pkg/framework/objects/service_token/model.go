@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/samber/lo"
@@ -17,6 +18,8 @@ type ServiceTokenResourceModel struct {
 	State       types.Int64  `tfsdk:"state"`
 
 	ServiceTokenPermissions []ServiceTokenPermission `tfsdk:"service_token_permissions"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 type ServiceTokenDataSourceModel struct {
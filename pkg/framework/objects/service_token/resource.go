@@ -2,8 +2,8 @@ package service_token
 
 import (
 	"context"
+	"errors"
 	"strconv"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
@@ -52,7 +52,7 @@ func (st *serviceTokenResource) Configure(ctx context.Context, req resource.Conf
 }
 
 // Schema implements resource.Resource.
-func (st *serviceTokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (st *serviceTokenResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -133,6 +133,7 @@ func (st *serviceTokenResource) Schema(_ context.Context, _ resource.SchemaReque
 					},
 				},
 			},
+			"timeouts": helper.TimeoutsBlock(ctx),
 		},
 	}
 }
@@ -147,16 +148,20 @@ func (st *serviceTokenResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	svcTokID, err := strconv.Atoi(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to convert the service token ID to an integer", err.Error())
 		return
 	}
 
-	svcTok, err := st.client.GetServiceToken(svcTokID)
+	svcTok, err := st.client.GetServiceToken(ctx, svcTokID)
 
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			resp.Diagnostics.AddWarning(
 				"Resource not found",
 				"The service token was not found and has been removed from the state.",
@@ -164,7 +169,7 @@ func (st *serviceTokenResource) Read(ctx context.Context, req resource.ReadReque
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error getting the service token", err.Error())
+		helper.AddReadError(&resp.Diagnostics, "Error getting the service token", err)
 		return
 	}
 
@@ -173,7 +178,7 @@ func (st *serviceTokenResource) Read(ctx context.Context, req resource.ReadReque
 	state.Name = types.StringValue(svcTok.Name)
 	state.State = types.Int64Value(int64(svcTok.State))
 
-	svcTokPerms, err := st.client.GetServiceTokenPermissions(int(svcTokID))
+	svcTokPerms, err := st.client.GetServiceTokenPermissions(ctx, int(svcTokID))
 
 	if err != nil {
 		resp.Diagnostics.AddError("Error getting the service token permissions", err.Error())
@@ -202,10 +207,13 @@ func (st *serviceTokenResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	name := plan.Name.ValueString()
 	state := plan.State.ValueInt64()
 
-	createdSrvTok, err := st.client.CreateServiceToken(name, int(state))
+	createdSrvTok, err := st.client.CreateServiceToken(ctx, name, int(state))
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to create the service token", err.Error())
 		return
@@ -217,7 +225,7 @@ func (st *serviceTokenResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	updatedSvcTokPerms, err := st.client.UpdateServiceTokenPermissions(*createdSrvTok.ID, srvTokPermissions)
+	updatedSvcTokPerms, err := st.client.UpdateServiceTokenPermissions(ctx, *createdSrvTok.ID, srvTokPermissions)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to assign permissions to the service token", err.Error())
 		return
@@ -250,6 +258,9 @@ func (st *serviceTokenResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	svcTokID, err := strconv.Atoi(state.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to convert the service token ID to an integer", err.Error())
@@ -257,7 +268,7 @@ func (st *serviceTokenResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	if !plan.Name.Equal(state.Name) || !plan.State.Equal(state.State) {
-		svcTok, err := st.client.GetServiceToken(svcTokID)
+		svcTok, err := st.client.GetServiceToken(ctx, svcTokID)
 		if err != nil {
 			resp.Diagnostics.AddError("Unable to get the service token", err.Error())
 			return
@@ -267,7 +278,7 @@ func (st *serviceTokenResource) Update(ctx context.Context, req resource.UpdateR
 		svcTok.UID = state.UID.ValueString()
 		svcTok.State = int(plan.State.ValueInt64())
 
-		_, err = st.client.UpdateServiceToken(svcTokID, *svcTok)
+		_, err = st.client.UpdateServiceToken(ctx, svcTokID, *svcTok)
 		if err != nil {
 			resp.Diagnostics.AddError("Unable to update the service token", err.Error())
 			return
@@ -282,7 +293,7 @@ func (st *serviceTokenResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	updatedSvcTokPerms, err := st.client.UpdateServiceTokenPermissions(svcTokID, svcTokPerms)
+	updatedSvcTokPerms, err := st.client.UpdateServiceTokenPermissions(ctx, svcTokID, svcTokPerms)
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to update the service token permissions", err.Error())
 		return
@@ -306,6 +317,10 @@ func (st *serviceTokenResource) Delete(ctx context.Context, req resource.DeleteR
 
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if diags.HasError() {
 		return
 	}
@@ -316,7 +331,7 @@ func (st *serviceTokenResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	if _, err := st.client.DeleteServiceToken(svcTokID); err != nil {
+	if _, err := st.client.DeleteServiceToken(ctx, svcTokID); err != nil {
 		resp.Diagnostics.AddError("Unable to delete the service token", err.Error())
 		return
 	}
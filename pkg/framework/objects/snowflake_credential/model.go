@@ -0,0 +1,52 @@
+package snowflake_credential
+
+import (
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/credential"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SnowflakeCredentialResourceModel struct {
+	ID                   types.String   `tfsdk:"id"`
+	ProjectID            types.Int64    `tfsdk:"project_id"`
+	CredentialID         types.Int64    `tfsdk:"credential_id"`
+	IsActive             types.Bool     `tfsdk:"is_active"`
+	AuthType             types.String   `tfsdk:"auth_type"`
+	Database             types.String   `tfsdk:"database"`
+	Role                 types.String   `tfsdk:"role"`
+	Warehouse            types.String   `tfsdk:"warehouse"`
+	Schema               types.String   `tfsdk:"schema"`
+	User                 types.String   `tfsdk:"user"`
+	Password             types.String   `tfsdk:"password"`
+	PrivateKey           types.String   `tfsdk:"private_key"`
+	PrivateKeyPassphrase types.String   `tfsdk:"private_key_passphrase"`
+	NumThreads           types.Int64    `tfsdk:"num_threads"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+// setFromCredential updates the model with the credential returned by the API
+// the secrets are not returned and keep the value of the model
+func (m *SnowflakeCredentialResourceModel) setFromCredential(snowflakeCredential dbt_cloud.SnowflakeCredential) {
+	m.ID = credential.ID(snowflakeCredential.Project_Id, *snowflakeCredential.ID)
+	m.ProjectID = types.Int64Value(int64(snowflakeCredential.Project_Id))
+	m.CredentialID = types.Int64Value(int64(*snowflakeCredential.ID))
+	m.IsActive = types.BoolValue(snowflakeCredential.State == dbt_cloud.STATE_ACTIVE)
+	m.AuthType = types.StringValue(snowflakeCredential.Auth_Type)
+	m.Database = credential.NullIfEmpty(types.StringValue(snowflakeCredential.Database))
+	m.Role = credential.NullIfEmpty(types.StringValue(snowflakeCredential.Role))
+	m.Warehouse = credential.NullIfEmpty(types.StringValue(snowflakeCredential.Warehouse))
+	m.Schema = types.StringValue(snowflakeCredential.Schema)
+	m.User = types.StringValue(snowflakeCredential.User)
+	m.NumThreads = types.Int64Value(int64(snowflakeCredential.Threads))
+}
+
+// normalizeStateV0 converts the empty strings saved by the SDKv2 resource to null
+func normalizeStateV0(state *SnowflakeCredentialResourceModel) {
+	state.Database = credential.NullIfEmpty(state.Database)
+	state.Role = credential.NullIfEmpty(state.Role)
+	state.Warehouse = credential.NullIfEmpty(state.Warehouse)
+	state.Password = credential.NullIfEmpty(state.Password)
+	state.PrivateKey = credential.NullIfEmpty(state.PrivateKey)
+	state.PrivateKeyPassphrase = credential.NullIfEmpty(state.PrivateKeyPassphrase)
+}
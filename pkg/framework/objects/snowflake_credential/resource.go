@@ -0,0 +1,176 @@
+package snowflake_credential
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/credential"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                 = &snowflakeCredentialResource{}
+	_ resource.ResourceWithConfigure    = &snowflakeCredentialResource{}
+	_ resource.ResourceWithImportState  = &snowflakeCredentialResource{}
+	_ resource.ResourceWithUpgradeState = &snowflakeCredentialResource{}
+)
+
+func SnowflakeCredentialResource() resource.Resource {
+	return &snowflakeCredentialResource{
+		Base: credential.Base[dbt_cloud.SnowflakeCredential]{TypeName: "snowflake_credential"},
+	}
+}
+
+type snowflakeCredentialResource struct {
+	credential.Base[dbt_cloud.SnowflakeCredential]
+}
+
+func (r *snowflakeCredentialResource) Read(
+	ctx context.Context,
+	req resource.ReadRequest,
+	resp *resource.ReadResponse,
+) {
+	var state SnowflakeCredentialResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	snowflakeCredential := r.ReadCredential(ctx, state.ID, resp)
+	if snowflakeCredential == nil {
+		return
+	}
+
+	state.setFromCredential(*snowflakeCredential)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *snowflakeCredentialResource) Create(
+	ctx context.Context,
+	req resource.CreateRequest,
+	resp *resource.CreateResponse,
+) {
+	var plan SnowflakeCredentialResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	snowflakeCredential, err := r.Client.CreateSnowflakeCredential(
+		ctx,
+		int(plan.ProjectID.ValueInt64()),
+		"snowflake",
+		plan.IsActive.ValueBool(),
+		plan.Database.ValueString(),
+		plan.Role.ValueString(),
+		plan.Warehouse.ValueString(),
+		plan.Schema.ValueString(),
+		plan.User.ValueString(),
+		plan.Password.ValueString(),
+		plan.PrivateKey.ValueString(),
+		plan.PrivateKeyPassphrase.ValueString(),
+		plan.AuthType.ValueString(),
+		int(plan.NumThreads.ValueInt64()),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to create Snowflake credential",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = credential.ID(snowflakeCredential.Project_Id, *snowflakeCredential.ID)
+	plan.CredentialID = types.Int64Value(int64(*snowflakeCredential.ID))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *snowflakeCredentialResource) Update(
+	ctx context.Context,
+	req resource.UpdateRequest,
+	resp *resource.UpdateResponse,
+) {
+	var plan, state SnowflakeCredentialResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := helper.WithTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	projectID, credentialID, ok := r.SplitID(state.ID.ValueString(), &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	client := r.Credentials()
+	snowflakeCredential, err := client.Get(ctx, projectID, credentialID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Issue getting Snowflake credential",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	// only the fields changed in the config are updated, the secrets are not returned by the API
+	if !plan.AuthType.Equal(state.AuthType) {
+		snowflakeCredential.Auth_Type = plan.AuthType.ValueString()
+	}
+	if !plan.Database.Equal(state.Database) {
+		snowflakeCredential.Database = plan.Database.ValueString()
+	}
+	if !plan.Role.Equal(state.Role) {
+		snowflakeCredential.Role = plan.Role.ValueString()
+	}
+	if !plan.Warehouse.Equal(state.Warehouse) {
+		snowflakeCredential.Warehouse = plan.Warehouse.ValueString()
+	}
+	if !plan.Schema.Equal(state.Schema) {
+		snowflakeCredential.Schema = plan.Schema.ValueString()
+	}
+	if !plan.User.Equal(state.User) {
+		snowflakeCredential.User = plan.User.ValueString()
+	}
+	if !plan.Password.Equal(state.Password) {
+		snowflakeCredential.Password = plan.Password.ValueString()
+	}
+	if !plan.PrivateKey.Equal(state.PrivateKey) {
+		snowflakeCredential.PrivateKey = plan.PrivateKey.ValueString()
+	}
+	if !plan.PrivateKeyPassphrase.Equal(state.PrivateKeyPassphrase) {
+		snowflakeCredential.PrivateKeyPassphrase = plan.PrivateKeyPassphrase.ValueString()
+	}
+	if !plan.NumThreads.Equal(state.NumThreads) {
+		snowflakeCredential.Threads = int(plan.NumThreads.ValueInt64())
+	}
+
+	_, err = client.Update(ctx, projectID, credentialID, *snowflakeCredential)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to update Snowflake credential",
+			"Error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *snowflakeCredentialResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return credential.UpgradeStateV0(ctx, r, normalizeStateV0)
+}
@@ -1,6 +1,7 @@
-package resources_test
+package snowflake_credential_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -10,6 +11,7 @@ import (
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -34,7 +36,7 @@ func TestAccDbtCloudSnowflakeCredentialResource(t *testing.T) {
 	privateKeyPassphrase := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckDbtCloudSnowflakeCredentialDestroy,
 		Steps: []resource.TestStep{
@@ -138,7 +140,7 @@ func TestAccDbtCloudSnowflakeCredentialResource(t *testing.T) {
 	})
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { testAccPreCheck(t) },
+		PreCheck:                 func() { acctest_helper.TestAccPreCheck(t) },
 		ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
 		CheckDestroy:             testAccCheckDbtCloudSnowflakeCredentialDestroy,
 		Steps: []resource.TestStep{
@@ -207,6 +209,63 @@ func TestAccDbtCloudSnowflakeCredentialResource(t *testing.T) {
 	})
 }
 
+func TestAccDbtCloudSnowflakeCredentialResourceUpgradeFromSDKv2(t *testing.T) {
+
+	projectName := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	schema := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	user := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+	password := strings.ToUpper(acctest.RandStringFromCharSet(10, acctest.CharSetAlpha))
+
+	// the optional database, role and warehouse are not set and were saved as empty strings by the SDKv2
+	config := fmt.Sprintf(`
+resource "dbtcloud_project" "test_project" {
+  name        = "%s"
+}
+resource "dbtcloud_snowflake_credential" "test_credential" {
+    project_id = dbtcloud_project.test_project.id
+    auth_type = "password"
+    schema = "%s"
+    user = "%s"
+    password = "%s"
+    num_threads = 3
+}
+`, projectName, schema, user, password)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest_helper.TestAccPreCheck(t) },
+		CheckDestroy: testAccCheckDbtCloudSnowflakeCredentialDestroy,
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"dbtcloud": {
+						Source:            "dbt-labs/dbtcloud",
+						VersionConstraint: "0.3.22",
+					},
+				},
+				Config: config,
+			},
+			{
+				ProtoV6ProviderFactories: acctest_helper.TestAccProtoV6ProviderFactories,
+				Config:                   config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDbtCloudSnowflakeCredentialExists(
+						"dbtcloud_snowflake_credential.test_credential",
+					),
+					resource.TestCheckNoResourceAttr(
+						"dbtcloud_snowflake_credential.test_credential",
+						"database",
+					),
+				),
+			},
+		},
+	})
+}
+
 func testAccDbtCloudSnowflakeCredentialResourceBasicConfig(
 	projectName, database, role, warehouse, schema, user, password string,
 ) string {
@@ -274,7 +333,7 @@ func testAccCheckDbtCloudSnowflakeCredentialExists(resource string) resource.Tes
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		_, err = apiClient.GetSnowflakeCredential(projectId, credentialId)
+		_, err = apiClient.GetSnowflakeCredential(context.Background(), projectId, credentialId)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -300,7 +359,7 @@ func testAccCheckDbtCloudSnowflakeCredentialDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = apiClient.GetSnowflakeCredential(projectId, credentialId)
+		_, err = apiClient.GetSnowflakeCredential(context.Background(), projectId, credentialId)
 		if err == nil {
 			return fmt.Errorf("Snowflake credential still exists")
 		}
@@ -0,0 +1,47 @@
+package snowflake_credential
+
+import (
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/testutil"
+)
+
+// a state saved by the SDKv2 version of the resource, with the zero values of the attributes not configured
+const snowflakeCredentialStateV0 = `{
+	"id": "10:20",
+	"project_id": 10,
+	"credential_id": 20,
+	"is_active": true,
+	"auth_type": "keypair",
+	"database": "",
+	"role": "transformer",
+	"warehouse": "",
+	"schema": "analytics",
+	"user": "dbt",
+	"password": "",
+	"private_key": "key",
+	"private_key_passphrase": "",
+	"num_threads": 4,
+	"timeouts": null
+}`
+
+func TestSnowflakeCredentialResourceUpgradeStateV0(t *testing.T) {
+	state := testutil.UpgradeState[SnowflakeCredentialResourceModel](t, SnowflakeCredentialResource().(*snowflakeCredentialResource), 0, snowflakeCredentialStateV0)
+
+	if state.ID.ValueString() != "10:20" || state.CredentialID.ValueInt64() != 20 {
+		t.Errorf("expected the ID 10:20 and the credential ID 20, got %s and %s", state.ID, state.CredentialID)
+	}
+	if !state.Database.IsNull() || !state.Warehouse.IsNull() || !state.Password.IsNull() ||
+		!state.PrivateKeyPassphrase.IsNull() {
+		t.Errorf(
+			"expected the empty values to be null, got database %s, warehouse %s, password %s and private_key_passphrase %s",
+			state.Database,
+			state.Warehouse,
+			state.Password,
+			state.PrivateKeyPassphrase,
+		)
+	}
+	if state.Role.ValueString() != "transformer" || state.PrivateKey.ValueString() != "key" {
+		t.Errorf("expected the values set to be kept, got role %s and private_key %s", state.Role, state.PrivateKey)
+	}
+}
@@ -0,0 +1,104 @@
+package snowflake_credential
+
+import (
+	"context"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/credential"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/samber/lo"
+)
+
+var authTypes = []string{
+	"password",
+	"keypair",
+}
+
+func (r *snowflakeCredentialResource) Schema(
+	ctx context.Context,
+	_ resource.SchemaRequest,
+	resp *resource.SchemaResponse,
+) {
+	resp.Schema = schema.Schema{
+		Description: "Manage the credentials of the Snowflake environments of a project",
+		// the SDKv2 resource was at version 0, the state is upgraded with UpgradeState
+		Version: 1,
+		Attributes: lo.Assign(
+			credential.CommonAttributes("Snowflake"),
+			map[string]schema.Attribute{
+				"is_active": schema.BoolAttribute{
+					Optional:    true,
+					Computed:    true,
+					Default:     booldefault.StaticBool(true),
+					Description: "Whether the Snowflake credential is active",
+				},
+				"auth_type": schema.StringAttribute{
+					Required:    true,
+					Description: "The type of Snowflake credential ('password' or 'keypair')",
+					Validators: []validator.String{
+						stringvalidator.OneOf(authTypes...),
+					},
+				},
+				"database": schema.StringAttribute{
+					Optional:    true,
+					Description: "Database to connect to",
+				},
+				"role": schema.StringAttribute{
+					Optional:    true,
+					Description: "Role to assume",
+				},
+				"warehouse": schema.StringAttribute{
+					Optional:    true,
+					Description: "Warehouse to use",
+				},
+				"schema": schema.StringAttribute{
+					Required:    true,
+					Description: "Default schema name",
+				},
+				"user": schema.StringAttribute{
+					Required:    true,
+					Description: "Username for Snowflake",
+				},
+				"password": schema.StringAttribute{
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Password for Snowflake",
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(
+							path.MatchRoot("private_key"),
+							path.MatchRoot("private_key_passphrase"),
+						),
+					},
+				},
+				"private_key": schema.StringAttribute{
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Private key for Snowflake",
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(path.MatchRoot("password")),
+					},
+				},
+				"private_key_passphrase": schema.StringAttribute{
+					Optional:    true,
+					Sensitive:   true,
+					Description: "Private key passphrase for Snowflake",
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(path.MatchRoot("password")),
+					},
+				},
+				"num_threads": schema.Int64Attribute{
+					Required:    true,
+					Description: "Number of threads to use",
+				},
+			},
+		),
+		Blocks: map[string]schema.Block{
+			"timeouts": helper.TimeoutsBlock(ctx),
+		},
+	}
+}
@@ -39,7 +39,7 @@ func (d *userDataSource) Read(
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 
-	user, err := d.client.GetUser(string(state.Email.ValueString()))
+	user, err := d.client.GetUser(ctx, string(state.Email.ValueString()))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Did not find user with email: %s", state.Email.ValueString()),
@@ -0,0 +1,56 @@
+package testutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// UpgradeState runs the state upgrader of the resource for the version given on a state saved as JSON
+// and returns the upgraded state read into the model M
+// it lives outside of acctest_helper as the resource packages can't import the provider in their unit tests
+func UpgradeState[M any](
+	t *testing.T,
+	r resource.ResourceWithUpgradeState,
+	version int64,
+	priorStateJSON string,
+) M {
+	t.Helper()
+	ctx := context.Background()
+
+	upgrader, ok := r.UpgradeState(ctx)[version]
+	if !ok {
+		t.Fatalf("no state upgrader for the version %d", version)
+	}
+
+	priorType := upgrader.PriorSchema.Type().TerraformType(ctx)
+	priorValue, err := (&tfprotov6.RawState{JSON: []byte(priorStateJSON)}).Unmarshal(priorType)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schemaResp := resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	currentType := schemaResp.Schema.Type().TerraformType(ctx)
+
+	req := resource.UpgradeStateRequest{
+		State: &tfsdk.State{Schema: *upgrader.PriorSchema, Raw: priorValue},
+	}
+	resp := resource.UpgradeStateResponse{
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(currentType, nil)},
+	}
+	upgrader.StateUpgrader(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors upgrading the state: %v", resp.Diagnostics)
+	}
+
+	var state M
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("unexpected errors reading the upgraded state: %v", diags)
+	}
+	return state
+}
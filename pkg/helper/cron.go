@@ -0,0 +1,207 @@
+package helper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CRON_MAX_YEARS bounds the search of the next run of a schedule, e.g. a schedule on the 29th of February
+// runs at least once every 8 years while a schedule on the 30th of February never runs
+const CRON_MAX_YEARS = 10
+
+// CronSchedule is a standard cron expression with 5 fields (minute, hour, day of month, month and day of week)
+// as used by the dbt Cloud schedules, the times are in UTC
+type CronSchedule struct {
+	minutes     uint64
+	hours       uint64
+	daysOfMonth uint64
+	months      uint64
+	daysOfWeek  uint64
+	// when either of the day fields is *, both need to match, otherwise the schedule runs when one of them matches
+	anyDay bool
+}
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}},
+	// both 0 and 7 are Sunday
+	{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}},
+}
+
+// ParseCron parses a cron expression, e.g. `0 6,18 * * MON-FRI`
+// each field accepts *, values, ranges, steps and lists of them, months and days of week also accept their names
+func ParseCron(expression string) (*CronSchedule, error) {
+	fields := strings.Fields(expression)
+	if len(fields) != len(cronFields) {
+		return nil, fmt.Errorf(
+			"the cron expression %q needs 5 fields (minute, hour, day of month, month and day of week), got %d",
+			expression,
+			len(fields),
+		)
+	}
+
+	values := make([]uint64, len(fields))
+	for i, field := range fields {
+		bits, err := parseCronField(field, cronFields[i])
+		if err != nil {
+			return nil, fmt.Errorf(
+				"invalid %s %q in the cron expression %q: %w",
+				cronFields[i].name,
+				field,
+				expression,
+				err,
+			)
+		}
+		values[i] = bits
+	}
+
+	daysOfWeek := values[4]
+	if daysOfWeek&(1<<7) != 0 {
+		daysOfWeek = daysOfWeek&^(1<<7) | 1
+	}
+
+	return &CronSchedule{
+		minutes:     values[0],
+		hours:       values[1],
+		daysOfMonth: values[2],
+		months:      values[3],
+		daysOfWeek:  daysOfWeek,
+		anyDay:      strings.HasPrefix(fields[2], "*") || strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+// parseCronField returns the values of the field as bits, e.g. 1<<5 for the minute 5
+func parseCronField(field string, spec cronField) (uint64, error) {
+	var bits uint64
+
+	for _, part := range strings.Split(field, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step < 1 {
+				return 0, fmt.Errorf("the step %q needs to be a positive number", stepPart)
+			}
+		}
+
+		var start, end int
+		if rangePart == "*" {
+			start, end = spec.min, spec.max
+		} else if low, high, isRange := strings.Cut(rangePart, "-"); isRange {
+			var err error
+			if start, err = parseCronValue(low, spec); err != nil {
+				return 0, err
+			}
+			if end, err = parseCronValue(high, spec); err != nil {
+				return 0, err
+			}
+			if start > end {
+				return 0, fmt.Errorf("the range %q starts after it ends", rangePart)
+			}
+		} else {
+			var err error
+			if start, err = parseCronValue(rangePart, spec); err != nil {
+				return 0, err
+			}
+			end = start
+			// e.g. 5/15 is the same as 5-59/15 for the minutes
+			if hasStep {
+				end = spec.max
+			}
+		}
+
+		for value := start; value <= end; value += step {
+			bits |= 1 << value
+		}
+	}
+
+	return bits, nil
+}
+
+func parseCronValue(value string, spec cronField) (int, error) {
+	if number, ok := spec.names[strings.ToUpper(value)]; ok {
+		return number, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		if value == "" {
+			return 0, fmt.Errorf("a value is missing")
+		}
+		return 0, fmt.Errorf("%q is not a number", value)
+	}
+	if number < spec.min || number > spec.max {
+		return 0, fmt.Errorf("%d needs to be between %d and %d", number, spec.min, spec.max)
+	}
+	return number, nil
+}
+
+// Next returns the first time strictly after the time provided when the schedule runs, in UTC
+// ok is false when the schedule doesn't run in the next CRON_MAX_YEARS years, e.g. on the 30th of February
+func (s *CronSchedule) Next(after time.Time) (next time.Time, ok bool) {
+	t := after.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(CRON_MAX_YEARS, 0, 0)
+
+	for t.Before(limit) {
+		if s.months&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if s.hours&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if s.minutes&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t, true
+	}
+
+	return time.Time{}, false
+}
+
+// NextRuns returns the next count times when the schedule runs after the time provided, in UTC
+func (s *CronSchedule) NextRuns(after time.Time, count int) []time.Time {
+	runs := []time.Time{}
+	for len(runs) < count {
+		next, ok := s.Next(after)
+		if !ok {
+			break
+		}
+		runs = append(runs, next)
+		after = next
+	}
+	return runs
+}
+
+func (s *CronSchedule) dayMatches(t time.Time) bool {
+	dayOfMonth := s.daysOfMonth&(1<<uint(t.Day())) != 0
+	dayOfWeek := s.daysOfWeek&(1<<uint(t.Weekday())) != 0
+	if s.anyDay {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
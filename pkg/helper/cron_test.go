@@ -0,0 +1,91 @@
+package helper
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseCronErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		expression string
+		expected   string
+	}{
+		{expression: "0 6 * *", expected: "needs 5 fields"},
+		{expression: "0 6 * * * *", expected: "needs 5 fields"},
+		{expression: "60 6 * * *", expected: `invalid minute "60"`},
+		{expression: "0 24 * * *", expected: "24 needs to be between 0 and 23"},
+		{expression: "0 6 0 * *", expected: "invalid day of month"},
+		{expression: "0 6 * 13 *", expected: "invalid month"},
+		{expression: "0 6 * * 8", expected: "invalid day of week"},
+		{expression: "0 6 * * MON-", expected: "a value is missing"},
+		{expression: "0 6-2 * * *", expected: `the range "6-2" starts after it ends`},
+		{expression: "*/0 * * * *", expected: `the step "0" needs to be a positive number`},
+		{expression: "0 six * * *", expected: `"six" is not a number`},
+		{expression: "0 6,,8 * * *", expected: "a value is missing"},
+	}
+
+	for _, tc := range testCases {
+		_, err := ParseCron(tc.expression)
+		if err == nil || !strings.Contains(err.Error(), tc.expected) {
+			t.Errorf("expected an error containing %q for %q, got %v", tc.expected, tc.expression, err)
+		}
+	}
+}
+
+func TestCronScheduleNextRuns(t *testing.T) {
+	t.Parallel()
+
+	// a Wednesday
+	after := time.Date(2024, 5, 15, 10, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		expression string
+		expected   []string
+	}{
+		{
+			expression: "*/20 * * * *",
+			expected:   []string{"2024-05-15T10:40:00Z", "2024-05-15T11:00:00Z", "2024-05-15T11:20:00Z"},
+		},
+		{
+			expression: "0 6,18 * * MON-FRI",
+			expected:   []string{"2024-05-15T18:00:00Z", "2024-05-16T06:00:00Z", "2024-05-16T18:00:00Z"},
+		},
+		{
+			expression: "30 10 * * sat,7",
+			expected:   []string{"2024-05-18T10:30:00Z", "2024-05-19T10:30:00Z", "2024-05-25T10:30:00Z"},
+		},
+		{
+			// the 1st of the month or the Fridays
+			expression: "0 0 1 * 5",
+			expected:   []string{"2024-05-17T00:00:00Z", "2024-05-24T00:00:00Z", "2024-05-31T00:00:00Z"},
+		},
+		{
+			expression: "15 2 29 FEB *",
+			expected:   []string{"2028-02-29T02:15:00Z", "2032-02-29T02:15:00Z", "2036-02-29T02:15:00Z"},
+		},
+		{
+			expression: "0 0 30 2 *",
+			expected:   []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		schedule, err := ParseCron(tc.expression)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %v", tc.expression, err)
+		}
+
+		runs := schedule.NextRuns(after, 3)
+		if len(runs) != len(tc.expected) {
+			t.Fatalf("expected %d runs for %q, got %v", len(tc.expected), tc.expression, runs)
+		}
+		for i, run := range runs {
+			if run.Format(time.RFC3339) != tc.expected[i] {
+				t.Errorf("expected the run %d of %q at %s, got %s", i, tc.expression, tc.expected[i], run.Format(time.RFC3339))
+			}
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// AddReadError adds the error returned when reading an object to the diagnostics
+// a missing permission gets its own diagnostic so that it is not mistaken for a provider or API issue
+func AddReadError(diags *diag.Diagnostics, summary string, err error) {
+	var permissionErr *dbt_cloud.PermissionDeniedError
+	if errors.As(err, &permissionErr) {
+		diags.AddError(PermissionDeniedSummary(permissionErr), PermissionDeniedDetail(permissionErr))
+		return
+	}
+	diags.AddError(summary, err.Error())
+}
+
+// PermissionDeniedSummary returns the summary of the diagnostic for a permission denied error, e.g. "Permission denied (403)"
+func PermissionDeniedSummary(err *dbt_cloud.PermissionDeniedError) string {
+	return fmt.Sprintf("Permission denied (%d)", err.StatusCode)
+}
+
+// PermissionDeniedDetail returns the detail of the diagnostic for a permission denied error
+func PermissionDeniedDetail(err *dbt_cloud.PermissionDeniedError) string {
+	detail := fmt.Sprintf(
+		"The token configured doesn't have the permission to read %s.\n"+
+			"Check the permission sets of the token and the projects it has access to.",
+		err.URL,
+	)
+	if message := err.Message(); message != "" {
+		detail = fmt.Sprintf("%s\n\ndbt Cloud returned: %s", detail, message)
+	}
+	return detail
+}
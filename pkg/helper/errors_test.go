@@ -0,0 +1,64 @@
+package helper
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestAddReadError(t *testing.T) {
+	t.Parallel()
+
+	permissionErr := &dbt_cloud.PermissionDeniedError{
+		APIRequestError: dbt_cloud.APIRequestError{
+			Method:      "GET",
+			URL:         "https://cloud.getdbt.com/api/v3/accounts/1/projects/1/",
+			StatusCode:  403,
+			UserMessage: "You do not have permission",
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		err         error
+		wantSummary string
+		wantDetail  string
+	}{
+		{
+			name:        "permission denied",
+			err:         fmt.Errorf("wrapped: %w", permissionErr),
+			wantSummary: "Permission denied (403)",
+			wantDetail:  "You do not have permission",
+		},
+		{
+			name:        "other errors",
+			err:         errors.New("connection reset"),
+			wantSummary: "Error getting the project",
+			wantDetail:  "connection reset",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			AddReadError(&diags, "Error getting the project", tc.err)
+
+			if len(diags) != 1 || !diags.HasError() {
+				t.Fatalf("expected one error, got %v", diags)
+			}
+			if diags[0].Summary() != tc.wantSummary {
+				t.Errorf("summary: got %q, want %q", diags[0].Summary(), tc.wantSummary)
+			}
+			if !strings.Contains(diags[0].Detail(), tc.wantDetail) {
+				t.Errorf("detail %q doesn't contain %q", diags[0].Detail(), tc.wantDetail)
+			}
+		})
+	}
+}
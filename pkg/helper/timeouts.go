@@ -0,0 +1,44 @@
+package helper
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DEFAULT_TIMEOUT is used for the operations without a timeout configured, it is the same as the SDKv2 default
+const DEFAULT_TIMEOUT = 20 * time.Minute
+
+// TimeoutsBlock returns the timeouts {} block of the Framework resources
+func TimeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+}
+
+// NullTimeouts is the value of the timeouts block for the states not built from a plan or a state, e.g. on import
+// the zero value of timeouts.Value doesn't have the attribute types of the block and can't be saved in the state
+func NullTimeouts(ctx context.Context) timeouts.Value {
+	attributeTypes := TimeoutsBlock(ctx).Type().(timeouts.Type).AttributeTypes()
+	return timeouts.Value{Object: types.ObjectNull(attributeTypes)}
+}
+
+// WithTimeout returns a context cancelled after the timeout configured for the operation, or after DEFAULT_TIMEOUT
+// timeout is the method of the operation, e.g. plan.Timeouts.Create
+func WithTimeout(
+	ctx context.Context,
+	timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics),
+	diags *diag.Diagnostics,
+) (context.Context, context.CancelFunc) {
+	duration, timeoutDiags := timeout(ctx, DEFAULT_TIMEOUT)
+	diags.Append(timeoutDiags...)
+
+	return context.WithTimeout(ctx, duration)
+}
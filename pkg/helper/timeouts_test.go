@@ -0,0 +1,86 @@
+package helper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestNullTimeouts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{Computed: true},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": TimeoutsBlock(ctx),
+		},
+	}
+	state := tfsdk.State{
+		Schema: resourceSchema,
+		Raw:    tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil),
+	}
+
+	model := struct {
+		ID       types.Int64    `tfsdk:"id"`
+		Timeouts timeouts.Value `tfsdk:"timeouts"`
+	}{
+		ID:       types.Int64Value(1),
+		Timeouts: NullTimeouts(ctx),
+	}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("unexpected error saving null timeouts in the state: %v", diags)
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	attributeTypes := TimeoutsBlock(ctx).Type().(timeouts.Type).AttributeTypes()
+	configured := timeouts.Value{Object: types.ObjectValueMust(attributeTypes, map[string]attr.Value{
+		"create": types.StringValue("5m"),
+		"read":   types.StringNull(),
+		"update": types.StringValue("not a duration"),
+		"delete": types.StringNull(),
+	})}
+
+	testCases := []struct {
+		name     string
+		timeout  func(context.Context, time.Duration) (time.Duration, diag.Diagnostics)
+		expected time.Duration
+		err      bool
+	}{
+		{name: "configured", timeout: configured.Create, expected: 5 * time.Minute},
+		{name: "not configured", timeout: configured.Read, expected: DEFAULT_TIMEOUT},
+		{name: "block not set", timeout: NullTimeouts(ctx).Delete, expected: DEFAULT_TIMEOUT},
+		{name: "invalid", timeout: configured.Update, expected: DEFAULT_TIMEOUT, err: true},
+	}
+
+	for _, tc := range testCases {
+		var diags diag.Diagnostics
+		timeoutCtx, cancel := WithTimeout(ctx, tc.timeout, &diags)
+		defer cancel()
+
+		if diags.HasError() != tc.err {
+			t.Errorf("%s: unexpected diagnostics %v", tc.name, diags)
+		}
+		deadline, ok := timeoutCtx.Deadline()
+		if !ok {
+			t.Fatalf("%s: expected a deadline", tc.name)
+		}
+		if remaining := time.Until(deadline); remaining > tc.expected || remaining < tc.expected-time.Minute {
+			t.Errorf("%s: expected a deadline in %s, got %s", tc.name, tc.expected, remaining)
+		}
+	}
+}
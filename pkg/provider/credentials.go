@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	DEFAULT_HOST_URL      = "https://cloud.getdbt.com/api"
+	DEFAULT_PROFILES_FILE = "~/.dbt/dbt_cloud.yml"
+)
+
+// credentials holds the settings used to authenticate to dbt Cloud
+// they can come from the provider attributes, the environment variables or a profile
+type credentials struct {
+	AccountID    int
+	HostURL      string
+	Token        string
+	TokenFile    string
+	TokenCommand string
+	Profile      string
+	ProfilesFile string
+}
+
+// profile is an entry of the profiles file, e.g.
+//
+//	profiles:
+//	  prod:
+//	    account_id: 123
+//	    host_url: https://emea.dbt.com/api
+//	    token_command: vault kv get -field=token secret/dbt_cloud
+type profile struct {
+	AccountID    int    `yaml:"account_id"`
+	HostURL      string `yaml:"host_url"`
+	Token        string `yaml:"token"`
+	TokenFile    string `yaml:"token_file"`
+	TokenCommand string `yaml:"token_command"`
+}
+
+type profilesFile struct {
+	Profiles map[string]profile `yaml:"profiles"`
+}
+
+func credentialsFromEnv() credentials {
+	accountID, _ := strconv.Atoi(os.Getenv("DBT_CLOUD_ACCOUNT_ID"))
+	return credentials{
+		AccountID:    accountID,
+		HostURL:      os.Getenv("DBT_CLOUD_HOST_URL"),
+		Token:        os.Getenv("DBT_CLOUD_TOKEN"),
+		TokenFile:    os.Getenv("DBT_CLOUD_TOKEN_FILE"),
+		TokenCommand: os.Getenv("DBT_CLOUD_TOKEN_COMMAND"),
+		Profile:      os.Getenv("DBT_CLOUD_PROFILE"),
+		ProfilesFile: os.Getenv("DBT_CLOUD_PROFILES_FILE"),
+	}
+}
+
+// the credentials already resolved, keyed by the attributes and the environment variables they were resolved from
+// the SDKv2 and Framework providers are configured with the same values, so the second one reuses the result
+// of the first one instead of reading the token file or running the token command again
+var (
+	sharedCredentials   = map[sharedCredentialsKey]credentials{}
+	sharedCredentialsMu sync.Mutex
+)
+
+type sharedCredentialsKey struct {
+	attributes credentials
+	env        credentials
+}
+
+// getSharedCredentials returns the credentials resolved for the attributes, resolving them if needed
+// errors are not kept so that a failing token command is retried
+func getSharedCredentials(ctx context.Context, attributes credentials) (credentials, error) {
+	key := sharedCredentialsKey{attributes: attributes, env: credentialsFromEnv()}
+
+	sharedCredentialsMu.Lock()
+	defer sharedCredentialsMu.Unlock()
+
+	if resolved, ok := sharedCredentials[key]; ok {
+		return resolved, nil
+	}
+
+	resolved, err := resolveCredentials(ctx, attributes)
+	if err != nil {
+		return resolved, err
+	}
+	sharedCredentials[key] = resolved
+	return resolved, nil
+}
+
+// resolveCredentials returns the account ID, host URL and token to use for the client
+//
+// Each value is read from the provider attributes, then from the environment variables and finally from
+// the profile, which only fills the values still empty. For each source, the token is read from `token`,
+// then `token_file` and then `token_command`.
+// A profile set in the provider attributes is used as a whole: the environment variables of the account,
+// host and token are ignored so that the profile is not mixed with the credentials of another account.
+func resolveCredentials(ctx context.Context, attributes credentials) (credentials, error) {
+	resolved := credentials{}
+
+	env := credentialsFromEnv()
+	sources := []credentials{attributes, env}
+
+	profileName := attributes.Profile
+	if profileName != "" {
+		sources = []credentials{attributes}
+		if env.AccountID != 0 || env.HostURL != "" || env.Token != "" || env.TokenFile != "" || env.TokenCommand != "" {
+			tflog.Warn(ctx, "The profile is set in the provider, the dbt Cloud credentials from the environment variables are ignored", map[string]any{
+				"profile": profileName,
+			})
+		}
+	} else {
+		profileName = env.Profile
+	}
+	if profileName != "" {
+		profilesFilePath := attributes.ProfilesFile
+		if profilesFilePath == "" {
+			profilesFilePath = env.ProfilesFile
+		}
+		if profilesFilePath == "" {
+			profilesFilePath = DEFAULT_PROFILES_FILE
+		}
+
+		p, err := readProfile(profilesFilePath, profileName)
+		if err != nil {
+			return resolved, err
+		}
+		sources = append(sources, p.credentials())
+	}
+
+	for _, source := range sources {
+		if resolved.AccountID == 0 {
+			resolved.AccountID = source.AccountID
+		}
+		if resolved.HostURL == "" {
+			resolved.HostURL = source.HostURL
+		}
+		if resolved.Token == "" {
+			// the token is read lazily so that a token command is only run when no other source has a token
+			token, err := source.readToken(ctx)
+			if err != nil {
+				return resolved, err
+			}
+			resolved.Token = token
+		}
+	}
+
+	if resolved.HostURL == "" {
+		resolved.HostURL = DEFAULT_HOST_URL
+	}
+
+	return resolved, nil
+}
+
+// ResolveCredentials resolves the credentials for the commands of the provider binary, e.g. `export`
+// the values given play the role of the provider attributes, the rest comes from the environment
+// variables and the profiles file like for the provider
+func ResolveCredentials(
+	ctx context.Context,
+	accountID int,
+	hostURL string,
+	profile string,
+) (int, string, string, error) {
+	resolved, err := resolveCredentials(
+		ctx,
+		credentials{AccountID: accountID, HostURL: hostURL, Profile: profile},
+	)
+	return resolved.AccountID, resolved.HostURL, resolved.Token, err
+}
+
+func (p profile) credentials() credentials {
+	return credentials{
+		AccountID:    p.AccountID,
+		HostURL:      p.HostURL,
+		Token:        p.Token,
+		TokenFile:    p.TokenFile,
+		TokenCommand: p.TokenCommand,
+	}
+}
+
+func (c credentials) readToken(ctx context.Context) (string, error) {
+	if c.Token != "" {
+		return c.Token, nil
+	}
+	if c.TokenFile != "" {
+		return readTokenFile(c.TokenFile)
+	}
+	if c.TokenCommand != "" {
+		return runTokenCommand(ctx, c.TokenCommand)
+	}
+	return "", nil
+}
+
+func readTokenFile(path string) (string, error) {
+	content, err := os.ReadFile(expandHome(path))
+	if err != nil {
+		return "", fmt.Errorf("unable to read the token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("the token file %s is empty", path)
+	}
+	return token, nil
+}
+
+// runTokenCommand runs the command in a shell and returns its trimmed stdout
+// stdout is never part of the errors as it might contain a partial token
+func runTokenCommand(ctx context.Context, command string) (string, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", command)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf(
+			"the token command failed: %w\n%s",
+			err,
+			strings.TrimSpace(stderr.String()),
+		)
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", fmt.Errorf("the token command didn't return any token")
+	}
+	return token, nil
+}
+
+func readProfile(path string, name string) (profile, error) {
+	content, err := os.ReadFile(expandHome(path))
+	if err != nil {
+		return profile{}, fmt.Errorf("unable to read the profiles file to get the profile %s: %w", name, err)
+	}
+
+	profiles := profilesFile{}
+	if err := yaml.Unmarshal(content, &profiles); err != nil {
+		return profile{}, fmt.Errorf("unable to parse the profiles file %s: %w", path, err)
+	}
+
+	p, ok := profiles.Profiles[name]
+	if !ok {
+		available := []string{}
+		for profileName := range profiles.Profiles {
+			available = append(available, profileName)
+		}
+		sort.Strings(available)
+		return profile{}, fmt.Errorf(
+			"the profile %s doesn't exist in %s, available profiles: %s",
+			name,
+			path,
+			strings.Join(available, ", "),
+		)
+	}
+	return p, nil
+}
+
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestResolveCredentials(t *testing.T) {
+	for _, env := range []string{
+		"DBT_CLOUD_ACCOUNT_ID",
+		"DBT_CLOUD_HOST_URL",
+		"DBT_CLOUD_TOKEN",
+		"DBT_CLOUD_TOKEN_FILE",
+		"DBT_CLOUD_TOKEN_COMMAND",
+		"DBT_CLOUD_PROFILE",
+		"DBT_CLOUD_PROFILES_FILE",
+	} {
+		t.Setenv(env, "")
+	}
+
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	if err := os.WriteFile(tokenFile, []byte("  token-from-file\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	profilesFile := filepath.Join(dir, "dbt_cloud.yml")
+	profiles := `
+profiles:
+  prod:
+    account_id: 123
+    host_url: https://emea.dbt.com/api
+    token: token-from-profile
+  staging:
+    account_id: 456
+    token_file: ` + tokenFile + `
+  vault:
+    account_id: 789
+    token_command: exit 1
+`
+	if err := os.WriteFile(profilesFile, []byte(profiles), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name       string
+		attributes credentials
+		env        map[string]string
+		expected   credentials
+		err        string
+	}{
+		{
+			name:       "attributes",
+			attributes: credentials{AccountID: 1, Token: "token"},
+			expected:   credentials{AccountID: 1, Token: "token", HostURL: DEFAULT_HOST_URL},
+		},
+		{
+			name:       "attributes take precedence over the environment",
+			attributes: credentials{TokenFile: tokenFile},
+			env:        map[string]string{"DBT_CLOUD_ACCOUNT_ID": "2", "DBT_CLOUD_TOKEN": "token-from-env"},
+			expected:   credentials{AccountID: 2, Token: "token-from-file", HostURL: DEFAULT_HOST_URL},
+		},
+		{
+			name:       "token command",
+			attributes: credentials{AccountID: 1, TokenCommand: "echo '  token-from-command  '"},
+			expected:   credentials{AccountID: 1, Token: "token-from-command", HostURL: DEFAULT_HOST_URL},
+		},
+		{
+			name:       "failing token command",
+			attributes: credentials{AccountID: 1, TokenCommand: "echo 'vault is sealed' >&2; exit 1"},
+			err:        "vault is sealed",
+		},
+		{
+			name:       "profile",
+			attributes: credentials{Profile: "prod", ProfilesFile: profilesFile},
+			expected:   credentials{AccountID: 123, Token: "token-from-profile", HostURL: "https://emea.dbt.com/api"},
+		},
+		{
+			name:       "profile from the environment with a token file",
+			env:        map[string]string{"DBT_CLOUD_PROFILE": "staging", "DBT_CLOUD_PROFILES_FILE": profilesFile},
+			attributes: credentials{HostURL: "https://cloud.example.com/api"},
+			expected:   credentials{AccountID: 456, Token: "token-from-file", HostURL: "https://cloud.example.com/api"},
+		},
+		{
+			name:       "profile values are overridden by the attributes",
+			attributes: credentials{AccountID: 1, Token: "token", Profile: "prod", ProfilesFile: profilesFile},
+			expected:   credentials{AccountID: 1, Token: "token", HostURL: "https://emea.dbt.com/api"},
+		},
+		{
+			name: "environment takes precedence over the profile from the environment",
+			env: map[string]string{
+				"DBT_CLOUD_PROFILE":       "prod",
+				"DBT_CLOUD_PROFILES_FILE": profilesFile,
+				"DBT_CLOUD_ACCOUNT_ID":    "2",
+				"DBT_CLOUD_HOST_URL":      "https://cloud.example.com/api",
+				"DBT_CLOUD_TOKEN":         "token-from-env",
+			},
+			expected: credentials{AccountID: 2, Token: "token-from-env", HostURL: "https://cloud.example.com/api"},
+		},
+		{
+			name: "profile token command is not run when the environment has a token",
+			env: map[string]string{
+				"DBT_CLOUD_PROFILE":       "vault",
+				"DBT_CLOUD_PROFILES_FILE": profilesFile,
+				"DBT_CLOUD_TOKEN":         "token-from-env",
+			},
+			expected: credentials{AccountID: 789, Token: "token-from-env", HostURL: DEFAULT_HOST_URL},
+		},
+		{
+			name:       "profile from the attributes is not mixed with the environment",
+			attributes: credentials{Profile: "prod", ProfilesFile: profilesFile},
+			env: map[string]string{
+				"DBT_CLOUD_ACCOUNT_ID": "2",
+				"DBT_CLOUD_HOST_URL":   "https://cloud.example.com/api",
+				"DBT_CLOUD_TOKEN":      "token-from-env",
+			},
+			expected: credentials{AccountID: 123, Token: "token-from-profile", HostURL: "https://emea.dbt.com/api"},
+		},
+		{
+			name:       "profile from the attributes ignores a token file from the environment",
+			attributes: credentials{Profile: "prod", ProfilesFile: profilesFile},
+			env:        map[string]string{"DBT_CLOUD_TOKEN_FILE": tokenFile},
+			expected:   credentials{AccountID: 123, Token: "token-from-profile", HostURL: "https://emea.dbt.com/api"},
+		},
+		{
+			name:       "profile from the attributes takes precedence over the profile from the environment",
+			attributes: credentials{Profile: "staging", ProfilesFile: profilesFile},
+			env:        map[string]string{"DBT_CLOUD_PROFILE": "prod", "DBT_CLOUD_TOKEN": "token-from-env"},
+			expected:   credentials{AccountID: 456, Token: "token-from-file", HostURL: DEFAULT_HOST_URL},
+		},
+		{
+			name:       "missing profile",
+			attributes: credentials{Profile: "dev", ProfilesFile: profilesFile},
+			err:        "available profiles: prod, staging",
+		},
+		{
+			name:       "missing token file",
+			attributes: credentials{TokenFile: filepath.Join(dir, "missing")},
+			err:        "unable to read the token file",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for key, value := range tc.env {
+				t.Setenv(key, value)
+			}
+
+			resolved, err := resolveCredentials(context.Background(), tc.attributes)
+			if tc.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.err) {
+					t.Fatalf("expected an error containing %q, got %v", tc.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resolved != tc.expected {
+				t.Errorf("expected %+v, got %+v", tc.expected, resolved)
+			}
+		})
+	}
+}
+
+func TestGetSharedCredentials(t *testing.T) {
+	for _, env := range []string{
+		"DBT_CLOUD_ACCOUNT_ID",
+		"DBT_CLOUD_HOST_URL",
+		"DBT_CLOUD_TOKEN",
+		"DBT_CLOUD_TOKEN_FILE",
+		"DBT_CLOUD_TOKEN_COMMAND",
+		"DBT_CLOUD_PROFILE",
+		"DBT_CLOUD_PROFILES_FILE",
+	} {
+		t.Setenv(env, "")
+	}
+
+	// the command appends a line to the file each time it runs
+	runsFile := filepath.Join(t.TempDir(), "runs")
+	attributes := credentials{AccountID: 1, TokenCommand: "echo run >> " + runsFile + "; echo token-from-command"}
+
+	// the SDKv2 and the Framework providers are both configured with the same attributes
+	for i := 0; i < 2; i++ {
+		resolved, err := getSharedCredentials(context.Background(), attributes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resolved.Token != "token-from-command" {
+			t.Errorf("expected the token from the command, got %q", resolved.Token)
+		}
+	}
+
+	runs, err := os.ReadFile(runsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count := strings.Count(string(runs), "run"); count != 1 {
+		t.Errorf("expected the token command to run once, got %d runs", count)
+	}
+}
@@ -4,33 +4,46 @@ import (
 	"context"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/account_features"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/azure_dev_ops_project"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/azure_dev_ops_repository"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/bigquery_credential"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/credential"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/databricks_credential"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/environment"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/fabric_credential"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/global_connection"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/group"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/group_partial_permissions"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/ip_restrictions_rule"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/job"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/job_run"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/license_map"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/lineage_integration"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/notification"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/oauth_configuration"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/partial_license_map"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/partial_notification"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/postgres_credential"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/project"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/project_artefacts"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/run_artifact"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/service_token"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/snowflake_credential"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/framework/objects/user"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -63,7 +76,7 @@ func (p *dbtCloudProvider) Schema(
 			"token": schema.StringAttribute{
 				Optional:    true,
 				Sensitive:   true,
-				Description: "API token for your dbt Cloud. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_TOKEN`",
+				Description: "API token for your dbt Cloud. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_TOKEN`, or use `token_file`, `token_command` or `profile`",
 			},
 			"account_id": schema.Int64Attribute{
 				Optional:    true,
@@ -71,16 +84,147 @@ func (p *dbtCloudProvider) Schema(
 			},
 			"host_url": schema.StringAttribute{
 				Optional:    true,
-				Description: "URL for your dbt Cloud deployment. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_HOST_URL` - Defaults to https://cloud.getdbt.com/api",
+				Description: "URL for your dbt Cloud deployment, e.g. `https://emea.dbt.com/api` or the access URL of multi-cell accounts like `https://ab123.us1.dbt.com/api`. The URL is normalised, so the scheme, `/api` and trailing slashes can be omitted. Only `https` is allowed, except for `http://localhost` as the token would otherwise be sent unencrypted. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_HOST_URL` - Defaults to https://cloud.getdbt.com/api",
+			},
+			"token_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file containing the API token, e.g. a mounted secret. Leading and trailing whitespaces are removed. Conflicts with `token` and `token_command`. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_TOKEN_FILE`",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("token"), path.MatchRoot("token_command")),
+				},
+			},
+			"token_command": schema.StringAttribute{
+				Optional:    true,
+				Description: "Command run in a shell to get the API token, e.g. `vault kv get -field=token secret/dbt_cloud`. The token is read from the trimmed standard output. Conflicts with `token` and `token_file`. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_TOKEN_COMMAND`",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("token"), path.MatchRoot("token_file")),
+				},
+			},
+			"profile": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the profile to read the `account_id`, `host_url` and token from, in the profiles file. When set in the provider, the profile is used as a whole: only the values set in the provider take precedence over the ones of the profile, and the environment variables of the account, host and token are ignored. When set via `DBT_CLOUD_PROFILE`, the values set in the provider, then via their own environment variables, take precedence over the ones of the profile. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_PROFILE`",
+			},
+			"profiles_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to the YAML file containing the profiles, under a `profiles` key and with the fields `account_id`, `host_url` and one of `token`, `token_file` or `token_command`. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_PROFILES_FILE` - Defaults to `~/.dbt/dbt_cloud.yml`",
+			},
+			"retry_max_attempts": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of attempts for an API call, when dbt Cloud returns a 429, a 5xx or when the connection fails. Requests creating objects are only retried on 429. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_RETRY_MAX_ATTEMPTS` - Defaults to 5",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_max_wait_seconds": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of seconds to wait between two attempts of an API call. The `Retry-After` header returned by dbt Cloud is honoured up to this value. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_RETRY_MAX_WAIT_SECONDS` - Defaults to 30",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"pagination_concurrency": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of pages fetched in parallel when listing objects from dbt Cloud. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_PAGINATION_CONCURRENCY` - Defaults to 4",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Maximum number of requests per second sent to the dbt Cloud API, shared by all the resources of the provider for the same account and host. This helps staying under the API limits when running with a high `-parallelism`. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_MAX_REQUESTS_PER_SECOND` - Defaults to 0, no limit",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"enable_read_cache": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Cache the responses of the dbt Cloud list endpoints of projects, environments, users, connections and IP restrictions for the duration of the Terraform command. The cache is invalidated when related objects are created, updated or deleted by the provider, and job runs are never cached. This reduces the number of API calls for large refreshes. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_ENABLE_READ_CACHE` - Defaults to `false`",
+			},
+			"http_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Timeout in seconds of each HTTP request sent to dbt Cloud. Increasing it can help for large list calls. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_HTTP_TIMEOUT` - Defaults to 30",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of the proxy used to connect to dbt Cloud, e.g. `http://proxy.example.com:3128`. When not set, the proxy is read from the `HTTPS_PROXY`/`HTTP_PROXY` environment variables. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_PROXY_URL`",
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM encoded CA certificate(s) trusted in addition to the system ones, for dbt Cloud instances or proxies using a private CA. Conflicts with `ca_cert_file`. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_CA_CERT_PEM`",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file containing PEM encoded CA certificate(s) trusted in addition to the system ones. Conflicts with `ca_cert_pem`. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_CA_CERT_FILE`",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem")),
+				},
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM encoded client certificate used for mTLS, requires a client key. Conflicts with `client_cert_file`. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_CLIENT_CERT_PEM`",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_cert_file")),
+				},
+			},
+			"client_cert_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file containing the PEM encoded client certificate used for mTLS, requires a client key. Conflicts with `client_cert_pem`. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_CLIENT_CERT_FILE`",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_cert_pem")),
+				},
+			},
+			"client_key_pem": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM encoded private key of the client certificate used for mTLS. Conflicts with `client_key_file`. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_CLIENT_KEY_PEM`",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_key_file")),
+				},
+			},
+			"client_key_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a file containing the PEM encoded private key of the client certificate used for mTLS. Conflicts with `client_key_pem`. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_CLIENT_KEY_FILE`",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_key_pem")),
+				},
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip the validation of the token and account when configuring the provider. The validation then happens on the first API call instead, which allows commands like `terraform validate` to run without network access and supports tokens that are not allowed to list the accounts. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_SKIP_CREDENTIALS_VALIDATION` - Defaults to `false`",
 			},
 		},
 	}
 }
 
 type dbtCloudProviderModel struct {
-	Token     types.String `tfsdk:"token"`
-	AccountID types.Int64  `tfsdk:"account_id"`
-	HostURL   types.String `tfsdk:"host_url"`
+	Token                 types.String  `tfsdk:"token"`
+	AccountID             types.Int64   `tfsdk:"account_id"`
+	HostURL               types.String  `tfsdk:"host_url"`
+	TokenFile             types.String  `tfsdk:"token_file"`
+	TokenCommand          types.String  `tfsdk:"token_command"`
+	Profile               types.String  `tfsdk:"profile"`
+	ProfilesFile          types.String  `tfsdk:"profiles_file"`
+	RetryMaxAttempts      types.Int64   `tfsdk:"retry_max_attempts"`
+	RetryMaxWaitSeconds   types.Int64   `tfsdk:"retry_max_wait_seconds"`
+	PaginationConcurrency types.Int64   `tfsdk:"pagination_concurrency"`
+	MaxRequestsPerSecond  types.Float64 `tfsdk:"max_requests_per_second"`
+	EnableReadCache       types.Bool    `tfsdk:"enable_read_cache"`
+	HTTPTimeout           types.Int64   `tfsdk:"http_timeout"`
+	ProxyURL              types.String  `tfsdk:"proxy_url"`
+	CACertPEM             types.String  `tfsdk:"ca_cert_pem"`
+	CACertFile            types.String  `tfsdk:"ca_cert_file"`
+	ClientCertPEM         types.String  `tfsdk:"client_cert_pem"`
+	ClientCertFile        types.String  `tfsdk:"client_cert_file"`
+	ClientKeyPEM          types.String  `tfsdk:"client_key_pem"`
+	ClientKeyFile         types.String  `tfsdk:"client_key_file"`
+
+	SkipCredentialsValidation types.Bool `tfsdk:"skip_credentials_validation"`
 }
 
 func (p *dbtCloudProvider) Configure(
@@ -125,21 +269,95 @@ func (p *dbtCloudProvider) Configure(
 		return
 	}
 
-	accountIDString := os.Getenv("DBT_CLOUD_ACCOUNT_ID")
-	accountID, _ := strconv.Atoi(accountIDString)
-	token := os.Getenv("DBT_CLOUD_TOKEN")
-	hostURL := os.Getenv("DBT_CLOUD_HOST_URL")
+	retryMaxAttempts, _ := strconv.Atoi(os.Getenv("DBT_CLOUD_RETRY_MAX_ATTEMPTS"))
+	retryMaxWaitSeconds, _ := strconv.Atoi(os.Getenv("DBT_CLOUD_RETRY_MAX_WAIT_SECONDS"))
+	paginationConcurrency, _ := strconv.Atoi(os.Getenv("DBT_CLOUD_PAGINATION_CONCURRENCY"))
+	maxRequestsPerSecond, _ := strconv.ParseFloat(os.Getenv("DBT_CLOUD_MAX_REQUESTS_PER_SECOND"), 64)
+	enableReadCache, _ := strconv.ParseBool(os.Getenv("DBT_CLOUD_ENABLE_READ_CACHE"))
+	httpTimeout, _ := strconv.Atoi(os.Getenv("DBT_CLOUD_HTTP_TIMEOUT"))
+	proxyURL := os.Getenv("DBT_CLOUD_PROXY_URL")
+	caCertPEM := os.Getenv("DBT_CLOUD_CA_CERT_PEM")
+	caCertFile := os.Getenv("DBT_CLOUD_CA_CERT_FILE")
+	clientCertPEM := os.Getenv("DBT_CLOUD_CLIENT_CERT_PEM")
+	clientCertFile := os.Getenv("DBT_CLOUD_CLIENT_CERT_FILE")
+	clientKeyPEM := os.Getenv("DBT_CLOUD_CLIENT_KEY_PEM")
+	clientKeyFile := os.Getenv("DBT_CLOUD_CLIENT_KEY_FILE")
+	skipCredentialsValidation, _ := strconv.ParseBool(os.Getenv("DBT_CLOUD_SKIP_CREDENTIALS_VALIDATION"))
+
+	creds, err := getSharedCredentials(ctx, credentials{
+		AccountID:    int(config.AccountID.ValueInt64()),
+		HostURL:      config.HostURL.ValueString(),
+		Token:        config.Token.ValueString(),
+		TokenFile:    config.TokenFile.ValueString(),
+		TokenCommand: config.TokenCommand.ValueString(),
+		Profile:      config.Profile.ValueString(),
+		ProfilesFile: config.ProfilesFile.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to get the dbt Cloud credentials",
+			err.Error(),
+		)
+		return
+	}
+	accountID := creds.AccountID
+	token := creds.Token
+	hostURL := creds.HostURL
+
+	if !config.RetryMaxAttempts.IsNull() {
+		retryMaxAttempts = int(config.RetryMaxAttempts.ValueInt64())
+	}
+
+	if !config.RetryMaxWaitSeconds.IsNull() {
+		retryMaxWaitSeconds = int(config.RetryMaxWaitSeconds.ValueInt64())
+	}
+
+	if !config.PaginationConcurrency.IsNull() {
+		paginationConcurrency = int(config.PaginationConcurrency.ValueInt64())
+	}
+
+	if !config.MaxRequestsPerSecond.IsNull() {
+		maxRequestsPerSecond = config.MaxRequestsPerSecond.ValueFloat64()
+	}
+
+	if !config.EnableReadCache.IsNull() {
+		enableReadCache = config.EnableReadCache.ValueBool()
+	}
+
+	if !config.HTTPTimeout.IsNull() {
+		httpTimeout = int(config.HTTPTimeout.ValueInt64())
+	}
+
+	if !config.ProxyURL.IsNull() {
+		proxyURL = config.ProxyURL.ValueString()
+	}
+
+	if !config.CACertPEM.IsNull() {
+		caCertPEM = config.CACertPEM.ValueString()
+	}
+
+	if !config.CACertFile.IsNull() {
+		caCertFile = config.CACertFile.ValueString()
+	}
+
+	if !config.ClientCertPEM.IsNull() {
+		clientCertPEM = config.ClientCertPEM.ValueString()
+	}
 
-	if !config.AccountID.IsNull() {
-		accountID = int(config.AccountID.ValueInt64())
+	if !config.ClientCertFile.IsNull() {
+		clientCertFile = config.ClientCertFile.ValueString()
 	}
 
-	if !config.Token.IsNull() {
-		token = config.Token.ValueString()
+	if !config.ClientKeyPEM.IsNull() {
+		clientKeyPEM = config.ClientKeyPEM.ValueString()
 	}
 
-	if !config.HostURL.IsNull() {
-		hostURL = config.HostURL.ValueString()
+	if !config.ClientKeyFile.IsNull() {
+		clientKeyFile = config.ClientKeyFile.ValueString()
+	}
+
+	if !config.SkipCredentialsValidation.IsNull() {
+		skipCredentialsValidation = config.SkipCredentialsValidation.ValueBool()
 	}
 
 	if accountID == 0 {
@@ -158,15 +376,31 @@ func (p *dbtCloudProvider) Configure(
 		)
 	}
 
-	if hostURL == "" {
-		hostURL = "https://cloud.getdbt.com/api"
-	}
-
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	client, err := dbt_cloud.NewClient(&accountID, &token, &hostURL)
+	clientConfig := dbt_cloud.ClientConfig{
+		RetryMaxAttempts: retryMaxAttempts,
+		RetryMaxWait:     time.Duration(retryMaxWaitSeconds) * time.Second,
+		PageConcurrency:  paginationConcurrency,
+
+		MaxRequestsPerSecond: maxRequestsPerSecond,
+		EnableReadCache:      enableReadCache,
+
+		HTTPTimeout:    time.Duration(httpTimeout) * time.Second,
+		ProxyURL:       proxyURL,
+		CACertPEM:      caCertPEM,
+		CACertFile:     caCertFile,
+		ClientCertPEM:  clientCertPEM,
+		ClientCertFile: clientCertFile,
+		ClientKeyPEM:   clientKeyPEM,
+		ClientKeyFile:  clientKeyFile,
+
+		SkipCredentialsValidation: skipCredentialsValidation,
+	}
+
+	client, err := dbt_cloud.NewClient(ctx, &accountID, &token, &hostURL, &clientConfig)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create dbt Cloud API Client",
@@ -192,9 +426,11 @@ func (p *dbtCloudProvider) DataSources(_ context.Context) []func() datasource.Da
 		global_connection.GlobalConnectionDataSource,
 		global_connection.GlobalConnectionsDataSource,
 		group.GroupDataSource,
+		job.JobRunsDataSource,
 		job.JobsDataSource,
 		notification.NotificationDataSource,
 		project.ProjectsDataSource,
+		run_artifact.RunArtifactDataSource,
 		service_token.ServiceTokenDataSource,
 		user.UserDataSource,
 		user.UsersDataSource,
@@ -204,9 +440,16 @@ func (p *dbtCloudProvider) DataSources(_ context.Context) []func() datasource.Da
 func (p *dbtCloudProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		account_features.AccountFeaturesResource,
+		bigquery_credential.BigQueryCredentialResource,
+		credential.CredentialResource,
+		databricks_credential.DatabricksCredentialResource,
+		environment.EnvironmentResource,
+		fabric_credential.FabricCredentialResource,
 		global_connection.GlobalConnectionResource,
 		group_partial_permissions.GroupPartialPermissionsResource,
 		group.GroupResource,
+		job.JobResource,
+		job_run.JobRunResource,
 		ip_restrictions_rule.IPRestrictionsRuleResource,
 		license_map.LicenseMapResource,
 		lineage_integration.LineageIntegrationResource,
@@ -214,7 +457,10 @@ func (p *dbtCloudProvider) Resources(_ context.Context) []func() resource.Resour
 		oauth_configuration.OAuthConfigurationResource,
 		partial_license_map.PartialLicenseMapResource,
 		partial_notification.PartialNotificationResource,
+		postgres_credential.PostgresCredentialResource,
+		project.ProjectResource,
 		project_artefacts.ProjectArtefactsResource,
 		service_token.ServiceTokenResource,
+		snowflake_credential.SnowflakeCredentialResource,
 	}
 }
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud_fake"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSDKProviderResourcesTimeouts(t *testing.T) {
+	t.Parallel()
+
+	p := SDKProvider("test")()
+	if err := p.InternalValidate(); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, r := range p.ResourcesMap {
+		if r.Timeouts == nil {
+			t.Errorf("the resource %s doesn't define timeouts", name)
+		}
+	}
+}
+
+func TestFrameworkProviderResourcesTimeouts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	for _, newResource := range New().Resources(ctx) {
+		r := newResource()
+
+		metadataResp := &resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "dbtcloud"}, metadataResp)
+
+		schemaResp := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+		if schemaResp.Diagnostics.HasError() {
+			t.Errorf("error getting the schema of %s: %v", metadataResp.TypeName, schemaResp.Diagnostics)
+			continue
+		}
+		if _, ok := schemaResp.Schema.Blocks["timeouts"]; !ok {
+			t.Errorf("the resource %s doesn't have a timeouts block", metadataResp.TypeName)
+		}
+	}
+}
+
+// providerConfigValue returns the value of the provider block, with the attributes not given set to null
+func providerConfigValue(objectType tftypes.Object, attributes map[string]any) tftypes.Value {
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, attributes[name])
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+// configureSDKProvider configures the SDKv2 half of the provider like Terraform does, via the protocol
+func configureSDKProvider(t *testing.T, attributes map[string]any) (*dbt_cloud.Client, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	p := SDKProvider("test")()
+	server := schema.NewGRPCProviderServer(p)
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	objectType := schemaResp.Provider.ValueType().(tftypes.Object)
+	config, err := tfprotov5.NewDynamicValue(objectType, providerConfigValue(objectType, attributes))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{Config: &config})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, diagnostic := range resp.Diagnostics {
+		if diagnostic.Severity == tfprotov5.DiagnosticSeverityError {
+			return nil, fmt.Errorf("%s: %s", diagnostic.Summary, diagnostic.Detail)
+		}
+	}
+	return p.Meta().(*dbt_cloud.Client), nil
+}
+
+// configureFrameworkProvider configures the Framework half of the provider with the same attributes
+func configureFrameworkProvider(t *testing.T, attributes map[string]any) (*dbt_cloud.Client, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	p := New()
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	req := provider.ConfigureRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: providerConfigValue(objectType, attributes)},
+	}
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		errDiag := resp.Diagnostics.Errors()[0]
+		return nil, fmt.Errorf("%s: %s", errDiag.Summary(), errDiag.Detail())
+	}
+	return resp.ResourceData.(*dbt_cloud.Client), nil
+}
+
+// TestProviderBoolSettings checks that both halves of the provider read the boolean settings the same way
+// an explicit false in the provider block needs to take precedence over the environment variable
+func TestProviderBoolSettings(t *testing.T) {
+	for _, env := range []string{
+		"DBT_CLOUD_ACCOUNT_ID",
+		"DBT_CLOUD_HOST_URL",
+		"DBT_CLOUD_TOKEN",
+		"DBT_CLOUD_TOKEN_FILE",
+		"DBT_CLOUD_TOKEN_COMMAND",
+		"DBT_CLOUD_PROFILE",
+		"DBT_CLOUD_PROFILES_FILE",
+		"DBT_CLOUD_ENABLE_READ_CACHE",
+		"DBT_CLOUD_SKIP_CREDENTIALS_VALIDATION",
+	} {
+		t.Setenv(env, "")
+	}
+	// the credentials are never validated when TF_ACC is set, even to an empty value
+	t.Setenv("TF_ACC", "")
+	os.Unsetenv("TF_ACC")
+
+	fakeAPI := dbt_cloud_fake.NewServer(1, "token")
+	defer fakeAPI.Close()
+
+	testCases := []struct {
+		name       string
+		env        map[string]string
+		attributes map[string]any
+		readCache  bool
+		// the token is wrong, so the configuration fails when the credentials are validated
+		wrongToken            bool
+		credentialsValidation bool
+	}{
+		{
+			name:      "read cache not set",
+			readCache: false,
+		},
+		{
+			name:      "read cache from the environment",
+			env:       map[string]string{"DBT_CLOUD_ENABLE_READ_CACHE": "true"},
+			readCache: true,
+		},
+		{
+			name:       "read cache config true",
+			attributes: map[string]any{"enable_read_cache": true},
+			readCache:  true,
+		},
+		{
+			name:       "read cache config false, env true",
+			env:        map[string]string{"DBT_CLOUD_ENABLE_READ_CACHE": "true"},
+			attributes: map[string]any{"enable_read_cache": false},
+			readCache:  false,
+		},
+		{
+			name:                  "credentials validated by default",
+			wrongToken:            true,
+			credentialsValidation: true,
+		},
+		{
+			name:                  "credentials validation skipped from the environment",
+			env:                   map[string]string{"DBT_CLOUD_SKIP_CREDENTIALS_VALIDATION": "true"},
+			wrongToken:            true,
+			credentialsValidation: false,
+		},
+		{
+			name:                  "credentials validation config false, env true",
+			env:                   map[string]string{"DBT_CLOUD_SKIP_CREDENTIALS_VALIDATION": "true"},
+			attributes:            map[string]any{"skip_credentials_validation": false},
+			wrongToken:            true,
+			credentialsValidation: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for key, value := range tc.env {
+				t.Setenv(key, value)
+			}
+
+			attributes := map[string]any{
+				"account_id": 1,
+				"token":      "token",
+				"host_url":   fakeAPI.HostURL,
+			}
+			if tc.wrongToken {
+				attributes["token"] = "wrong-token"
+			}
+			for key, value := range tc.attributes {
+				attributes[key] = value
+			}
+
+			configurers := map[string]func(*testing.T, map[string]any) (*dbt_cloud.Client, error){
+				"SDKv2":     configureSDKProvider,
+				"Framework": configureFrameworkProvider,
+			}
+			for half, configure := range configurers {
+				client, err := configure(t, attributes)
+				if tc.credentialsValidation {
+					if err == nil || !strings.Contains(err.Error(), "the token is not valid") {
+						t.Errorf("%s: expected the credentials to be validated, got %v", half, err)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("%s: unexpected error configuring the provider: %v", half, err)
+				}
+				if readCache := client.ReadCache != nil; readCache != tc.readCache {
+					t.Errorf("%s: expected the read cache to be %t, got %t", half, tc.readCache, readCache)
+				}
+			}
+		})
+	}
+}
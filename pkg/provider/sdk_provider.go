@@ -4,9 +4,11 @@ import (
 	"context"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/sdkv2/data_sources"
@@ -22,7 +24,7 @@ func SDKProvider(version string) func() *schema.Provider {
 					Type:        schema.TypeString,
 					Optional:    true,
 					Sensitive:   true,
-					Description: "API token for your dbt Cloud. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_TOKEN`",
+					Description: "API token for your dbt Cloud. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_TOKEN`, or use `token_file`, `token_command` or `profile`",
 				},
 				"account_id": {
 					Type:        schema.TypeInt,
@@ -32,7 +34,111 @@ func SDKProvider(version string) func() *schema.Provider {
 				"host_url": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Description: "URL for your dbt Cloud deployment. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_HOST_URL` - Defaults to https://cloud.getdbt.com/api",
+					Description: "URL for your dbt Cloud deployment, e.g. `https://emea.dbt.com/api` or the access URL of multi-cell accounts like `https://ab123.us1.dbt.com/api`. The URL is normalised, so the scheme, `/api` and trailing slashes can be omitted. Only `https` is allowed, except for `http://localhost` as the token would otherwise be sent unencrypted. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_HOST_URL` - Defaults to https://cloud.getdbt.com/api",
+				},
+				"token_file": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ConflictsWith: []string{"token", "token_command"},
+					Description:   "Path to a file containing the API token, e.g. a mounted secret. Leading and trailing whitespaces are removed. Conflicts with `token` and `token_command`. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_TOKEN_FILE`",
+				},
+				"token_command": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ConflictsWith: []string{"token", "token_file"},
+					Description:   "Command run in a shell to get the API token, e.g. `vault kv get -field=token secret/dbt_cloud`. The token is read from the trimmed standard output. Conflicts with `token` and `token_file`. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_TOKEN_COMMAND`",
+				},
+				"profile": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Name of the profile to read the `account_id`, `host_url` and token from, in the profiles file. When set in the provider, the profile is used as a whole: only the values set in the provider take precedence over the ones of the profile, and the environment variables of the account, host and token are ignored. When set via `DBT_CLOUD_PROFILE`, the values set in the provider, then via their own environment variables, take precedence over the ones of the profile. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_PROFILE`",
+				},
+				"profiles_file": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Path to the YAML file containing the profiles, under a `profiles` key and with the fields `account_id`, `host_url` and one of `token`, `token_file` or `token_command`. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_PROFILES_FILE` - Defaults to `~/.dbt/dbt_cloud.yml`",
+				},
+				"retry_max_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "Maximum number of attempts for an API call, when dbt Cloud returns a 429, a 5xx or when the connection fails. Requests creating objects are only retried on 429. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_RETRY_MAX_ATTEMPTS` - Defaults to 5",
+				},
+				"retry_max_wait_seconds": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "Maximum number of seconds to wait between two attempts of an API call. The `Retry-After` header returned by dbt Cloud is honoured up to this value. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_RETRY_MAX_WAIT_SECONDS` - Defaults to 30",
+				},
+				"pagination_concurrency": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "Maximum number of pages fetched in parallel when listing objects from dbt Cloud. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_PAGINATION_CONCURRENCY` - Defaults to 4",
+				},
+				"max_requests_per_second": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					ValidateFunc: validation.FloatAtLeast(0),
+					Description:  "Maximum number of requests per second sent to the dbt Cloud API, shared by all the resources of the provider for the same account and host. This helps staying under the API limits when running with a high `-parallelism`. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_MAX_REQUESTS_PER_SECOND` - Defaults to 0, no limit",
+				},
+				"enable_read_cache": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Cache the responses of the dbt Cloud list endpoints of projects, environments, users, connections and IP restrictions for the duration of the Terraform command. The cache is invalidated when related objects are created, updated or deleted by the provider, and job runs are never cached. This reduces the number of API calls for large refreshes. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_ENABLE_READ_CACHE` - Defaults to `false`",
+				},
+				"http_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "Timeout in seconds of each HTTP request sent to dbt Cloud. Increasing it can help for large list calls. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_HTTP_TIMEOUT` - Defaults to 30",
+				},
+				"proxy_url": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "URL of the proxy used to connect to dbt Cloud, e.g. `http://proxy.example.com:3128`. When not set, the proxy is read from the `HTTPS_PROXY`/`HTTP_PROXY` environment variables. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_PROXY_URL`",
+				},
+				"ca_cert_pem": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ConflictsWith: []string{"ca_cert_file"},
+					Description:   "PEM encoded CA certificate(s) trusted in addition to the system ones, for dbt Cloud instances or proxies using a private CA. Conflicts with `ca_cert_file`. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_CA_CERT_PEM`",
+				},
+				"ca_cert_file": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ConflictsWith: []string{"ca_cert_pem"},
+					Description:   "Path to a file containing PEM encoded CA certificate(s) trusted in addition to the system ones. Conflicts with `ca_cert_pem`. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_CA_CERT_FILE`",
+				},
+				"client_cert_pem": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ConflictsWith: []string{"client_cert_file"},
+					Description:   "PEM encoded client certificate used for mTLS, requires a client key. Conflicts with `client_cert_file`. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_CLIENT_CERT_PEM`",
+				},
+				"client_cert_file": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ConflictsWith: []string{"client_cert_pem"},
+					Description:   "Path to a file containing the PEM encoded client certificate used for mTLS, requires a client key. Conflicts with `client_cert_pem`. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_CLIENT_CERT_FILE`",
+				},
+				"client_key_pem": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					ConflictsWith: []string{"client_key_file"},
+					Description:   "PEM encoded private key of the client certificate used for mTLS. Conflicts with `client_key_file`. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_CLIENT_KEY_PEM`",
+				},
+				"client_key_file": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					ConflictsWith: []string{"client_key_pem"},
+					Description:   "Path to a file containing the PEM encoded private key of the client certificate used for mTLS. Conflicts with `client_key_pem`. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_CLIENT_KEY_FILE`",
+				},
+				"skip_credentials_validation": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Skip the validation of the token and account when configuring the provider. The validation then happens on the first API call instead, which allows commands like `terraform validate` to run without network access and supports tokens that are not allowed to list the accounts. Instead of setting the parameter, you can set the environment variable `DBT_CLOUD_SKIP_CREDENTIALS_VALIDATION` - Defaults to `false`",
 				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
@@ -53,16 +159,9 @@ func SDKProvider(version string) func() *schema.Provider {
 				"dbtcloud_group_users":           data_sources.DatasourceGroupUsers(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"dbtcloud_job":                               resources.ResourceJob(),
-				"dbtcloud_project":                           resources.ResourceProject(),
 				"dbtcloud_project_connection":                resources.ResourceProjectConnection(),
 				"dbtcloud_project_repository":                resources.ResourceProjectRepository(),
-				"dbtcloud_environment":                       resources.ResourceEnvironment(),
 				"dbtcloud_environment_variable":              resources.ResourceEnvironmentVariable(),
-				"dbtcloud_databricks_credential":             resources.ResourceDatabricksCredential(),
-				"dbtcloud_snowflake_credential":              resources.ResourceSnowflakeCredential(),
-				"dbtcloud_bigquery_credential":               resources.ResourceBigQueryCredential(),
-				"dbtcloud_postgres_credential":               resources.ResourcePostgresCredential(),
 				"dbtcloud_connection":                        resources.ResourceConnection(),
 				"dbtcloud_bigquery_connection":               resources.ResourceBigQueryConnection(),
 				"dbtcloud_repository":                        resources.ResourceRepository(),
@@ -71,7 +170,6 @@ func SDKProvider(version string) func() *schema.Provider {
 				"dbtcloud_extended_attributes":               resources.ResourceExtendedAttributes(),
 				"dbtcloud_environment_variable_job_override": resources.ResourceEnvironmentVariableJobOverride(),
 				"dbtcloud_fabric_connection":                 resources.ResourceFabricConnection(),
-				"dbtcloud_fabric_credential":                 resources.ResourceFabricCredential(),
 			},
 			ConfigureContextFunc: providerConfigure,
 		}
@@ -84,30 +182,114 @@ func providerConfigure(
 	d *schema.ResourceData,
 ) (interface{}, diag.Diagnostics) {
 
-	account_id := d.Get("account_id").(int)
-	token := d.Get("token").(string)
-	host_url := d.Get("host_url").(string)
+	var diags diag.Diagnostics
+
+	creds, err := getSharedCredentials(ctx, credentials{
+		AccountID:    d.Get("account_id").(int),
+		HostURL:      d.Get("host_url").(string),
+		Token:        d.Get("token").(string),
+		TokenFile:    d.Get("token_file").(string),
+		TokenCommand: d.Get("token_command").(string),
+		Profile:      d.Get("profile").(string),
+		ProfilesFile: d.Get("profiles_file").(string),
+	})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to get the dbt Cloud credentials",
+			Detail:   err.Error(),
+		})
+		return nil, diags
+	}
+	account_id := creds.AccountID
+	token := creds.Token
+	host_url := creds.HostURL
+
+	retry_max_attempts := d.Get("retry_max_attempts").(int)
+	retry_max_wait_seconds := d.Get("retry_max_wait_seconds").(int)
+	pagination_concurrency := d.Get("pagination_concurrency").(int)
+	max_requests_per_second := d.Get("max_requests_per_second").(float64)
+	enable_read_cache := configBool(d, "enable_read_cache", "DBT_CLOUD_ENABLE_READ_CACHE")
+	http_timeout := d.Get("http_timeout").(int)
+	proxy_url := d.Get("proxy_url").(string)
+	ca_cert_pem := d.Get("ca_cert_pem").(string)
+	ca_cert_file := d.Get("ca_cert_file").(string)
+	client_cert_pem := d.Get("client_cert_pem").(string)
+	client_cert_file := d.Get("client_cert_file").(string)
+	client_key_pem := d.Get("client_key_pem").(string)
+	client_key_file := d.Get("client_key_file").(string)
+	skip_credentials_validation := configBool(d, "skip_credentials_validation", "DBT_CLOUD_SKIP_CREDENTIALS_VALIDATION")
 
-	if account_id == 0 {
-		accountIDString := os.Getenv("DBT_CLOUD_ACCOUNT_ID")
-		account_id, _ = strconv.Atoi(accountIDString)
+	if retry_max_attempts == 0 {
+		retry_max_attempts, _ = strconv.Atoi(os.Getenv("DBT_CLOUD_RETRY_MAX_ATTEMPTS"))
 	}
 
-	if token == "" {
-		token = os.Getenv("DBT_CLOUD_TOKEN")
+	if retry_max_wait_seconds == 0 {
+		retry_max_wait_seconds, _ = strconv.Atoi(os.Getenv("DBT_CLOUD_RETRY_MAX_WAIT_SECONDS"))
 	}
 
-	if host_url == "" {
-		host_url = os.Getenv("DBT_CLOUD_HOST_URL")
-		if host_url == "" {
-			host_url = "https://cloud.getdbt.com/api"
-		}
+	if pagination_concurrency == 0 {
+		pagination_concurrency, _ = strconv.Atoi(os.Getenv("DBT_CLOUD_PAGINATION_CONCURRENCY"))
 	}
 
-	var diags diag.Diagnostics
+	if max_requests_per_second == 0 {
+		max_requests_per_second, _ = strconv.ParseFloat(os.Getenv("DBT_CLOUD_MAX_REQUESTS_PER_SECOND"), 64)
+	}
+
+	if http_timeout == 0 {
+		http_timeout, _ = strconv.Atoi(os.Getenv("DBT_CLOUD_HTTP_TIMEOUT"))
+	}
+
+	if proxy_url == "" {
+		proxy_url = os.Getenv("DBT_CLOUD_PROXY_URL")
+	}
+
+	if ca_cert_pem == "" {
+		ca_cert_pem = os.Getenv("DBT_CLOUD_CA_CERT_PEM")
+	}
+
+	if ca_cert_file == "" {
+		ca_cert_file = os.Getenv("DBT_CLOUD_CA_CERT_FILE")
+	}
+
+	if client_cert_pem == "" {
+		client_cert_pem = os.Getenv("DBT_CLOUD_CLIENT_CERT_PEM")
+	}
+
+	if client_cert_file == "" {
+		client_cert_file = os.Getenv("DBT_CLOUD_CLIENT_CERT_FILE")
+	}
+
+	if client_key_pem == "" {
+		client_key_pem = os.Getenv("DBT_CLOUD_CLIENT_KEY_PEM")
+	}
+
+	if client_key_file == "" {
+		client_key_file = os.Getenv("DBT_CLOUD_CLIENT_KEY_FILE")
+	}
+
+	clientConfig := dbt_cloud.ClientConfig{
+		RetryMaxAttempts: retry_max_attempts,
+		RetryMaxWait:     time.Duration(retry_max_wait_seconds) * time.Second,
+		PageConcurrency:  pagination_concurrency,
+
+		MaxRequestsPerSecond: max_requests_per_second,
+		EnableReadCache:      enable_read_cache,
+
+		HTTPTimeout:    time.Duration(http_timeout) * time.Second,
+		ProxyURL:       proxy_url,
+		CACertPEM:      ca_cert_pem,
+		CACertFile:     ca_cert_file,
+		ClientCertPEM:  client_cert_pem,
+		ClientCertFile: client_cert_file,
+		ClientKeyPEM:   client_key_pem,
+		ClientKeyFile:  client_key_file,
+
+		SkipCredentialsValidation: skip_credentials_validation,
+	}
 
 	if (token != "") && (account_id != 0) {
-		c, err := dbt_cloud.NewClient(&account_id, &token, &host_url)
+		c, err := dbt_cloud.NewClient(ctx, &account_id, &token, &host_url, &clientConfig)
 
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
@@ -121,7 +303,7 @@ func providerConfigure(
 		return c, diags
 	}
 
-	c, err := dbt_cloud.NewClient(nil, nil, nil)
+	c, err := dbt_cloud.NewClient(ctx, nil, nil, nil, nil)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
 			Severity: diag.Error,
@@ -133,3 +315,15 @@ func providerConfigure(
 
 	return c, diags
 }
+
+// configBool returns the value of a boolean attribute when it is set in the provider block, or else of its environment variable
+// GetOk can't be used for this as it reports an explicit false the same way as an attribute not set
+func configBool(d *schema.ResourceData, key string, envVar string) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsKnown() && !rawConfig.IsNull() && !rawConfig.GetAttr(key).IsNull() {
+		return d.Get(key).(bool)
+	}
+
+	value, _ := strconv.ParseBool(os.Getenv(envVar))
+	return value
+}
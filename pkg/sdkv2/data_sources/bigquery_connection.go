@@ -149,7 +149,7 @@ func datasourceBigQueryConnectionRead(ctx context.Context, d *schema.ResourceDat
 	connectionID := d.Get("connection_id").(int)
 	projectID := d.Get("project_id").(int)
 
-	connection, err := c.GetBigQueryConnection(strconv.Itoa(connectionID), strconv.Itoa(projectID))
+	connection, err := c.GetBigQueryConnection(ctx, strconv.Itoa(connectionID), strconv.Itoa(projectID))
 	if err != nil {
 		return diag.FromErr(err)
 	}
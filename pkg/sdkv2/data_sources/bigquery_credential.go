@@ -52,7 +52,7 @@ func bigqueryCredentialRead(ctx context.Context, d *schema.ResourceData, m inter
 	credentialID := d.Get("credential_id").(int)
 	projectID := d.Get("project_id").(int)
 
-	bigqueryCredential, err := c.GetBigQueryCredential(projectID, credentialID)
+	bigqueryCredential, err := c.GetBigQueryCredential(ctx, projectID, credentialID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
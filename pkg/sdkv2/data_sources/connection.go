@@ -88,7 +88,7 @@ func datasourceConnectionRead(ctx context.Context, d *schema.ResourceData, m int
 	connectionID := d.Get("connection_id").(int)
 	projectID := d.Get("project_id").(int)
 
-	connection, err := c.GetConnection(strconv.Itoa(connectionID), strconv.Itoa(projectID))
+	connection, err := c.GetConnection(ctx, strconv.Itoa(connectionID), strconv.Itoa(projectID))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -62,7 +62,7 @@ func databricksCredentialRead(ctx context.Context, d *schema.ResourceData, m int
 	credentialID := d.Get("credential_id").(int)
 	projectID := d.Get("project_id").(int)
 
-	databricksCredential, err := c.GetDatabricksCredential(projectID, credentialID)
+	databricksCredential, err := c.GetDatabricksCredential(ctx, projectID, credentialID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
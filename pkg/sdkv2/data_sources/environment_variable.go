@@ -42,7 +42,7 @@ func datasourceEnvironmentVariableRead(ctx context.Context, d *schema.ResourceDa
 	projectID := d.Get("project_id").(int)
 	name := d.Get("name").(string)
 
-	environmentVariable, err := c.GetEnvironmentVariable(projectID, name)
+	environmentVariable, err := c.GetEnvironmentVariable(ctx, projectID, name)
 	if err != nil {
 		return diag.FromErr(err)
 	}
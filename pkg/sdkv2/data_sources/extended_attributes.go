@@ -47,7 +47,7 @@ func datasourceExtendedAttributesRead(ctx context.Context, d *schema.ResourceDat
 	extendedAttributesID := d.Get("extended_attributes_id").(int)
 	projectID := d.Get("project_id").(int)
 
-	extendedAttributes, err := c.GetExtendedAttributes(projectID, extendedAttributesID)
+	extendedAttributes, err := c.GetExtendedAttributes(ctx, projectID, extendedAttributesID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -53,7 +53,7 @@ func datasourceGroupUsersRead(
 
 	groupID := d.Get("group_id").(int)
 
-	users, err := c.GetUsers()
+	users, err := c.GetUsers(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
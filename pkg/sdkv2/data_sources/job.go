@@ -123,7 +123,7 @@ func datasourceJobRead(
 
 	jobId := strconv.Itoa(d.Get("job_id").(int))
 
-	job, err := c.GetJob(jobId)
+	job, err := c.GetJob(ctx, jobId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
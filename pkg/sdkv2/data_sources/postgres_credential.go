@@ -57,7 +57,7 @@ func postgresCredentialRead(ctx context.Context, d *schema.ResourceData, m inter
 	credentialID := d.Get("credential_id").(int)
 	projectID := d.Get("project_id").(int)
 
-	postgresCredential, err := c.GetPostgresCredential(projectID, credentialID)
+	postgresCredential, err := c.GetPostgresCredential(ctx, projectID, credentialID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
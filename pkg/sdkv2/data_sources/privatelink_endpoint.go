@@ -59,7 +59,7 @@ func datasourcePrivatelinkEndpointRead(ctx context.Context, d *schema.ResourceDa
 	endpointName := d.Get("name").(string)
 	privatelinkEndpointURL := d.Get("private_link_endpoint_url").(string)
 
-	privatelinkEndpoint, err := c.GetPrivatelinkEndpoint(endpointName, privatelinkEndpointURL)
+	privatelinkEndpoint, err := c.GetPrivatelinkEndpoint(ctx, endpointName, privatelinkEndpointURL)
 	if err != nil {
 		return diag.FromErr(err)
 	}
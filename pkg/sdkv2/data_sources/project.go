@@ -83,7 +83,7 @@ func datasourceProjectRead(
 		}
 
 		var err error
-		project, err = c.GetProject(projectId)
+		project, err = c.GetProject(ctx, projectId)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -92,7 +92,7 @@ func datasourceProjectRead(
 		projectName := d.Get("name").(string)
 
 		var err error
-		project, err = c.GetProjectByName(projectName)
+		project, err = c.GetProjectByName(ctx, projectName)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -85,6 +85,7 @@ func datasourceRepositoryRead(
 	projectID := d.Get("project_id").(int)
 
 	repository, err := c.GetRepository(
+		ctx,
 		strconv.Itoa(repositoryID),
 		strconv.Itoa(projectID),
 	)
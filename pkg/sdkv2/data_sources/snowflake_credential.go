@@ -62,7 +62,7 @@ func snowflakeCredentialRead(ctx context.Context, d *schema.ResourceData, m inte
 	credentialID := d.Get("credential_id").(int)
 	projectID := d.Get("project_id").(int)
 
-	snowflakeCredential, err := c.GetSnowflakeCredential(projectID, credentialID)
+	snowflakeCredential, err := c.GetSnowflakeCredential(ctx, projectID, credentialID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
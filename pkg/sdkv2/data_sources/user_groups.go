@@ -39,7 +39,7 @@ func datasourceUserGroupsRead(ctx context.Context, d *schema.ResourceData, m int
 
 	userID := d.Get("user_id").(int)
 
-	userGroups, err := c.GetUserGroups(userID)
+	userGroups, err := c.GetUserGroups(ctx, userID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -77,7 +77,7 @@ func datasourceWebhookRead(ctx context.Context, d *schema.ResourceData, m interf
 
 	webhookId := d.Get("webhook_id").(string)
 
-	webhook, err := c.GetWebhook(webhookId)
+	webhook, err := c.GetWebhook(ctx, webhookId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
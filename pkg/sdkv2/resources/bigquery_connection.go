@@ -2,8 +2,8 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
@@ -176,6 +176,7 @@ func ResourceBigQueryConnection() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
 	}
 }
 
@@ -265,7 +266,7 @@ func resourceBigQueryConnectionCreate(
 
 	// scopes := d.Get("scopes").([]string)
 
-	connection, err := c.CreateBigQueryConnection(projectId,
+	connection, err := c.CreateBigQueryConnection(ctx, projectId,
 		name,
 		connectionType,
 		isActive,
@@ -320,13 +321,14 @@ func resourceBigQueryConnectionRead(
 		return diag.FromErr(err)
 	}
 
-	connection, err := c.GetBigQueryConnection(connectionIdString, projectIdString)
+	connection, err := c.GetBigQueryConnection(ctx, connectionIdString, projectIdString)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			d.SetId("")
 			return diags
 		}
-		return diag.FromErr(err)
+		return readErrorDiags(err)
 	}
 
 	if err := d.Set("connection_id", connection.ID); err != nil {
@@ -442,7 +444,7 @@ func resourceBigQueryConnectionUpdate(
 		d.HasChange("dataproc_cluster_name") ||
 		d.HasChange("application_secret") ||
 		d.HasChange("application_id") {
-		connection, err := c.GetBigQueryConnection(connectionIdString, projectIdString)
+		connection, err := c.GetBigQueryConnection(ctx, connectionIdString, projectIdString)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -568,7 +570,7 @@ func resourceBigQueryConnectionUpdate(
 			connection.Details.ApplicationId = applicationId
 		}
 
-		_, err = c.UpdateBigQueryConnection(connectionIdString, projectIdString, *connection)
+		_, err = c.UpdateBigQueryConnection(ctx, connectionIdString, projectIdString, *connection)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -258,7 +259,7 @@ func testAccCheckDbtCloudBigQueryConnectionDestroy(s *terraform.State) error {
 			"dbtcloud_bigquery_connection",
 		)
 
-		_, err := apiClient.GetConnection(connectionId, projectId)
+		_, err := apiClient.GetConnection(context.Background(), connectionId, projectId)
 		if err == nil {
 			return fmt.Errorf("Connection still exists")
 		}
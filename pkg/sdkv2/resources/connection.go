@@ -2,8 +2,8 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
@@ -157,6 +157,7 @@ func ResourceConnection() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
 	}
 }
 
@@ -189,6 +190,7 @@ func resourceConnectionCreate(
 	catalog := d.Get("catalog").(string)
 
 	connection, err := c.CreateConnection(
+		ctx,
 		projectId,
 		name,
 		connectionType,
@@ -236,13 +238,14 @@ func resourceConnectionRead(
 		return diag.FromErr(err)
 	}
 
-	connection, err := c.GetConnection(connectionIdString, projectIdString)
+	connection, err := c.GetConnection(ctx, connectionIdString, projectIdString)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			d.SetId("")
 			return diags
 		}
-		return diag.FromErr(err)
+		return readErrorDiags(err)
 	}
 
 	connection.Details.OAuthClientID = d.Get("oauth_client_id").(string)
@@ -376,7 +379,7 @@ func resourceConnectionUpdate(
 		d.HasChange("http_path") ||
 		d.HasChange("catalog") ||
 		d.HasChange("adapter_id") {
-		connection, err := c.GetConnection(connectionIdString, projectIdString)
+		connection, err := c.GetConnection(ctx, connectionIdString, projectIdString)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -458,7 +461,7 @@ func resourceConnectionUpdate(
 			connection.Details.AdapterId = &adapterId
 		}
 
-		_, err = c.UpdateConnection(connectionIdString, projectIdString, *connection)
+		_, err = c.UpdateConnection(ctx, connectionIdString, projectIdString, *connection)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -484,7 +487,7 @@ func resourceConnectionDelete(
 		return diag.FromErr(err)
 	}
 
-	_, err = c.DeleteConnection(connectionIdString, projectIdString)
+	_, err = c.DeleteConnection(ctx, connectionIdString, projectIdString)
 	if err != nil {
 		return diag.FromErr(err)
 	}
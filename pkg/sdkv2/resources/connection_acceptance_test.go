@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
@@ -595,7 +596,7 @@ func testAccCheckDbtCloudConnectionExists(resource string) resource.TestCheckFun
 			return err
 		}
 
-		_, err = apiClient.GetConnection(connectionId, projectId)
+		_, err = apiClient.GetConnection(context.Background(), connectionId, projectId)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -621,7 +622,7 @@ func testAccCheckDbtCloudConnectionDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = apiClient.GetConnection(connectionId, projectId)
+		_, err = apiClient.GetConnection(context.Background(), connectionId, projectId)
 		if err == nil {
 			return fmt.Errorf("Connection still exists")
 		}
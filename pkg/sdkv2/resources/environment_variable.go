@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -53,6 +54,7 @@ func ResourceEnvironmentVariable() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeoutsWithoutUpdate(),
 	}
 }
 
@@ -74,6 +76,7 @@ func resourceEnvironmentVariableCreate(
 	}
 
 	environmentVariable, err := c.CreateEnvironmentVariable(
+		ctx,
 		projectID,
 		name,
 		environmentValuesStrings,
@@ -112,13 +115,14 @@ func resourceEnvironmentVariableRead(
 
 	environmentVariableName := strings.Split(d.Id(), dbt_cloud.ID_DELIMITER)[1]
 
-	environmentVariable, err := c.GetEnvironmentVariable(projectID, environmentVariableName)
+	environmentVariable, err := c.GetEnvironmentVariable(ctx, projectID, environmentVariableName)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			d.SetId("")
 			return diags
 		}
-		return diag.FromErr(err)
+		return readErrorDiags(err)
 	}
 	if err := d.Set("project_id", environmentVariable.ProjectID); err != nil {
 		return diag.FromErr(err)
@@ -156,7 +160,7 @@ func resourceEnvironmentVariableDelete(
 
 	environmentVariableName := strings.Split(d.Id(), dbt_cloud.ID_DELIMITER)[1]
 
-	_, err = c.DeleteEnvironmentVariable(environmentVariableName, projectID)
+	_, err = c.DeleteEnvironmentVariable(ctx, environmentVariableName, projectID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
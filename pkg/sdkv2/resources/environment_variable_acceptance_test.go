@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -217,7 +218,7 @@ func testAccCheckDbtCloudEnvironmentVariableExists(resource string) resource.Tes
 
 		environmentVariableName := strings.Split(rs.Primary.ID, dbt_cloud.ID_DELIMITER)[1]
 
-		_, err = apiClient.GetEnvironmentVariable(projectId, environmentVariableName)
+		_, err = apiClient.GetEnvironmentVariable(context.Background(), projectId, environmentVariableName)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -241,7 +242,7 @@ func testAccCheckDbtCloudEnvironmentVariableDestroy(s *terraform.State) error {
 		}
 
 		environmentVariableName := strings.Split(rs.Primary.ID, dbt_cloud.ID_DELIMITER)[1]
-		_, err = apiClient.GetEnvironmentVariable(projectId, environmentVariableName)
+		_, err = apiClient.GetEnvironmentVariable(context.Background(), projectId, environmentVariableName)
 		if err == nil {
 			return fmt.Errorf("Environment variable still exists")
 		}
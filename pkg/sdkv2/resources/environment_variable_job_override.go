@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -52,6 +53,7 @@ func ResourceEnvironmentVariableJobOverride() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
 	}
 }
 
@@ -71,6 +73,7 @@ func resourceEnvironmentVariableJobOverrideCreate(
 	jobDefinitionID := d.Get("job_definition_id").(int)
 
 	environmentVariableJobOverride, err := c.CreateEnvironmentVariableJobOverride(
+		ctx,
 		projectId,
 		name,
 		rawValue,
@@ -122,16 +125,18 @@ func resourceEnvironmentVariableJobOverrideRead(
 	}
 
 	envVarOverride, err := c.GetEnvironmentVariableJobOverride(
+		ctx,
 		projectId,
 		jobID,
 		envVarOverrideID,
 	)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			d.SetId("")
 			return diags
 		}
-		return diag.FromErr(err)
+		return readErrorDiags(err)
 	}
 
 	if err := d.Set("job_definition_id", envVarOverride.JobDefinitionID); err != nil {
@@ -177,6 +182,7 @@ func resourceEnvironmentVariableJobOverrideUpdate(
 
 	if d.HasChange("raw_value") {
 		envVarOverride, err := c.GetEnvironmentVariableJobOverride(
+			ctx,
 			projectId,
 			jobID,
 			envVarOverrideID,
@@ -189,6 +195,7 @@ func resourceEnvironmentVariableJobOverrideUpdate(
 		envVarOverride.RawValue = rawValue
 
 		_, err = c.UpdateEnvironmentVariableJobOverride(
+			ctx,
 			projectId,
 			envVarOverrideID,
 			*envVarOverride,
@@ -220,7 +227,7 @@ func resourceEnvironmentVariableJobOverrideDelete(
 		return diag.FromErr(err)
 	}
 
-	_, err = c.DeleteEnvironmentVariableJobOverride(projectId, envVarOverrideID)
+	_, err = c.DeleteEnvironmentVariableJobOverride(ctx, projectId, envVarOverrideID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
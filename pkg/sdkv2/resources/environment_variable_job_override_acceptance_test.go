@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -195,6 +196,7 @@ func testAccCheckDbtCloudEnvironmentVariableJobOverrideExists(
 		}
 
 		_, err = apiClient.GetEnvironmentVariableJobOverride(
+			context.Background(),
 			projectId,
 			jobID,
 			envVarOverrideID,
@@ -233,6 +235,7 @@ func testAccCheckDbtCloudEnvironmentVariableJobOverrideDestroy(s *terraform.Stat
 		}
 
 		_, err = apiClient.GetEnvironmentVariableJobOverride(
+			context.Background(),
 			projectId,
 			jobID,
 			envVarOverrideID,
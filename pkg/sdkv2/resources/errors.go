@@ -0,0 +1,23 @@
+package resources
+
+import (
+	"errors"
+
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// readErrorDiags converts the error returned when reading an object to diagnostics
+// a missing permission gets its own diagnostic, the same as in the Framework resources
+func readErrorDiags(err error) diag.Diagnostics {
+	var permissionErr *dbt_cloud.PermissionDeniedError
+	if errors.As(err, &permissionErr) {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  helper.PermissionDeniedSummary(permissionErr),
+			Detail:   helper.PermissionDeniedDetail(permissionErr),
+		}}
+	}
+	return diag.FromErr(err)
+}
@@ -3,9 +3,9 @@ package resources
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
@@ -58,6 +58,7 @@ func ResourceExtendedAttributes() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
 
 		Description: "This resource allows setting extended attributes which can be assigned to a given environment ([see docs](https://docs.getdbt.com/docs/dbt-cloud-environments#extended-attributes)).<br/><br/>In dbt Cloud those values are provided as YML but in the provider they need to be provided as JSON (see example below).",
 	}
@@ -77,7 +78,7 @@ func resourceExtendedAttributesCreate(
 	extendedAttributesValue := d.Get("extended_attributes").(string)
 	extendedAttributesRaw := json.RawMessage([]byte(extendedAttributesValue))
 
-	extendedAttributes, err := c.CreateExtendedAttributes(state, projectId, extendedAttributesRaw)
+	extendedAttributes, err := c.CreateExtendedAttributes(ctx, state, projectId, extendedAttributesRaw)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -112,13 +113,14 @@ func resourceExtendedAttributesRead(
 		return diag.FromErr(err)
 	}
 
-	extendedAttributes, err := c.GetExtendedAttributes(projectID, extendedAttributesID)
+	extendedAttributes, err := c.GetExtendedAttributes(ctx, projectID, extendedAttributesID)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			d.SetId("")
 			return diags
 		}
-		return diag.FromErr(err)
+		return readErrorDiags(err)
 	}
 
 	if err := d.Set("extended_attributes_id", &extendedAttributes.ID); err != nil {
@@ -175,7 +177,7 @@ func resourceExtendedAttributesUpdate(
 		d.HasChange("project_id") ||
 		d.HasChange("extended_attributes") {
 
-		extendedAttributes, err := c.GetExtendedAttributes(projectId, extendedAttributesId)
+		extendedAttributes, err := c.GetExtendedAttributes(ctx, projectId, extendedAttributesId)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -193,7 +195,7 @@ func resourceExtendedAttributesUpdate(
 			extendedAttributes.ExtendedAttributes = json.RawMessage([]byte(extendedAttributesValue))
 		}
 
-		_, err = c.UpdateExtendedAttributes(projectId, extendedAttributesId, *extendedAttributes)
+		_, err = c.UpdateExtendedAttributes(ctx, projectId, extendedAttributesId, *extendedAttributes)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -219,7 +221,7 @@ func resourceExtendedAttributesDelete(
 		return diag.FromErr(err)
 	}
 
-	_, err = c.DeleteExtendedAttributes(projectId, extendedAttributesId)
+	_, err = c.DeleteExtendedAttributes(ctx, projectId, extendedAttributesId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
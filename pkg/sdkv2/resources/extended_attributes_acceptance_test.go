@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -209,7 +210,7 @@ func testAccCheckDbtCloudExtendedAttributesExists(resource string) resource.Test
 			return fmt.Errorf("Can't get extendedAttributesID")
 		}
 
-		_, err = apiClient.GetExtendedAttributes(projectId, extendedAttributesID)
+		_, err = apiClient.GetExtendedAttributes(context.Background(), projectId, extendedAttributesID)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -238,7 +239,7 @@ func testAccCheckDbtCloudExtendedAttributesDestroy(s *terraform.State) error {
 			return fmt.Errorf("Can't get extendedAttributesID")
 		}
 
-		_, err = apiClient.GetExtendedAttributes(projectId, extendedAttributesID)
+		_, err = apiClient.GetExtendedAttributes(context.Background(), projectId, extendedAttributesID)
 		if err == nil {
 			return fmt.Errorf("Extended attributes still exists")
 		}
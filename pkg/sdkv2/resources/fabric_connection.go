@@ -2,8 +2,8 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
@@ -85,6 +85,7 @@ func ResourceFabricConnection() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
 	}
 }
 
@@ -107,7 +108,7 @@ func resourceFabricConnectionCreate(
 	loginTimeout := d.Get("login_timeout").(int)
 	queryTimeout := d.Get("query_timeout").(int)
 
-	connection, err := c.CreateFabricConnection(projectId,
+	connection, err := c.CreateFabricConnection(ctx, projectId,
 		name,
 		server,
 		port,
@@ -145,13 +146,14 @@ func resourceFabricConnectionRead(
 		return diag.FromErr(err)
 	}
 
-	connection, err := c.GetFabricConnection(connectionIdString, projectIdString)
+	connection, err := c.GetFabricConnection(ctx, connectionIdString, projectIdString)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			d.SetId("")
 			return diags
 		}
-		return diag.FromErr(err)
+		return readErrorDiags(err)
 	}
 
 	if err := d.Set("connection_id", connection.ID); err != nil {
@@ -211,7 +213,7 @@ func resourceFabricConnectionUpdate(
 		d.HasChange("retries") ||
 		d.HasChange("login_timeout") ||
 		d.HasChange("query_timeout") {
-		connection, err := c.GetFabricConnection(connectionIdString, projectIdString)
+		connection, err := c.GetFabricConnection(ctx, connectionIdString, projectIdString)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -239,7 +241,7 @@ func resourceFabricConnectionUpdate(
 			)
 		}
 
-		_, err = c.UpdateFabricConnection(connectionIdString, projectIdString, *connection)
+		_, err = c.UpdateFabricConnection(ctx, connectionIdString, projectIdString, *connection)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -185,7 +186,7 @@ func testAccCheckDbtCloudFabricConnectionDestroy(s *terraform.State) error {
 			return err
 		}
 
-		_, err = apiClient.GetConnection(connectionId, projectId)
+		_, err = apiClient.GetConnection(context.Background(), connectionId, projectId)
 		if err == nil {
 			return fmt.Errorf("Connection still exists")
 		}
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -37,6 +38,7 @@ func ResourceProjectConnection() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts:    resourceTimeoutsWithoutUpdate(),
 		Description: "~> This resource is deprecated with the release of global connections and it will be removed in a future version of the provider. Going forward, please set the `connection_id` in the `dbtcloud_environment` resource instead.",
 	}
 }
@@ -54,14 +56,14 @@ func resourceProjectConnectionCreate(
 	projectID := d.Get("project_id").(int)
 	projectIDString := strconv.Itoa(projectID)
 
-	project, err := c.GetProject(projectIDString)
+	project, err := c.GetProject(ctx, projectIDString)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	project.ConnectionID = &connectionID
 
-	_, err = c.UpdateProject(projectIDString, *project)
+	_, err = c.UpdateProject(ctx, projectIDString, *project)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -88,13 +90,14 @@ func resourceProjectConnectionRead(
 	}
 	projectIDString := strconv.Itoa(projectID)
 
-	project, err := c.GetProject(projectIDString)
+	project, err := c.GetProject(ctx, projectIDString)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			d.SetId("")
 			return diags
 		}
-		return diag.FromErr(err)
+		return readErrorDiags(err)
 	}
 
 	if err := d.Set("connection_id", project.ConnectionID); err != nil {
@@ -119,14 +122,14 @@ func resourceProjectConnectionDelete(
 	projectID := d.Get("project_id").(int)
 	projectIDString := strconv.Itoa(projectID)
 
-	project, err := c.GetProject(projectIDString)
+	project, err := c.GetProject(ctx, projectIDString)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	project.ConnectionID = nil
 
-	_, err = c.UpdateProject(projectIDString, *project)
+	_, err = c.UpdateProject(ctx, projectIDString, *project)
 	if err != nil {
 		return diag.FromErr(err)
 	}
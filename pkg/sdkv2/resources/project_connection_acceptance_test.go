@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -92,7 +93,7 @@ func testAccCheckDbtCloudProjectConnectionExists(resource string) resource.TestC
 		if err != nil {
 			return err
 		}
-		project, err := apiClient.GetProject(projectId)
+		project, err := apiClient.GetProject(context.Background(), projectId)
 		if err != nil {
 			return fmt.Errorf("Can't get project")
 		}
@@ -121,7 +122,7 @@ func testAccCheckDbtCloudProjectConnectionDestroy(s *terraform.State) error {
 			return err
 		}
 
-		project, err := apiClient.GetProject(projectId)
+		project, err := apiClient.GetProject(context.Background(), projectId)
 		if project != nil {
 			return fmt.Errorf("Project still exists")
 		}
@@ -2,9 +2,9 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
@@ -37,6 +37,7 @@ func ResourceProjectRepository() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts:    resourceTimeoutsWithoutUpdate(),
 		Description: "This resource allows you to link a dbt Cloud project to a git repository.",
 	}
 }
@@ -54,14 +55,14 @@ func resourceProjectRepositoryCreate(
 	projectID := d.Get("project_id").(int)
 	projectIDString := strconv.Itoa(projectID)
 
-	project, err := c.GetProject(projectIDString)
+	project, err := c.GetProject(ctx, projectIDString)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	project.RepositoryID = &repositoryID
 
-	_, err = c.UpdateProject(projectIDString, *project)
+	_, err = c.UpdateProject(ctx, projectIDString, *project)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -89,13 +90,14 @@ func resourceProjectRepositoryRead(
 		return diag.FromErr(err)
 	}
 
-	project, err := c.GetProject(projectIDString)
+	project, err := c.GetProject(ctx, projectIDString)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			d.SetId("")
 			return diags
 		}
-		return diag.FromErr(err)
+		return readErrorDiags(err)
 	}
 
 	if err := d.Set("repository_id", project.RepositoryID); err != nil {
@@ -120,14 +122,14 @@ func resourceProjectRepositoryDelete(
 	projectID := d.Get("project_id").(int)
 	projectIDString := strconv.Itoa(projectID)
 
-	project, err := c.GetProject(projectIDString)
+	project, err := c.GetProject(ctx, projectIDString)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	project.RepositoryID = nil
 
-	_, err = c.UpdateProject(projectIDString, *project)
+	_, err = c.UpdateProject(ctx, projectIDString, *project)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -108,7 +109,7 @@ func testAccCheckDbtCloudProjectRepositoryExists(resource string) resource.TestC
 		if err != nil {
 			return err
 		}
-		project, err := apiClient.GetProject(projectId)
+		project, err := apiClient.GetProject(context.Background(), projectId)
 		if err != nil {
 			return fmt.Errorf("Can't get project")
 		}
@@ -132,7 +133,7 @@ func testAccCheckDbtCloudProjectRepositoryEmpty(resource string) resource.TestCh
 		if err != nil {
 			return fmt.Errorf("Issue getting the client")
 		}
-		project, err := apiClient.GetProject(rs.Primary.ID)
+		project, err := apiClient.GetProject(context.Background(), rs.Primary.ID)
 		if err != nil {
 			return fmt.Errorf("Can't get project")
 		}
@@ -160,7 +161,7 @@ func testAccCheckDbtCloudProjectRepositoryDestroy(s *terraform.State) error {
 		if err != nil {
 			return err
 		}
-		project, err := apiClient.GetProject(projectId)
+		project, err := apiClient.GetProject(context.Background(), projectId)
 		if project != nil {
 			return fmt.Errorf("Project still exists")
 		}
@@ -2,8 +2,8 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
@@ -110,6 +110,7 @@ func ResourceRepository() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
 	}
 }
 
@@ -134,6 +135,7 @@ func resourceRepositoryCreate(
 	pullRequestURLTemplate := d.Get("pull_request_url_template").(string)
 
 	repository, err := c.CreateRepository(
+		ctx,
 		projectId,
 		remoteUrl,
 		isActive,
@@ -154,7 +156,7 @@ func resourceRepositoryCreate(
 
 		repositoryIDString := fmt.Sprintf("%d", *repository.ID)
 		projectIDString := fmt.Sprintf("%d", repository.ProjectID)
-		_, err := c.DeleteRepository(repositoryIDString, projectIDString)
+		_, err := c.DeleteRepository(ctx, repositoryIDString, projectIDString)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -189,13 +191,14 @@ func resourceRepositoryRead(
 		return diag.FromErr(err)
 	}
 
-	repository, err := c.GetRepository(repositoryIdString, projectIdString)
+	repository, err := c.GetRepository(ctx, repositoryIdString, projectIdString)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			d.SetId("")
 			return diags
 		}
-		return diag.FromErr(err)
+		return readErrorDiags(err)
 	}
 
 	if err := d.Set("repository_id", repository.ID); err != nil {
@@ -261,7 +264,7 @@ func resourceRepositoryUpdate(
 	}
 
 	if d.HasChange("is_active") || d.HasChange("pull_request_url_template") {
-		repository, err := c.GetRepository(repositoryIdString, projectIdString)
+		repository, err := c.GetRepository(ctx, repositoryIdString, projectIdString)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -278,7 +281,7 @@ func resourceRepositoryUpdate(
 			repository.PullRequestURLTemplate = d.Get("pull_request_url_template").(string)
 		}
 
-		_, err = c.UpdateRepository(repositoryIdString, projectIdString, *repository)
+		_, err = c.UpdateRepository(ctx, repositoryIdString, projectIdString, *repository)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -304,7 +307,7 @@ func resourceRepositoryDelete(
 		return diag.FromErr(err)
 	}
 
-	_, err = c.DeleteRepository(repositoryIdString, projectIdString)
+	_, err = c.DeleteRepository(ctx, repositoryIdString, projectIdString)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -166,7 +167,7 @@ func testAccCheckDbtCloudRepositoryExists(resource string) resource.TestCheckFun
 		if err != nil {
 			return err
 		}
-		_, err = apiClient.GetRepository(repositoryId, projectId)
+		_, err = apiClient.GetRepository(context.Background(), repositoryId, projectId)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -191,7 +192,7 @@ func testAccCheckDbtCloudRepositoryDestroy(s *terraform.State) error {
 		if err != nil {
 			return err
 		}
-		_, err = apiClient.GetRepository(repositoryId, projectId)
+		_, err = apiClient.GetRepository(context.Background(), repositoryId, projectId)
 		if err == nil {
 			return fmt.Errorf("Repository still exists")
 		}
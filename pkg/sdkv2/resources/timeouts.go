@@ -0,0 +1,24 @@
+package resources
+
+import (
+	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/helper"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceTimeouts returns the default timeouts of the resources, configurable with a timeouts {} block
+// the SDK cancels the context of each operation when its timeout is reached
+func resourceTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(helper.DEFAULT_TIMEOUT),
+		Read:   schema.DefaultTimeout(helper.DEFAULT_TIMEOUT),
+		Update: schema.DefaultTimeout(helper.DEFAULT_TIMEOUT),
+		Delete: schema.DefaultTimeout(helper.DEFAULT_TIMEOUT),
+	}
+}
+
+// resourceTimeoutsWithoutUpdate returns the default timeouts of the resources recreated on every change
+func resourceTimeoutsWithoutUpdate() *schema.ResourceTimeout {
+	timeouts := resourceTimeouts()
+	timeouts.Update = nil
+	return timeouts
+}
@@ -2,9 +2,9 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -38,6 +38,7 @@ func ResourceUserGroups() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
 
 		Description: `
 Assigns a set of dbt Cloud groups to a given User ID. 
@@ -79,7 +80,7 @@ func resourceUserGroupsCreate(ctx context.Context, d *schema.ResourceData, m int
 		groupIDs = append(groupIDs, groupID.(int))
 	}
 
-	groupsAssigned, err := c.AssignUserGroups(userID, groupIDs)
+	groupsAssigned, err := c.AssignUserGroups(ctx, userID, groupIDs)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -106,13 +107,14 @@ func resourceUserGroupsRead(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	userGroups, err := c.GetUserGroups(userID)
+	userGroups, err := c.GetUserGroups(ctx, userID)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			d.SetId("")
 			return diags
 		}
-		return diag.FromErr(err)
+		return readErrorDiags(err)
 	}
 
 	if err := d.Set("user_id", userID); err != nil {
@@ -148,7 +150,7 @@ func resourceUserGroupsUpdate(ctx context.Context, d *schema.ResourceData, m int
 			groupIDs = append(groupIDs, groupID.(int))
 		}
 
-		groupsAssigned, err := c.AssignUserGroups(userID, groupIDs)
+		groupsAssigned, err := c.AssignUserGroups(ctx, userID, groupIDs)
 		if err != nil {
 			return diag.FromErr(err)
 		}
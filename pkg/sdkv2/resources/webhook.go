@@ -2,8 +2,8 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"strconv"
-	"strings"
 
 	"github.com/dbt-labs/terraform-provider-dbtcloud/pkg/dbt_cloud"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -93,6 +93,7 @@ func ResourceWebhook() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: resourceTimeouts(),
 	}
 }
 
@@ -104,13 +105,14 @@ func resourceWebhookRead(ctx context.Context, d *schema.ResourceData, m interfac
 
 	webhookId := d.Id()
 
-	webhook, err := c.GetWebhook(webhookId)
+	webhook, err := c.GetWebhook(ctx, webhookId)
 	if err != nil {
-		if strings.HasPrefix(err.Error(), "resource-not-found") {
+		var notFoundErr *dbt_cloud.NotFoundError
+		if errors.As(err, &notFoundErr) {
 			d.SetId("")
 			return diags
 		}
-		return diag.FromErr(err)
+		return readErrorDiags(err)
 	}
 
 	if err := d.Set("webhook_id", webhook.WebhookId); err != nil {
@@ -176,6 +178,7 @@ func resourceWebhookCreate(ctx context.Context, d *schema.ResourceData, m interf
 	}
 
 	w, err := c.CreateWebhook(
+		ctx,
 		webhookId,
 		name,
 		description,
@@ -209,7 +212,7 @@ func resourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		d.HasChange("client_url") ||
 		d.HasChange("event_types") ||
 		d.HasChange("job_ids") {
-		webhookRead, err := c.GetWebhook(webhookId)
+		webhookRead, err := c.GetWebhook(ctx, webhookId)
 
 		jobIdsWrite := make([]int, len(webhookRead.JobIds))
 		for i, step := range webhookRead.JobIds {
@@ -257,7 +260,7 @@ func resourceWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interf
 			webhook.JobIds = jobIDs
 		}
 
-		_, err = c.UpdateWebhook(webhookId, webhook)
+		_, err = c.UpdateWebhook(ctx, webhookId, webhook)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -272,7 +275,7 @@ func resourceWebhookDelete(ctx context.Context, d *schema.ResourceData, m interf
 
 	var diags diag.Diagnostics
 
-	_, err := c.DeleteWebhook(webhookId)
+	_, err := c.DeleteWebhook(ctx, webhookId)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"testing"
@@ -181,7 +182,7 @@ func testAccCheckDbtCloudWebhookExists(resource string) resource.TestCheckFunc {
 		}
 		webhookID := rs.Primary.ID
 
-		_, err = apiClient.GetWebhook(webhookID)
+		_, err = apiClient.GetWebhook(context.Background(), webhookID)
 		if err != nil {
 			return fmt.Errorf("error fetching item with resource %s. %s", resource, err)
 		}
@@ -200,7 +201,7 @@ func testAccCheckDbtCloudWebhookDestroy(s *terraform.State) error {
 			continue
 		}
 		webhookID := rs.Primary.ID
-		_, err := apiClient.GetWebhook(webhookID)
+		_, err := apiClient.GetWebhook(context.Background(), webhookID)
 		if err == nil {
 			return fmt.Errorf("Webhook still exists")
 		}
@@ -43,23 +43,3 @@ func JobConditionMapToSet(item map[string]any) *schema.Set {
 
 	return schema.NewSet(hashFunc, items)
 }
-
-func ExtractJobConditionSet(
-	d *schema.ResourceData,
-) (empty bool, jobID, projectID int, statuses []int) {
-
-	if d.Get("job_completion_trigger_condition").(*schema.Set).Len() == 0 {
-		return true, 0, 0, []int{}
-	} else {
-		// this is a set but we only allow 1 item
-		jobCompletionTrigger := d.Get("job_completion_trigger_condition").(*schema.Set).List()[0].(map[string]any)
-
-		jobCompletionStatuses := lo.Map(
-			jobCompletionTrigger["statuses"].(*schema.Set).List(),
-			func(status interface{}, idx int) int {
-				return JobCompletionTriggerConditionsMappingHumanCode[status.(string)]
-			},
-		)
-		return false, jobCompletionTrigger["job_id"].(int), jobCompletionTrigger["project_id"].(int), jobCompletionStatuses
-	}
-}